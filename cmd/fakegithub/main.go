@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/fakegithub"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+)
+
+// fakegithub serves the seeded fake GitHub API, e.g. for the docker-compose demo profile.
+//
+// Environment:
+//
+//	FAKE_GITHUB_ADDR        listen address, ":8080" by default
+//	FAKE_GITHUB_LATENCY     delay added to every response, e.g. "200ms"
+//	FAKE_GITHUB_RATE_LIMIT  requests allowed per hour, 5000 by default
+func main() {
+	customLogger := logger.New(logger.DEBUG)
+
+	server := fakegithub.New(nil)
+
+	if value := os.Getenv("FAKE_GITHUB_LATENCY"); value != "" {
+		latency, err := time.ParseDuration(value)
+		if err != nil {
+			customLogger.Fatal("Invalid FAKE_GITHUB_LATENCY: %v", err)
+		}
+		server.SetLatency(latency)
+	}
+
+	if value := os.Getenv("FAKE_GITHUB_RATE_LIMIT"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			customLogger.Fatal("Invalid FAKE_GITHUB_RATE_LIMIT: %v", err)
+		}
+		server.SetRateLimit(limit)
+	}
+
+	addr := os.Getenv("FAKE_GITHUB_ADDR")
+	if addr == "" {
+		addr = ":8080"
+	}
+
+	httpServer := &http.Server{Addr: addr, Handler: server}
+	go func() {
+		customLogger.Info("Fake GitHub API listening on %s", addr)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			customLogger.Fatal("Failed to serve: %v", err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		customLogger.Error("Error shutting down fake GitHub API: %v", err)
+	}
+
+	customLogger.Info("Fake GitHub API stopped after %d requests", server.Requests())
+}
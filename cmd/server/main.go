@@ -11,8 +11,10 @@ import (
 
 	"github.com/Dhoini/GitHub_Parser/internal/application/service"
 	"github.com/Dhoini/GitHub_Parser/internal/config"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
 	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/api/proto"
 	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/cassette"
 	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/metrics"
 	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/persistence/mongodb"
 	grpcHandler "github.com/Dhoini/GitHub_Parser/internal/interfaces/grpc"
@@ -49,36 +51,110 @@ func main() {
 
 	db := mongoClient.Database(cfg.MongoDB.Database)
 
+	// Job IDs and idempotency keys must stay unique across replicas
+	if err := mongodb.EnsureJobIndexes(context.Background(), db); err != nil {
+		customLogger.Fatal("Failed to create job indexes: %v", err)
+	}
+
 	// Initialize repositories
 	repoRepo := mongodb.NewRepositoryRepository(db, customLogger)
 	issueRepo := mongodb.NewIssueRepository(db, customLogger)
 	prRepo := mongodb.NewPullRequestRepository(db, customLogger)
 	userRepo := mongodb.NewUserRepository(db, customLogger)
+	ciCheckRepo := mongodb.NewCICheckRepository(db, customLogger)
+	fileRepo := mongodb.NewRepositoryFileRepository(db, customLogger)
+	codeOwnerRepo := mongodb.NewCodeOwnerRepository(db, customLogger)
+	depRepo := mongodb.NewDependencyRepository(db, customLogger)
+	alertRepo := mongodb.NewSecurityAlertRepository(db, customLogger)
+	jobRepo := mongodb.NewJobRepository(db, customLogger)
+	scheduleRepo := mongodb.NewScheduleRepository(db, customLogger)
 
 	// Initialize GitHub client
-	githubClient := github.NewGithubClient(cfg.GitHub.Token, appMetrics, customLogger)
+	var clientOpts []github.ClientOption
+	var recorder *cassette.Transport
+	if cfg.GitHub.BaseURL != "" {
+		clientOpts = append(clientOpts, github.WithBaseURL(cfg.GitHub.BaseURL))
+		customLogger.Info("Using GitHub API at %s", cfg.GitHub.BaseURL)
+	}
+	if cfg.GitHub.CassetteMode != "" {
+		mode, err := cassette.ParseMode(cfg.GitHub.CassetteMode)
+		if err != nil {
+			customLogger.Fatal("Invalid cassette mode: %v", err)
+		}
+
+		recorder, err = cassette.New(cfg.GitHub.CassettePath, mode, nil)
+		if err != nil {
+			customLogger.Fatal("Failed to open cassette: %v", err)
+		}
+
+		clientOpts = append(clientOpts, github.WithTransport(recorder))
+		customLogger.Info("GitHub API cassette %s in %s mode", cfg.GitHub.CassettePath, cfg.GitHub.CassetteMode)
+	}
+	githubClient := github.NewGithubClient(cfg.GitHub.Token, appMetrics, customLogger, clientOpts...)
 
 	// Initialize services
 	githubService := service.NewGithubService(githubClient.GetClient(), customLogger)
+	jobQueue := service.NewJobQueue(cfg.Jobs.Workers, cfg.Jobs.QueueSize, appMetrics, customLogger)
 	parserService := service.NewParserService(
 		githubService,
 		repoRepo,
 		issueRepo,
 		prRepo,
 		userRepo,
+		ciCheckRepo,
+		fileRepo,
+		codeOwnerRepo,
+		depRepo,
+		alertRepo,
+		jobRepo,
+		jobQueue,
+		cfg.Jobs.StepParallelism,
+		entity.RetryPolicy{
+			MaxAttempts: cfg.Jobs.MaxAttempts,
+			Backoff:     cfg.Jobs.RetryBackoff,
+			MaxBackoff:  cfg.Jobs.RetryMaxBackoff,
+		},
+		service.JobLeaseConfig{
+			Owner:        cfg.Jobs.ReplicaID,
+			TTL:          cfg.Jobs.LeaseTTL,
+			PollInterval: cfg.Jobs.LeasePollInterval,
+			Resume:       cfg.Jobs.ResumeInterrupted,
+		},
+		service.CallbackConfig{
+			Secret: cfg.Callbacks.Secret,
+			Retry: entity.RetryPolicy{
+				MaxAttempts: cfg.Callbacks.MaxAttempts,
+				Backoff:     cfg.Callbacks.Backoff,
+				MaxBackoff:  cfg.Callbacks.MaxBackoff,
+			},
+			Timeout: cfg.Callbacks.Timeout,
+		},
 		mongoClient, // Pass mongo client for transaction support
 		appMetrics,  // Pass metrics
 		customLogger,
 	)
 
+	// Pick up jobs left unfinished by a previous run or by replicas that are gone
+	if err := parserService.RecoverJobs(context.Background(), cfg.Jobs.ResumeInterrupted); err != nil {
+		customLogger.Error("Failed to recover parsing jobs: %v", err)
+	}
+	// Workers are not stopped on shutdown: unfinished jobs are taken over once their leases expire
+	parserService.StartWorkers(context.Background())
+
+	scheduler := service.NewScheduler(scheduleRepo, parserService, cfg.Jobs.ScheduleTick, customLogger)
+	scheduler.Start(context.Background())
+
 	// Initialize gRPC server
 	server := grpc.NewServer()
 	handler := grpcHandler.NewHandler(
 		parserService,
+		scheduler,
 		repoRepo,
 		issueRepo,
 		prRepo,
 		userRepo,
+		depRepo,
+		alertRepo,
 		customLogger,
 	)
 	proto.RegisterGithubParserServiceServer(server, handler)
@@ -105,6 +181,12 @@ func main() {
 
 	server.GracefulStop()
 
+	if recorder != nil {
+		if err := recorder.Save(); err != nil {
+			customLogger.Error("Error saving GitHub API cassette: %v", err)
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"bufio"
+	"encoding/json"
+	"strings"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+)
+
+// codeOwnersPaths lists the locations GitHub looks for a CODEOWNERS file, in priority order
+var codeOwnersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// manifestParsers maps tracked dependency manifests to their parsers
+var manifestParsers = map[string]func(content string) []*entity.Dependency{
+	"go.mod":           parseGoMod,
+	"package.json":     parsePackageJSON,
+	"requirements.txt": parseRequirements,
+	"Dockerfile":       parseDockerfile,
+}
+
+// manifestPaths lists tracked dependency manifests in a stable order
+var manifestPaths = []string{"go.mod", "package.json", "requirements.txt", "Dockerfile"}
+
+// parseCodeOwners parses CODEOWNERS rules, skipping comments and patterns without owners
+func parseCodeOwners(content string) []*entity.CodeOwnerRule {
+	var rules []*entity.CodeOwnerRule
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := stripComment(scanner.Text())
+
+		fields := strings.Fields(text)
+		if len(fields) < 2 {
+			continue
+		}
+
+		rules = append(rules, &entity.CodeOwnerRule{
+			Line:    line,
+			Pattern: fields[0],
+			Owners:  fields[1:],
+		})
+	}
+
+	return rules
+}
+
+// parseGoMod extracts required modules from a go.mod file
+func parseGoMod(content string) []*entity.Dependency {
+	var deps []*entity.Dependency
+
+	inRequire := false
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		raw := strings.TrimSpace(scanner.Text())
+		indirect := strings.HasSuffix(raw, "// indirect")
+		text := strings.TrimSpace(stripLineComment(raw))
+
+		switch {
+		case text == "":
+			continue
+		case inRequire && text == ")":
+			inRequire = false
+			continue
+		case text == "require (":
+			inRequire = true
+			continue
+		case strings.HasPrefix(text, "require "):
+			text = strings.TrimSpace(strings.TrimPrefix(text, "require "))
+		case !inRequire:
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) < 2 {
+			continue
+		}
+
+		scope := "runtime"
+		if indirect {
+			scope = "indirect"
+		}
+
+		deps = append(deps, &entity.Dependency{
+			Ecosystem: entity.EcosystemGo,
+			Name:      fields[0],
+			Version:   fields[1],
+			Scope:     scope,
+		})
+	}
+
+	return deps
+}
+
+// parsePackageJSON extracts runtime and development dependencies from a package.json file
+func parsePackageJSON(content string) []*entity.Dependency {
+	var manifest struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+	if err := json.Unmarshal([]byte(content), &manifest); err != nil {
+		return nil
+	}
+
+	var deps []*entity.Dependency
+	for name, version := range manifest.Dependencies {
+		deps = append(deps, &entity.Dependency{Ecosystem: entity.EcosystemNPM, Name: name, Version: version, Scope: "runtime"})
+	}
+	for name, version := range manifest.DevDependencies {
+		deps = append(deps, &entity.Dependency{Ecosystem: entity.EcosystemNPM, Name: name, Version: version, Scope: "dev"})
+	}
+
+	return deps
+}
+
+// parseRequirements extracts packages from a pip requirements file.
+// Pinned versions ("==") are stored as the bare version, other specifiers are kept as written.
+func parseRequirements(content string) []*entity.Dependency {
+	var deps []*entity.Dependency
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		text := strings.TrimSpace(stripComment(scanner.Text()))
+
+		// Skip options such as -r, -e and --index-url
+		if text == "" || strings.HasPrefix(text, "-") {
+			continue
+		}
+
+		// Drop environment markers
+		if i := strings.Index(text, ";"); i >= 0 {
+			text = strings.TrimSpace(text[:i])
+		}
+
+		name, version := text, ""
+		if i := strings.IndexAny(text, "=<>~!"); i >= 0 {
+			name, version = strings.TrimSpace(text[:i]), strings.TrimSpace(text[i:])
+		}
+
+		// Drop extras, e.g. requests[security]
+		if i := strings.Index(name, "["); i >= 0 {
+			name = name[:i]
+		}
+
+		deps = append(deps, &entity.Dependency{
+			Ecosystem: entity.EcosystemPyPI,
+			Name:      strings.ToLower(name),
+			Version:   strings.TrimPrefix(version, "=="),
+			Scope:     "runtime",
+		})
+	}
+
+	return deps
+}
+
+// parseDockerfile extracts base images from FROM instructions, ignoring references to earlier build stages
+func parseDockerfile(content string) []*entity.Dependency {
+	var deps []*entity.Dependency
+	stages := map[string]bool{"scratch": true}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(stripComment(scanner.Text()))
+		if len(fields) < 2 || !strings.EqualFold(fields[0], "FROM") {
+			continue
+		}
+
+		args := fields[1:]
+		for len(args) > 0 && strings.HasPrefix(args[0], "--") {
+			args = args[1:]
+		}
+		if len(args) == 0 {
+			continue
+		}
+
+		image := args[0]
+		if len(args) >= 3 && strings.EqualFold(args[1], "AS") {
+			stages[strings.ToLower(args[2])] = true
+		}
+		if stages[strings.ToLower(image)] {
+			continue
+		}
+
+		name, version := splitImageReference(image)
+		deps = append(deps, &entity.Dependency{
+			Ecosystem: entity.EcosystemDocker,
+			Name:      name,
+			Version:   version,
+			Scope:     "runtime",
+		})
+	}
+
+	return deps
+}
+
+// splitImageReference splits an image reference into name and tag or digest; "latest" is implied when absent
+func splitImageReference(image string) (string, string) {
+	if i := strings.Index(image, "@"); i >= 0 {
+		return image[:i], image[i+1:]
+	}
+
+	// A colon before the last slash belongs to a registry port
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i+1:]
+	}
+
+	return image, "latest"
+}
+
+// stripComment removes a "#" comment from a line
+func stripComment(line string) string {
+	if i := strings.Index(line, "#"); i >= 0 {
+		return line[:i]
+	}
+	return line
+}
+
+// stripLineComment removes a "//" comment from a line
+func stripLineComment(line string) string {
+	if i := strings.Index(line, "//"); i >= 0 {
+		return line[:i]
+	}
+	return line
+}
@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+)
+
+// dep is a dependency as the parsers return it, before it is linked to a repository
+func dep(ecosystem, name, version, scope string) *entity.Dependency {
+	return &entity.Dependency{Ecosystem: ecosystem, Name: name, Version: version, Scope: scope}
+}
+
+func TestParseGoMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []*entity.Dependency
+	}{
+		{
+			name: "require block",
+			content: "module example.com/app\n\ngo 1.24\n\nrequire (\n" +
+				"\tgithub.com/a/b v1.2.3\n" +
+				"\tgithub.com/c/d v0.1.0 // indirect\n" +
+				"\t// a comment\n" +
+				")\n",
+			want: []*entity.Dependency{
+				dep(entity.EcosystemGo, "github.com/a/b", "v1.2.3", "runtime"),
+				dep(entity.EcosystemGo, "github.com/c/d", "v0.1.0", "indirect"),
+			},
+		},
+		{
+			name:    "single require lines",
+			content: "module example.com/app\nrequire github.com/a/b v1.0.0\nrequire github.com/c/d v2.0.0 // indirect\n",
+			want: []*entity.Dependency{
+				dep(entity.EcosystemGo, "github.com/a/b", "v1.0.0", "runtime"),
+				dep(entity.EcosystemGo, "github.com/c/d", "v2.0.0", "indirect"),
+			},
+		},
+		{
+			name:    "replace and exclude are not requirements",
+			content: "module example.com/app\nreplace github.com/a/b => ../b\nexclude github.com/c/d v1.0.0\n",
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseGoMod(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseGoMod = %v, want %v", deps(got), deps(tt.want))
+			}
+		})
+	}
+}
+
+func TestParsePackageJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []*entity.Dependency
+	}{
+		{
+			name:    "runtime and dev dependencies",
+			content: `{"name": "app", "dependencies": {"react": "^18.2.0", "lodash": "4.17.21"}, "devDependencies": {"jest": "~29.0.0"}}`,
+			want: []*entity.Dependency{
+				dep(entity.EcosystemNPM, "jest", "~29.0.0", "dev"),
+				dep(entity.EcosystemNPM, "lodash", "4.17.21", "runtime"),
+				dep(entity.EcosystemNPM, "react", "^18.2.0", "runtime"),
+			},
+		},
+		{
+			name:    "no dependencies",
+			content: `{"name": "app"}`,
+		},
+		{
+			name:    "invalid JSON",
+			content: `{"dependencies": `,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Dependencies come from maps, so their order is not stable
+			got := parsePackageJSON(tt.content)
+			sort.Slice(got, func(i, j int) bool { return got[i].Name < got[j].Name })
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsePackageJSON = %v, want %v", deps(got), deps(tt.want))
+			}
+		})
+	}
+}
+
+func TestParseRequirements(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []*entity.Dependency
+	}{
+		{
+			name:    "pinned and ranged versions",
+			content: "Django==4.2.1\nrequests>=2.0,<3\nflask\n",
+			want: []*entity.Dependency{
+				dep(entity.EcosystemPyPI, "django", "4.2.1", "runtime"),
+				dep(entity.EcosystemPyPI, "requests", ">=2.0,<3", "runtime"),
+				dep(entity.EcosystemPyPI, "flask", "", "runtime"),
+			},
+		},
+		{
+			name:    "extras, markers and comments",
+			content: "# tools\nrequests[security]==2.31.0  # pinned\npywin32==306; sys_platform == 'win32'\n",
+			want: []*entity.Dependency{
+				dep(entity.EcosystemPyPI, "requests", "2.31.0", "runtime"),
+				dep(entity.EcosystemPyPI, "pywin32", "306", "runtime"),
+			},
+		},
+		{
+			name:    "options are skipped",
+			content: "-r base.txt\n--index-url https://pypi.example.com/simple\n-e .\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRequirements(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseRequirements = %v, want %v", deps(got), deps(tt.want))
+			}
+		})
+	}
+}
+
+func TestParseDockerfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []*entity.Dependency
+	}{
+		{
+			name:    "single stage",
+			content: "FROM golang:1.24-alpine\nRUN go build ./...\n",
+			want:    []*entity.Dependency{dep(entity.EcosystemDocker, "golang", "1.24-alpine", "runtime")},
+		},
+		{
+			name: "multi stage with a reference to an earlier stage",
+			content: "FROM --platform=$BUILDPLATFORM golang:1.24 AS build\n" +
+				"from build as test\n" +
+				"FROM gcr.io/distroless/static@sha256:abc # final\n",
+			want: []*entity.Dependency{
+				dep(entity.EcosystemDocker, "golang", "1.24", "runtime"),
+				dep(entity.EcosystemDocker, "gcr.io/distroless/static", "sha256:abc", "runtime"),
+			},
+		},
+		{
+			name:    "scratch is not an image",
+			content: "FROM scratch\nCOPY app /app\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseDockerfile(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseDockerfile = %v, want %v", deps(got), deps(tt.want))
+			}
+		})
+	}
+}
+
+func TestSplitImageReference(t *testing.T) {
+	tests := []struct {
+		image, name, version string
+	}{
+		{"alpine", "alpine", "latest"},
+		{"alpine:3.20", "alpine", "3.20"},
+		{"library/nginx@sha256:abc", "library/nginx", "sha256:abc"},
+		{"registry.example.com:5000/team/app", "registry.example.com:5000/team/app", "latest"},
+		{"registry.example.com:5000/team/app:1.0", "registry.example.com:5000/team/app", "1.0"},
+	}
+
+	for _, tt := range tests {
+		name, version := splitImageReference(tt.image)
+		if name != tt.name || version != tt.version {
+			t.Errorf("splitImageReference(%q) = %q, %q, want %q, %q", tt.image, name, version, tt.name, tt.version)
+		}
+	}
+}
+
+func TestParseCodeOwners(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []*entity.CodeOwnerRule
+	}{
+		{
+			name:    "rules with line numbers",
+			content: "# Owners\n*       @octo/core\n\n/docs/  @alice @octo/docs # docs team\n",
+			want: []*entity.CodeOwnerRule{
+				{Line: 2, Pattern: "*", Owners: []string{"@octo/core"}},
+				{Line: 4, Pattern: "/docs/", Owners: []string{"@alice", "@octo/docs"}},
+			},
+		},
+		{
+			name:    "patterns without owners are skipped",
+			content: "/vendor/\n*.go @bob\n",
+			want:    []*entity.CodeOwnerRule{{Line: 2, Pattern: "*.go", Owners: []string{"@bob"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseCodeOwners(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseCodeOwners = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// deps dereferences dependencies for readable failure messages
+func deps(list []*entity.Dependency) []entity.Dependency {
+	out := make([]entity.Dependency, 0, len(list))
+	for _, d := range list {
+		out = append(out, *d)
+	}
+	return out
+}
+
+// stubContents serves repository files from a map; other GitHub calls are not expected
+type stubContents struct {
+	domainService.GithubService
+	files map[string]*entity.RepositoryFile
+}
+
+func (g *stubContents) GetReadme(ctx context.Context, owner, repo string) (*entity.RepositoryFile, error) {
+	return nil, nil
+}
+
+func (g *stubContents) GetFileContent(ctx context.Context, owner, repo, path string) (*entity.RepositoryFile, error) {
+	file, ok := g.files[path]
+	if !ok {
+		return nil, nil
+	}
+	clone := *file
+	return &clone, nil
+}
+
+// flakyDependencyRepo fails the next fail replacements
+type flakyDependencyRepo struct {
+	repository.DependencyRepository
+	fail int
+}
+
+func (r *flakyDependencyRepo) ReplaceForManifest(ctx context.Context, repoID int64, manifest string, deps []*entity.Dependency) error {
+	if r.fail > 0 {
+		r.fail--
+		return errors.New("database unavailable")
+	}
+	return r.DependencyRepository.ReplaceForManifest(ctx, repoID, manifest, deps)
+}
+
+func TestParseContentsStoresFilesAfterTheirRecords(t *testing.T) {
+	github := &stubContents{files: map[string]*entity.RepositoryFile{
+		"go.mod":     {Path: "go.mod", SHA: "mod1", Content: "module example.com/app\nrequire github.com/a/b v1.0.0\n"},
+		"CODEOWNERS": {Path: "CODEOWNERS", SHA: "owners1", Content: "* @octo/core\n"},
+	}}
+	s := newParserServiceWith(t, github)
+	flaky := &flakyDependencyRepo{DependencyRepository: s.depRepo, fail: 1}
+	s.depRepo = flaky
+	ctx := withRepository(context.Background(), &entity.Repository{ID: 1001, FullName: "octo/demo"})
+
+	// The dependencies of go.mod cannot be stored, so neither is the file
+	if _, err := s.ParseContents(ctx, "octo", "demo"); err == nil {
+		t.Fatal("ParseContents succeeded with a failing dependency store")
+	}
+	if stored, _ := s.fileRepo.FindByPath(ctx, 1001, "go.mod"); stored != nil {
+		t.Fatalf("go.mod was stored without its dependencies: %+v", stored)
+	}
+
+	// The next run parses the unchanged file again
+	if _, err := s.ParseContents(ctx, "octo", "demo"); err != nil {
+		t.Fatalf("ParseContents: %v", err)
+	}
+	stored, err := s.depRepo.List(ctx, repository.DependencyFilter{RepositoryID: 1001})
+	if err != nil || len(stored) != 1 || stored[0].Name != "github.com/a/b" {
+		t.Fatalf("stored dependencies = %v, %v, want github.com/a/b", stored, err)
+	}
+
+	// Rules of a removed CODEOWNERS file are dropped and come back with the file
+	owners := github.files["CODEOWNERS"]
+	delete(github.files, "CODEOWNERS")
+	if _, err := s.ParseContents(ctx, "octo", "demo"); err != nil {
+		t.Fatalf("ParseContents: %v", err)
+	}
+	if rules, _ := s.codeOwnerRepo.ListByRepository(ctx, 1001); len(rules) != 0 {
+		t.Errorf("rules of a removed CODEOWNERS file are kept: %+v", rules)
+	}
+
+	github.files["CODEOWNERS"] = owners
+	if _, err := s.ParseContents(ctx, "octo", "demo"); err != nil {
+		t.Fatalf("ParseContents: %v", err)
+	}
+	if rules, _ := s.codeOwnerRepo.ListByRepository(ctx, 1001); len(rules) != 1 || rules[0].Pattern != "*" {
+		t.Errorf("rules after CODEOWNERS came back = %+v, want one", rules)
+	}
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/google/go-github/v39/github"
+)
+
+// The go-github version in use predates the Dependabot alerts and repository advisories APIs,
+// so their payloads are decoded into local types.
+
+type dependabotAlert struct {
+	Number     int    `json:"number"`
+	State      string `json:"state"`
+	HTMLURL    string `json:"html_url"`
+	Dependency struct {
+		Package githubPackage `json:"package"`
+	} `json:"dependency"`
+	SecurityAdvisory struct {
+		GHSAID   string `json:"ghsa_id"`
+		CVEID    string `json:"cve_id"`
+		Summary  string `json:"summary"`
+		Severity string `json:"severity"`
+	} `json:"security_advisory"`
+	SecurityVulnerability struct {
+		VulnerableVersionRange string `json:"vulnerable_version_range"`
+		FirstPatchedVersion    *struct {
+			Identifier string `json:"identifier"`
+		} `json:"first_patched_version"`
+	} `json:"security_vulnerability"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	FixedAt         *time.Time `json:"fixed_at"`
+	DismissedAt     *time.Time `json:"dismissed_at"`
+	AutoDismissedAt *time.Time `json:"auto_dismissed_at"`
+}
+
+type repositoryAdvisory struct {
+	GHSAID          string `json:"ghsa_id"`
+	CVEID           string `json:"cve_id"`
+	Summary         string `json:"summary"`
+	Severity        string `json:"severity"`
+	State           string `json:"state"`
+	HTMLURL         string `json:"html_url"`
+	Vulnerabilities []struct {
+		Package                githubPackage `json:"package"`
+		VulnerableVersionRange string        `json:"vulnerable_version_range"`
+		PatchedVersions        string        `json:"patched_versions"`
+	} `json:"vulnerabilities"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	ClosedAt    *time.Time `json:"closed_at"`
+	WithdrawnAt *time.Time `json:"withdrawn_at"`
+}
+
+type githubPackage struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+}
+
+// GetDependabotAlerts returns all Dependabot alerts of a repository.
+// It returns nil without an error when the token has no access to them.
+func (s *GithubServiceImpl) GetDependabotAlerts(ctx context.Context, owner, repo string) ([]*entity.SecurityAlert, error) {
+	var result []*entity.SecurityAlert
+
+	err := s.listAllPages(ctx, fmt.Sprintf("repos/%s/%s/dependabot/alerts", owner, repo), func(req *http.Request) (*github.Response, error) {
+		var alerts []*dependabotAlert
+		resp, err := s.client.Do(ctx, req, &alerts)
+		if err != nil {
+			return resp, err
+		}
+
+		for _, alert := range alerts {
+			securityAlert := &entity.SecurityAlert{
+				Source:          entity.AlertSourceDependabot,
+				Number:          alert.Number,
+				GHSAID:          alert.SecurityAdvisory.GHSAID,
+				CVEID:           alert.SecurityAdvisory.CVEID,
+				Summary:         alert.SecurityAdvisory.Summary,
+				Severity:        alert.SecurityAdvisory.Severity,
+				Ecosystem:       alert.Dependency.Package.Ecosystem,
+				Package:         alert.Dependency.Package.Name,
+				VulnerableRange: alert.SecurityVulnerability.VulnerableVersionRange,
+				State:           alert.State,
+				Open:            alert.State == "open",
+				URL:             alert.HTMLURL,
+				CreatedAt:       alert.CreatedAt,
+				UpdatedAt:       alert.UpdatedAt,
+				FixedAt:         alert.FixedAt,
+				DismissedAt:     alert.DismissedAt,
+			}
+
+			if alert.SecurityVulnerability.FirstPatchedVersion != nil {
+				securityAlert.PatchedVersion = alert.SecurityVulnerability.FirstPatchedVersion.Identifier
+			}
+
+			if securityAlert.DismissedAt == nil {
+				securityAlert.DismissedAt = alert.AutoDismissedAt
+			}
+
+			result = append(result, securityAlert)
+		}
+
+		return resp, nil
+	})
+	if err != nil {
+		if isAccessDenied(err) {
+			s.logger.Warn("No access to Dependabot alerts of %s/%s: %v", owner, repo, err)
+			return nil, nil
+		}
+		s.logger.Error("Error getting Dependabot alerts: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetSecurityAdvisories returns repository security advisories, one alert per affected package.
+// It returns nil without an error when the token has no access to them.
+func (s *GithubServiceImpl) GetSecurityAdvisories(ctx context.Context, owner, repo string) ([]*entity.SecurityAlert, error) {
+	var result []*entity.SecurityAlert
+
+	err := s.listAllPages(ctx, fmt.Sprintf("repos/%s/%s/security-advisories", owner, repo), func(req *http.Request) (*github.Response, error) {
+		var advisories []*repositoryAdvisory
+		resp, err := s.client.Do(ctx, req, &advisories)
+		if err != nil {
+			return resp, err
+		}
+
+		for _, advisory := range advisories {
+			for _, vulnerability := range advisory.Vulnerabilities {
+				result = append(result, &entity.SecurityAlert{
+					Source:          entity.AlertSourceAdvisory,
+					GHSAID:          advisory.GHSAID,
+					CVEID:           advisory.CVEID,
+					Summary:         advisory.Summary,
+					Severity:        advisory.Severity,
+					Ecosystem:       vulnerability.Package.Ecosystem,
+					Package:         vulnerability.Package.Name,
+					VulnerableRange: vulnerability.VulnerableVersionRange,
+					PatchedVersion:  vulnerability.PatchedVersions,
+					State:           advisory.State,
+					// Triage and draft advisories describe vulnerabilities without a published fix
+					Open:      advisory.State == "triage" || advisory.State == "draft",
+					URL:       advisory.HTMLURL,
+					CreatedAt: advisory.CreatedAt,
+					UpdatedAt: advisory.UpdatedAt,
+					// Advisories tell when they were published, not when the repository was fixed,
+					// so they have no FixedAt
+					DismissedAt: firstTime(advisory.WithdrawnAt, advisory.ClosedAt),
+				})
+			}
+		}
+
+		return resp, nil
+	})
+	if err != nil {
+		if isAccessDenied(err) {
+			s.logger.Warn("No access to security advisories of %s/%s: %v", owner, repo, err)
+			return nil, nil
+		}
+		s.logger.Error("Error getting security advisories: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// listAllPages issues GET requests for every page of a list endpoint. The security endpoints page with
+// after/before cursors, which go-github does not parse, so the rel="next" link of each page is followed as is.
+func (s *GithubServiceImpl) listAllPages(ctx context.Context, path string, fetch func(req *http.Request) (*github.Response, error)) error {
+	next := path + "?per_page=100"
+	for next != "" {
+		req, err := s.client.NewRequest("GET", next, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := fetch(req)
+		if err != nil {
+			return err
+		}
+
+		next = nextLink(resp.Header.Get("Link"))
+	}
+
+	return nil
+}
+
+// nextLink returns the URL of the rel="next" entry of a Link header, empty on the last page
+func nextLink(header string) string {
+	for _, link := range strings.Split(header, ",") {
+		target, params, ok := strings.Cut(strings.TrimSpace(link), ";")
+		if !ok {
+			continue
+		}
+		for _, param := range strings.Split(params, ";") {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(target), "<>")
+			}
+		}
+	}
+	return ""
+}
+
+// isAccessDenied reports whether the GitHub API refused access to a resource (403 or 404)
+func isAccessDenied(err error) bool {
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return false
+	}
+	return errResp.Response.StatusCode == http.StatusForbidden || errResp.Response.StatusCode == http.StatusNotFound
+}
+
+// firstTime returns the first non-nil time
+func firstTime(times ...*time.Time) *time.Time {
+	for _, t := range times {
+		if t != nil {
+			return t
+		}
+	}
+	return nil
+}
@@ -2,7 +2,13 @@ package service
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
 	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
 	"github.com/google/go-github/v39/github"
 )
@@ -20,9 +26,14 @@ func NewGithubService(client *github.Client, logger *logger.Logger) *GithubServi
 }
 
 func (s *GithubServiceImpl) GetRepository(ctx context.Context, owner, name string) (*entity.Repository, error) {
+	// Renamed and transferred repositories answer with 301, which the HTTP client follows,
+	// so the returned repository carries its current full name
 	repo, _, err := s.client.Repositories.Get(ctx, owner, name)
 	if err != nil {
 		s.logger.Error("Ошибка получения репозитория: %v", err) // Замените на ваш логгер
+		if reason := goneReason(err); reason != "" {
+			return nil, &domainService.RepositoryGoneError{FullName: owner + "/" + name, Reason: reason, Err: err}
+		}
 		return nil, err
 	}
 
@@ -42,30 +53,23 @@ func (s *GithubServiceImpl) GetRepository(ctx context.Context, owner, name strin
 	}, nil
 }
 
-func (s *GithubServiceImpl) GetIssues(ctx context.Context, owner, repo string, page, perPage int) ([]*entity.Issue, error) {
+func (s *GithubServiceImpl) GetIssues(ctx context.Context, owner, repo string, page int, list domainService.ListOptions) ([]*entity.Issue, domainService.Page, error) {
 	opts := &github.IssueListByRepoOptions{
-		State:     "all", // Get all issues (open, closed)
-		Sort:      "created",
+		State:     listState(list),
+		Sort:      listSort(list),
 		Direction: "desc",
+		Since:     list.Since, // GitHub cuts the list at Since itself
 		ListOptions: github.ListOptions{
 			Page:    page,
-			PerPage: perPage,
+			PerPage: list.PerPage,
 		},
 	}
 
-	issues, _, err := s.client.Issues.ListByRepo(ctx, owner, repo, opts)
+	issues, resp, err := s.client.Issues.ListByRepo(ctx, owner, repo, opts)
 	if err != nil {
 		s.logger.Error("Error getting issues: %v", err)
-		return nil, err
-	}
-
-	// Получаем ID репозитория
-	repository, _, err := s.client.Repositories.Get(ctx, owner, repo)
-	if err != nil {
-		s.logger.Error("Error getting repository for issues: %v", err)
-		return nil, err
+		return nil, domainService.Page{}, err
 	}
-	repoID := repository.GetID()
 
 	var result []*entity.Issue
 	for _, issue := range issues {
@@ -73,17 +77,19 @@ func (s *GithubServiceImpl) GetIssues(ctx context.Context, owner, repo string, p
 		if issue.PullRequestLinks != nil {
 			continue
 		}
+		if !list.InWindow(issue.GetUpdatedAt()) {
+			continue
+		}
 
 		issueEntity := &entity.Issue{
-			ID:           issue.GetID(),
-			Number:       issue.GetNumber(),
-			Title:        issue.GetTitle(),
-			Body:         issue.GetBody(),
-			State:        issue.GetState(),
-			AuthorLogin:  issue.GetUser().GetLogin(),
-			RepositoryID: repoID,
-			CreatedAt:    issue.GetCreatedAt(),
-			UpdatedAt:    issue.GetUpdatedAt(),
+			ID:          issue.GetID(),
+			Number:      issue.GetNumber(),
+			Title:       issue.GetTitle(),
+			Body:        issue.GetBody(),
+			State:       issue.GetState(),
+			AuthorLogin: issue.GetUser().GetLogin(),
+			CreatedAt:   issue.GetCreatedAt(),
+			UpdatedAt:   issue.GetUpdatedAt(),
 		}
 
 		if issue.ClosedAt != nil {
@@ -94,46 +100,42 @@ func (s *GithubServiceImpl) GetIssues(ctx context.Context, owner, repo string, p
 		result = append(result, issueEntity)
 	}
 
-	return result, nil
+	return result, domainService.Page{Next: resp.NextPage, Last: resp.LastPage}, nil
 }
 
-func (s *GithubServiceImpl) GetPullRequests(ctx context.Context, owner, repo string, page, perPage int) ([]*entity.PullRequest, error) {
+func (s *GithubServiceImpl) GetPullRequests(ctx context.Context, owner, repo string, page int, list domainService.ListOptions) ([]*entity.PullRequest, domainService.Page, error) {
 	opts := &github.PullRequestListOptions{
-		State:     "all", // Get all PRs (open, closed, merged)
-		Sort:      "created",
+		State:     listState(list),
+		Sort:      listSort(list),
 		Direction: "desc",
 		ListOptions: github.ListOptions{
 			Page:    page,
-			PerPage: perPage,
+			PerPage: list.PerPage,
 		},
 	}
 
-	prs, _, err := s.client.PullRequests.List(ctx, owner, repo, opts)
+	prs, resp, err := s.client.PullRequests.List(ctx, owner, repo, opts)
 	if err != nil {
 		s.logger.Error("Error getting pull requests: %v", err)
-		return nil, err
-	}
-
-	// Получаем ID репозитория
-	repository, _, err := s.client.Repositories.Get(ctx, owner, repo)
-	if err != nil {
-		s.logger.Error("Error getting repository for PRs: %v", err)
-		return nil, err
+		return nil, domainService.Page{}, err
 	}
-	repoID := repository.GetID()
 
 	var result []*entity.PullRequest
 	for _, pr := range prs {
+		if !list.InWindow(pr.GetUpdatedAt()) {
+			continue
+		}
+
 		prEntity := &entity.PullRequest{
-			ID:           pr.GetID(),
-			Number:       pr.GetNumber(),
-			Title:        pr.GetTitle(),
-			Body:         pr.GetBody(),
-			State:        pr.GetState(),
-			AuthorLogin:  pr.GetUser().GetLogin(),
-			RepositoryID: repoID,
-			CreatedAt:    pr.GetCreatedAt(),
-			UpdatedAt:    pr.GetUpdatedAt(),
+			ID:          pr.GetID(),
+			Number:      pr.GetNumber(),
+			Title:       pr.GetTitle(),
+			Body:        pr.GetBody(),
+			State:       pr.GetState(),
+			AuthorLogin: pr.GetUser().GetLogin(),
+			HeadSHA:     pr.GetHead().GetSHA(),
+			CreatedAt:   pr.GetCreatedAt(),
+			UpdatedAt:   pr.GetUpdatedAt(),
 		}
 
 		if pr.ClosedAt != nil {
@@ -149,9 +151,283 @@ func (s *GithubServiceImpl) GetPullRequests(ctx context.Context, owner, repo str
 		result = append(result, prEntity)
 	}
 
+	// GitHub has no since parameter for pull requests: the list runs from the most recently updated one,
+	// so it ends with the first pull request updated before Since
+	next := domainService.Page{Next: resp.NextPage, Last: resp.LastPage}
+	if !list.Since.IsZero() && len(prs) > 0 && prs[len(prs)-1].GetUpdatedAt().Before(list.Since) {
+		next = domainService.Page{}
+	}
+
+	return result, next, nil
+}
+
+// listState returns the state parameter of a list request
+func listState(list domainService.ListOptions) string {
+	if list.State == "" {
+		return "all"
+	}
+	return list.State
+}
+
+// listSort returns the sort parameter of a list request: a time window is cut by update time.
+// Items updated while a windowed list is read move to its first page and push the rest down,
+// so a list resumed at a page number can miss items that crossed the page boundary meanwhile.
+func listSort(list domainService.ListOptions) string {
+	if list.Windowed() {
+		return "updated"
+	}
+	return "created"
+}
+
+// CountIssues asks for one issue per page, so the last page in the Link header is the number of issues
+func (s *GithubServiceImpl) CountIssues(ctx context.Context, owner, repo string, list domainService.ListOptions) (int, error) {
+	opts := &github.IssueListByRepoOptions{
+		State:       listState(list),
+		Since:       list.Since,
+		ListOptions: github.ListOptions{PerPage: 1},
+	}
+
+	issues, resp, err := s.client.Issues.ListByRepo(ctx, owner, repo, opts)
+	if err != nil {
+		s.logger.Error("Error counting issues: %v", err)
+		return 0, err
+	}
+
+	return listSize(resp, len(issues)), nil
+}
+
+// CountPullRequests counts pull requests the same way as CountIssues
+func (s *GithubServiceImpl) CountPullRequests(ctx context.Context, owner, repo string, list domainService.ListOptions) (int, error) {
+	opts := &github.PullRequestListOptions{
+		State:       listState(list),
+		ListOptions: github.ListOptions{PerPage: 1},
+	}
+
+	prs, resp, err := s.client.PullRequests.List(ctx, owner, repo, opts)
+	if err != nil {
+		s.logger.Error("Error counting pull requests: %v", err)
+		return 0, err
+	}
+
+	return listSize(resp, len(prs)), nil
+}
+
+// listSize returns the size of a list fetched one item per page.
+// A list of at most one item has no Link header, its only page tells the size.
+func listSize(resp *github.Response, fetched int) int {
+	if resp.LastPage > 0 {
+		return resp.LastPage
+	}
+	return fetched
+}
+
+func (s *GithubServiceImpl) GetRateLimit(ctx context.Context) (*domainService.RateLimit, error) {
+	limits, _, err := s.client.RateLimits(ctx)
+	if err != nil {
+		s.logger.Error("Error getting rate limit: %v", err)
+		return nil, err
+	}
+
+	core := limits.GetCore()
+	return &domainService.RateLimit{
+		Limit:     core.Limit,
+		Remaining: core.Remaining,
+		Reset:     core.Reset.Time,
+	}, nil
+}
+
+func (s *GithubServiceImpl) ListIssueNumbers(ctx context.Context, owner, repo string) ([]int, error) {
+	opts := &github.IssueListByRepoOptions{
+		State:       "all",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var numbers []int
+	for {
+		issues, resp, err := s.client.Issues.ListByRepo(ctx, owner, repo, opts)
+		if err != nil {
+			s.logger.Error("Error listing issue numbers: %v", err)
+			return nil, err
+		}
+
+		for _, issue := range issues {
+			// Пропускаем pull requests, так как у них есть поле PullRequestLinks
+			if issue.PullRequestLinks != nil {
+				continue
+			}
+			numbers = append(numbers, issue.GetNumber())
+		}
+
+		if resp.NextPage == 0 {
+			return numbers, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func (s *GithubServiceImpl) ListPullRequestNumbers(ctx context.Context, owner, repo string) ([]int, error) {
+	opts := &github.PullRequestListOptions{
+		State:       "all",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var numbers []int
+	for {
+		prs, resp, err := s.client.PullRequests.List(ctx, owner, repo, opts)
+		if err != nil {
+			s.logger.Error("Error listing pull request numbers: %v", err)
+			return nil, err
+		}
+
+		for _, pr := range prs {
+			numbers = append(numbers, pr.GetNumber())
+		}
+
+		if resp.NextPage == 0 {
+			return numbers, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func (s *GithubServiceImpl) GetIssueLocation(ctx context.Context, owner, repo string, number int) (string, error) {
+	// Transferred issues answer with 301 to their new location, which the HTTP client follows
+	issue, _, err := s.client.Issues.Get(ctx, owner, repo, number)
+	if err != nil {
+		var errResp *github.ErrorResponse
+		if errors.As(err, &errResp) && errResp.Response != nil &&
+			(errResp.Response.StatusCode == http.StatusNotFound || errResp.Response.StatusCode == http.StatusGone) {
+			return "", nil
+		}
+		s.logger.Error("Error getting issue #%d: %v", number, err)
+		return "", err
+	}
+
+	// repository_url has the form https://api.github.com/repos/{owner}/{repo}
+	repositoryURL := issue.GetRepositoryURL()
+	if i := strings.Index(repositoryURL, "/repos/"); i >= 0 {
+		return repositoryURL[i+len("/repos/"):], nil
+	}
+
+	return owner + "/" + repo, nil
+}
+
+// GetCIChecks returns commit statuses and check runs reported for the given ref
+func (s *GithubServiceImpl) GetCIChecks(ctx context.Context, owner, repo, ref string) ([]*entity.CICheck, error) {
+	// Large CI matrices span several pages of both lists
+	var statuses []*github.RepoStatus
+	statusOpts := &github.ListOptions{PerPage: 100}
+	for {
+		combined, resp, err := s.client.Repositories.GetCombinedStatus(ctx, owner, repo, ref, statusOpts)
+		if err != nil {
+			s.logger.Error("Error getting combined status: %v", err)
+			return nil, err
+		}
+		statuses = append(statuses, combined.Statuses...)
+		if resp.NextPage == 0 {
+			break
+		}
+		statusOpts.Page = resp.NextPage
+	}
+
+	var checkRuns []*github.CheckRun
+	runOpts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		runs, resp, err := s.client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, runOpts)
+		if err != nil {
+			s.logger.Error("Error getting check runs: %v", err)
+			return nil, err
+		}
+		checkRuns = append(checkRuns, runs.CheckRuns...)
+		if resp.NextPage == 0 {
+			break
+		}
+		runOpts.Page = resp.NextPage
+	}
+
+	var result []*entity.CICheck
+	for _, status := range statuses {
+		check := &entity.CICheck{
+			ID:       status.GetID(),
+			Source:   entity.CISourceStatus,
+			Name:     status.GetContext(),
+			State:    statusCIState(status.GetState()),
+			RawState: status.GetState(),
+			URL:      status.GetTargetURL(),
+			HeadSHA:  ref,
+		}
+
+		if status.CreatedAt != nil {
+			startedAt := status.GetCreatedAt()
+			check.StartedAt = &startedAt
+		}
+
+		// Статус без ожидания считается завершённым в момент последнего обновления
+		if status.UpdatedAt != nil && check.State != entity.CIStatePending {
+			completedAt := status.GetUpdatedAt()
+			check.CompletedAt = &completedAt
+		}
+
+		result = append(result, check)
+	}
+
+	for _, run := range checkRuns {
+		check := &entity.CICheck{
+			ID:       run.GetID(),
+			Source:   entity.CISourceCheckRun,
+			Name:     run.GetName(),
+			State:    checkRunCIState(run.GetStatus(), run.GetConclusion()),
+			RawState: run.GetConclusion(),
+			URL:      run.GetHTMLURL(),
+			HeadSHA:  ref,
+		}
+
+		if check.RawState == "" {
+			check.RawState = run.GetStatus()
+		}
+
+		if run.StartedAt != nil {
+			check.StartedAt = &run.StartedAt.Time
+		}
+
+		if run.CompletedAt != nil {
+			check.CompletedAt = &run.CompletedAt.Time
+		}
+
+		result = append(result, check)
+	}
+
 	return result, nil
 }
 
+// statusCIState maps a commit status state to a normalized CI state
+func statusCIState(state string) string {
+	switch state {
+	case "success":
+		return entity.CIStatePassed
+	case "failure", "error":
+		return entity.CIStateFailed
+	default:
+		return entity.CIStatePending
+	}
+}
+
+// checkRunCIState maps a check run status and conclusion to a normalized CI state
+func checkRunCIState(status, conclusion string) string {
+	if status != "completed" {
+		return entity.CIStatePending
+	}
+
+	switch conclusion {
+	case "success", "neutral", "skipped":
+		return entity.CIStatePassed
+	case "stale":
+		return entity.CIStatePending
+	default:
+		return entity.CIStateFailed
+	}
+}
+
 func (s *GithubServiceImpl) GetUser(ctx context.Context, username string) (*entity.User, error) {
 	user, _, err := s.client.Users.Get(ctx, username)
 	if err != nil {
@@ -174,3 +450,73 @@ func (s *GithubServiceImpl) GetUser(ctx context.Context, username string) (*enti
 
 	return userEntity, nil
 }
+
+func (s *GithubServiceImpl) GetFileContent(ctx context.Context, owner, repo, path string) (*entity.RepositoryFile, error) {
+	content, _, _, err := s.client.Repositories.GetContents(ctx, owner, repo, path, nil)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		s.logger.Error("Error getting content of %s: %v", path, err)
+		return nil, err
+	}
+
+	// A directory has no file content
+	if content == nil {
+		return nil, nil
+	}
+
+	return toRepositoryFile(content)
+}
+
+func (s *GithubServiceImpl) GetReadme(ctx context.Context, owner, repo string) (*entity.RepositoryFile, error) {
+	content, _, err := s.client.Repositories.GetReadme(ctx, owner, repo, nil)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		s.logger.Error("Error getting README: %v", err)
+		return nil, err
+	}
+
+	return toRepositoryFile(content)
+}
+
+// toRepositoryFile decodes contents API payload into a repository file
+func toRepositoryFile(content *github.RepositoryContent) (*entity.RepositoryFile, error) {
+	decoded, err := content.GetContent()
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.RepositoryFile{
+		Path:      content.GetPath(),
+		SHA:       content.GetSHA(),
+		Size:      content.GetSize(),
+		Content:   decoded,
+		FetchedAt: time.Now(),
+	}, nil
+}
+
+// goneReason classifies 404 and 451 responses; it returns an empty string for other errors
+func goneReason(err error) string {
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return ""
+	}
+
+	switch errResp.Response.StatusCode {
+	case http.StatusNotFound:
+		return "not_found"
+	case http.StatusUnavailableForLegalReasons:
+		return "unavailable_for_legal_reasons"
+	default:
+		return ""
+	}
+}
+
+// isNotFound reports whether the GitHub API answered with 404
+func isNotFound(err error) bool {
+	var errResp *github.ErrorResponse
+	return errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusNotFound
+}
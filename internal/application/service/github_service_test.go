@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	githubClient "github.com/Dhoini/GitHub_Parser/internal/infrastructure/github"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/cassette"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+	"github.com/google/go-github/v39/github"
+)
+
+// newTestGithubService creates a GitHub service replaying testdata/cassettes/<name>.json
+func newTestGithubService(t *testing.T, name string) *GithubServiceImpl {
+	t.Helper()
+
+	replayer, err := cassette.NewReplayer(filepath.Join("testdata", "cassettes", name+".json"))
+	if err != nil {
+		t.Fatalf("open cassette %s: %v", name, err)
+	}
+
+	client := githubClient.NewGithubClient("test-token", nil, testLogger(), githubClient.WithTransport(replayer))
+	return NewGithubService(client.GetClient(), testLogger())
+}
+
+// testLogger only lets fatal messages through to keep test output readable
+func testLogger() *logger.Logger {
+	return logger.New(logger.FATAL)
+}
+
+func TestGetRepository(t *testing.T) {
+	s := newTestGithubService(t, "repository")
+
+	repo, err := s.GetRepository(context.Background(), "octo", "demo")
+	if err != nil {
+		t.Fatalf("GetRepository: %v", err)
+	}
+
+	if repo.ID != 1001 || repo.FullName != "octo/demo" || repo.OwnerLogin != "octo" || repo.StarsCount != 42 {
+		t.Errorf("unexpected repository: %+v", repo)
+	}
+}
+
+func TestGetRepositoryNotFound(t *testing.T) {
+	s := newTestGithubService(t, "repository_not_found")
+
+	_, err := s.GetRepository(context.Background(), "octo", "gone")
+
+	var goneErr *domainService.RepositoryGoneError
+	if !errors.As(err, &goneErr) {
+		t.Fatalf("err = %v, want RepositoryGoneError", err)
+	}
+	if goneErr.Reason != "not_found" || goneErr.FullName != "octo/gone" {
+		t.Errorf("unexpected error: %+v", goneErr)
+	}
+}
+
+func TestGetRepositoryRateLimited(t *testing.T) {
+	s := newTestGithubService(t, "rate_limited")
+
+	_, err := s.GetRepository(context.Background(), "octo", "demo")
+
+	var rateErr *github.RateLimitError
+	if !errors.As(err, &rateErr) {
+		t.Fatalf("err = %v, want RateLimitError", err)
+	}
+	if rateErr.Rate.Remaining != 0 {
+		t.Errorf("remaining = %d, want 0", rateErr.Rate.Remaining)
+	}
+}
+
+func TestGetIssuesPagination(t *testing.T) {
+	s := newTestGithubService(t, "issues_pagination")
+	ctx := context.Background()
+
+	// The first page mixes an issue with a pull request, which is skipped
+	first, next, err := s.GetIssues(ctx, "octo", "demo", 1, domainService.ListOptions{PerPage: 2})
+	if err != nil {
+		t.Fatalf("GetIssues page 1: %v", err)
+	}
+	if len(first) != 1 || first[0].Number != 3 {
+		t.Fatalf("page 1 = %+v, want issue #3", first)
+	}
+	if next.Next != 2 || next.Last != 2 {
+		t.Fatalf("page 1 is followed by %+v, want next and last page 2", next)
+	}
+
+	second, next, err := s.GetIssues(ctx, "octo", "demo", 2, domainService.ListOptions{PerPage: 2})
+	if err != nil {
+		t.Fatalf("GetIssues page 2: %v", err)
+	}
+	if len(second) != 1 || second[0].Number != 1 {
+		t.Fatalf("page 2 = %+v, want issue #1", second)
+	}
+	if next.Next != 0 {
+		t.Errorf("next page after the last page = %d, want 0", next.Next)
+	}
+	if second[0].State != "closed" || second[0].ClosedAt == nil {
+		t.Errorf("issue #1 should be closed: %+v", second[0])
+	}
+}
+
+func TestGetCIChecks(t *testing.T) {
+	s := newTestGithubService(t, "ci_checks")
+
+	checks, err := s.GetCIChecks(context.Background(), "octo", "demo", "abc123")
+	if err != nil {
+		t.Fatalf("GetCIChecks: %v", err)
+	}
+
+	states := make(map[string]string)
+	for _, check := range checks {
+		states[check.Source+"/"+check.Name] = check.State
+	}
+
+	if len(checks) != 4 {
+		t.Fatalf("got %d checks, want 4: %v", len(checks), states)
+	}
+
+	summary := summarizeCIChecks(checks)
+	want := entity.CISummary{State: entity.CIStateFailed, Passed: 2, Failed: 1, Pending: 1}
+	if summary != want {
+		t.Errorf("summary = %+v, want %+v", summary, want)
+	}
+}
+
+func TestGetSecurityAlertsFollowsCursors(t *testing.T) {
+	s := newTestGithubService(t, "security_alerts")
+	ctx := context.Background()
+
+	// Both endpoints page with an after cursor in the Link header instead of page numbers
+	alerts, err := s.GetDependabotAlerts(ctx, "octo", "demo")
+	if err != nil {
+		t.Fatalf("GetDependabotAlerts: %v", err)
+	}
+	if len(alerts) != 3 || alerts[2].Number != 1 {
+		t.Fatalf("got %d Dependabot alerts, want 3 from two pages: %+v", len(alerts), alerts)
+	}
+	if fixed := alerts[1]; fixed.Open || fixed.FixedAt == nil || fixed.PatchedVersion != "1.2.0" {
+		t.Errorf("fixed alert = %+v", fixed)
+	}
+	if dismissed := alerts[2]; dismissed.Open || dismissed.DismissedAt == nil {
+		t.Errorf("dismissed alert = %+v", dismissed)
+	}
+
+	advisories, err := s.GetSecurityAdvisories(ctx, "octo", "demo")
+	if err != nil {
+		t.Fatalf("GetSecurityAdvisories: %v", err)
+	}
+	if len(advisories) != 3 || advisories[2].GHSAID != "GHSA-bbbb-0002" || !advisories[2].Open {
+		t.Fatalf("got %d advisory alerts, want 3 from two pages: %+v", len(advisories), advisories)
+	}
+	for _, advisory := range advisories {
+		if advisory.FixedAt != nil {
+			t.Errorf("advisory %s for %s has FixedAt %s", advisory.GHSAID, advisory.Package, advisory.FixedAt)
+		}
+	}
+}
+
+func TestGetSecurityAlertsNoAccess(t *testing.T) {
+	s := newTestGithubService(t, "security_alerts_no_access")
+	ctx := context.Background()
+
+	// 403 and 404 mean the token cannot see the alerts, which is not an error
+	alerts, err := s.GetDependabotAlerts(ctx, "octo", "demo")
+	if err != nil || alerts != nil {
+		t.Errorf("GetDependabotAlerts = %v, %v, want nothing", alerts, err)
+	}
+	advisories, err := s.GetSecurityAdvisories(ctx, "octo", "demo")
+	if err != nil || advisories != nil {
+		t.Errorf("GetSecurityAdvisories = %v, %v, want nothing", advisories, err)
+	}
+}
+
+func TestGetCIChecksPagination(t *testing.T) {
+	s := newTestGithubService(t, "ci_checks_pagination")
+
+	// 101 statuses and 102 check runs, the failures on the second pages
+	checks, err := s.GetCIChecks(context.Background(), "octo", "demo", "abc123")
+	if err != nil {
+		t.Fatalf("GetCIChecks: %v", err)
+	}
+	if len(checks) != 203 {
+		t.Fatalf("got %d checks, want 203 from two pages of each list", len(checks))
+	}
+
+	summary := summarizeCIChecks(checks)
+	want := entity.CISummary{State: entity.CIStateFailed, Passed: 201, Failed: 2}
+	if summary != want {
+		t.Errorf("summary = %+v, want %+v", summary, want)
+	}
+}
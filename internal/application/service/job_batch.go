@@ -0,0 +1,332 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/uuid"
+)
+
+// maxBatchTargets limits the number of repositories of one batch job
+const maxBatchTargets = 1000
+
+// StartBatchParsingJob creates a batch job with one child job per repository.
+// Children are queued as far as the queue allows; the rest follow as earlier children finish.
+func (s *ParserServiceImpl) StartBatchParsingJob(ctx context.Context, params domainService.ParsingJobParams, targets []string) (*domainService.ParsingJobStatus, error) {
+	repos, err := batchTargets(targets)
+	if err != nil {
+		return nil, err
+	}
+
+	childType := params.JobType
+	if childType == "" {
+		childType = entity.JobTypeParse
+	}
+	if childType == entity.JobTypeBatch {
+		return nil, fmt.Errorf("%w: batch jobs cannot be nested", domainService.ErrInvalidBatch)
+	}
+	if err := domainService.ValidateJobOptions(params); err != nil {
+		return nil, err
+	}
+	if err := s.CheckCallback(params.CallbackURL); err != nil {
+		return nil, err
+	}
+	if params.Retry.MaxAttempts == 0 {
+		params.Retry = s.retryPolicy
+	}
+
+	now := time.Now()
+	parentParams := params
+	parentParams.JobType = entity.JobTypeBatch
+	parentParams.OwnerName = ""
+	parentParams.RepoName = ""
+
+	parent := &entity.ParsingJob{
+		ID:     uuid.New(),
+		Params: parentParams,
+		Status: entity.JobStatusInProgress,
+		Transitions: []entity.JobTransition{{
+			Status:  entity.JobStatusInProgress,
+			At:      now,
+			Message: fmt.Sprintf("batch of %d repositories", len(repos)),
+		}},
+		Batch:     &entity.BatchSummary{JobType: childType, Total: len(repos), Running: len(repos)},
+		CreatedAt: now,
+		UpdatedAt: now,
+		StartedAt: &now,
+	}
+
+	children := make([]*entity.ParsingJob, 0, len(repos))
+	for i, repo := range repos {
+		childParams := params
+		childParams.JobType = childType
+		childParams.OwnerName = repo[0]
+		childParams.RepoName = repo[1]
+		childParams.ParentID = parent.ID
+		// Only the batch itself calls back
+		childParams.CallbackURL = ""
+
+		child := newPendingJob(fmt.Sprintf("%s-%d", parent.ID, i+1), childParams, now)
+		children = append(children, child)
+		parent.Batch.JobIDs = append(parent.Batch.JobIDs, child.ID)
+	}
+
+	if err := s.jobRepo.Save(ctx, parent); err != nil {
+		s.logger.Error("Failed to save batch job: %v", err)
+		return nil, err
+	}
+
+	for i, child := range children {
+		if err := s.jobRepo.Save(ctx, child); err != nil {
+			s.logger.Error("Failed to save child job %s of batch %s: %v", child.ID, parent.ID, err)
+			s.rejectBatch(ctx, parent, children[:i], err)
+			return nil, err
+		}
+	}
+
+	if s.metrics != nil {
+		s.metrics.ParsingJobs.WithLabelValues(entity.JobStatusPending).Add(float64(len(children)))
+		s.metrics.ParsingJobsTotal.Add(float64(len(children)))
+	}
+
+	s.batchMu.Lock()
+	s.feedBatch(ctx, children)
+	s.batchMu.Unlock()
+
+	s.logger.Info("Started batch job %s over %d repositories", parent.ID, len(repos))
+	return domainService.NewParsingJobStatus(parent), nil
+}
+
+// batchTargets validates "owner/repo" targets and drops duplicates, keeping the order
+func batchTargets(targets []string) ([][2]string, error) {
+	seen := make(map[string]bool, len(targets))
+	var repos [][2]string
+
+	for _, target := range targets {
+		target = strings.TrimSpace(target)
+		owner, name, ok := strings.Cut(target, "/")
+		if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+			return nil, fmt.Errorf("%w: target %q is not owner/repo", domainService.ErrInvalidBatch, target)
+		}
+
+		key := strings.ToLower(target)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		repos = append(repos, [2]string{owner, name})
+	}
+
+	if len(repos) == 0 {
+		return nil, fmt.Errorf("%w: no repositories given", domainService.ErrInvalidBatch)
+	}
+	if len(repos) > maxBatchTargets {
+		return nil, fmt.Errorf("%w: %d repositories, at most %d are allowed", domainService.ErrInvalidBatch, len(repos), maxBatchTargets)
+	}
+
+	return repos, nil
+}
+
+// rejectBatch fails a batch whose children could not all be saved, along with the saved ones
+func (s *ParserServiceImpl) rejectBatch(ctx context.Context, parent *entity.ParsingJob, saved []*entity.ParsingJob, err error) {
+	message := fmt.Sprintf("rejected: %v", err)
+
+	parent.ErrorMessage = message
+	s.transitionJob(ctx, parent, entity.JobStatusFailed, message)
+
+	for _, child := range saved {
+		child.ErrorMessage = message
+		s.transitionJob(ctx, child, entity.JobStatusFailed, message)
+	}
+}
+
+// feedBatch queues pending children in target order until the queue is full; callers hold s.batchMu
+func (s *ParserServiceImpl) feedBatch(ctx context.Context, children []*entity.ParsingJob) {
+	for _, child := range children {
+		if child.Status != entity.JobStatusPending {
+			continue
+		}
+
+		// Children already queued, waiting for a retry or held by another replica are skipped
+		if err := s.enqueueJob(ctx, child); errors.Is(err, domainService.ErrJobQueueFull) {
+			return
+		}
+	}
+}
+
+// batchChildren loads the child jobs of a batch in target order
+func (s *ParserServiceImpl) batchChildren(ctx context.Context, parent *entity.ParsingJob) ([]*entity.ParsingJob, error) {
+	jobs, err := s.jobRepo.List(ctx, repository.JobFilter{ParentID: parent.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*entity.ParsingJob, len(jobs))
+	for _, job := range jobs {
+		byID[job.ID] = job
+	}
+
+	children := make([]*entity.ParsingJob, 0, len(jobs))
+	for _, id := range parent.Batch.JobIDs {
+		if child, ok := byID[id]; ok {
+			children = append(children, child)
+		}
+	}
+	return children, nil
+}
+
+// summarizeBatch counts the children of a batch by outcome and sets its progress.
+// A finished child counts as done whatever its outcome.
+func summarizeBatch(parent *entity.ParsingJob, children []*entity.ParsingJob) {
+	batch := parent.Batch
+	batch.Total = len(batch.JobIDs)
+	batch.Completed, batch.Failed, batch.Cancelled = 0, 0, 0
+
+	progress := 0
+	for _, child := range children {
+		switch child.Status {
+		case entity.JobStatusCompleted:
+			batch.Completed++
+		case entity.JobStatusFailed, entity.JobStatusDeadLetter, entity.JobStatusInterrupted:
+			batch.Failed++
+		case entity.JobStatusCancelled:
+			batch.Cancelled++
+		}
+
+		if child.Finished() {
+			progress += 100
+		} else {
+			progress += child.Progress
+		}
+	}
+	batch.Running = batch.Total - batch.Completed - batch.Failed - batch.Cancelled
+
+	if batch.Total > 0 {
+		parent.Progress = progress / batch.Total
+	}
+}
+
+// updateBatch brings a batch up to date after one of its children finished:
+// it queues more children while some are left and finishes the batch after the last one.
+// The batch fails when any child failed.
+func (s *ParserServiceImpl) updateBatch(ctx context.Context, parentID string) {
+	s.batchMu.Lock()
+	defer s.batchMu.Unlock()
+
+	parent, err := s.jobRepo.FindByID(ctx, parentID)
+	if err != nil || parent == nil || parent.Batch == nil {
+		s.logger.Error("Failed to load batch job %s: %v", parentID, err)
+		return
+	}
+
+	children, err := s.batchChildren(ctx, parent)
+	if err != nil {
+		s.logger.Error("Failed to load child jobs of batch %s: %v", parentID, err)
+		return
+	}
+
+	progress := parent.Progress
+	summarizeBatch(parent, children)
+
+	// A cancelled or rejected batch only keeps its counts current
+	if parent.Finished() {
+		parent.UpdatedAt = time.Now()
+		s.saveJob(ctx, parent)
+		return
+	}
+
+	if parent.Batch.Running > 0 {
+		s.feedBatch(ctx, children)
+		parent.UpdatedAt = time.Now()
+		s.saveJob(ctx, parent)
+		if parent.Progress != progress {
+			s.publishJobEvent(parent, entity.JobEventProgress, "")
+		}
+		return
+	}
+
+	batch := parent.Batch
+	if batch.Failed > 0 {
+		parent.ErrorMessage = fmt.Sprintf("%d of %d repositories failed", batch.Failed, batch.Total)
+		s.logger.Error("Batch job %s failed: %s", parent.ID, parent.ErrorMessage)
+		s.transitionJob(ctx, parent, entity.JobStatusFailed, parent.ErrorMessage)
+		return
+	}
+
+	s.transitionJob(ctx, parent, entity.JobStatusCompleted, fmt.Sprintf("%d completed, %d cancelled", batch.Completed, batch.Cancelled))
+	s.logger.Info("Batch job %s completed: %d of %d repositories", parent.ID, batch.Completed, batch.Total)
+}
+
+// batchStatus reports a batch job with the live state of its children
+func (s *ParserServiceImpl) batchStatus(ctx context.Context, parent *entity.ParsingJob) (*domainService.ParsingJobStatus, error) {
+	children, err := s.batchChildren(ctx, parent)
+	if err != nil {
+		s.logger.Error("Failed to load child jobs of batch %s: %v", parent.ID, err)
+		return nil, err
+	}
+
+	summarizeBatch(parent, children)
+	status := domainService.NewParsingJobStatus(parent)
+	for _, child := range children {
+		status.Children = append(status.Children, domainService.BatchChild{
+			JobID:        child.ID,
+			OwnerName:    child.Params.OwnerName,
+			RepoName:     child.Params.RepoName,
+			Status:       child.Status,
+			Progress:     child.Progress,
+			ErrorMessage: child.ErrorMessage,
+			Results:      child.Results,
+		})
+	}
+
+	return status, nil
+}
+
+// cancelBatch cancels a batch and every child that has not finished yet
+func (s *ParserServiceImpl) cancelBatch(ctx context.Context, parentID string) (*domainService.ParsingJobStatus, error) {
+	// Once the batch is cancelled no more children are queued
+	s.batchMu.Lock()
+	parent, err := s.findJob(ctx, parentID)
+	if err != nil {
+		s.batchMu.Unlock()
+		return nil, err
+	}
+	if parent.Finished() {
+		s.batchMu.Unlock()
+		return nil, fmt.Errorf("%w: job %s is %s", domainService.ErrJobState, parentID, parent.Status)
+	}
+	parent.ErrorMessage = "cancelled by request"
+	s.transitionJob(ctx, parent, entity.JobStatusCancelled, parent.ErrorMessage)
+	s.batchMu.Unlock()
+
+	children, err := s.batchChildren(ctx, parent)
+	if err != nil {
+		s.logger.Error("Failed to load child jobs of batch %s: %v", parentID, err)
+		return nil, err
+	}
+
+	for _, child := range children {
+		if child.Finished() {
+			continue
+		}
+
+		if _, err := s.stopParsingJob(ctx, child.ID, errJobCancelled); err == nil {
+			continue
+		}
+
+		// Children the batch never queued are cancelled in place
+		current, err := s.findJob(ctx, child.ID)
+		if err == nil && current.Status == entity.JobStatusPending {
+			s.recordJobStop(ctx, current, errJobCancelled, entity.JobStatusPending)
+		}
+	}
+
+	s.logger.Info("Batch job %s cancelled", parentID)
+	return s.GetParsingJobStatus(ctx, parentID)
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/fakegithub"
+)
+
+func TestBatchTargets(t *testing.T) {
+	repos, err := batchTargets([]string{" octo/demo ", "octo/tools", "Octo/Demo"})
+	if err != nil {
+		t.Fatalf("batchTargets: %v", err)
+	}
+	if len(repos) != 2 || repos[0] != [2]string{"octo", "demo"} || repos[1] != [2]string{"octo", "tools"} {
+		t.Errorf("repos = %v", repos)
+	}
+
+	tooMany := make([]string, 0, maxBatchTargets+1)
+	for i := 0; i <= maxBatchTargets; i++ {
+		tooMany = append(tooMany, fmt.Sprintf("octo/repo-%d", i))
+	}
+
+	for _, targets := range [][]string{nil, {"demo"}, {"/demo"}, {"octo/"}, {"octo/demo/issues"}, tooMany} {
+		if _, err := batchTargets(targets); !errors.Is(err, domainService.ErrInvalidBatch) {
+			t.Errorf("batchTargets(%d targets): err = %v, want ErrInvalidBatch", len(targets), err)
+		}
+	}
+}
+
+func TestBatchParsingJobRollsUpChildren(t *testing.T) {
+	s := newFakeParserService(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	started, err := s.StartBatchParsingJob(ctx, entity.ParsingJobParams{ParseIssues: true, CallbackURL: "https://example.com/hook"},
+		[]string{"octo/demo", "octo/missing", "octo/tools"})
+	if err != nil {
+		t.Fatalf("StartBatchParsingJob: %v", err)
+	}
+	if started.Batch == nil || len(started.Batch.JobIDs) != 3 {
+		t.Fatalf("batch = %+v, want 3 children", started.Batch)
+	}
+
+	// Children run the parameters of the batch for their own repository; only the batch calls back
+	for i, repo := range []string{"demo", "missing", "tools"} {
+		child, err := s.jobRepo.FindByID(ctx, started.Batch.JobIDs[i])
+		if err != nil || child == nil {
+			t.Fatalf("find child %d: %v", i, err)
+		}
+		if p := child.Params; p.ParentID != started.ID || p.OwnerName != "octo" || p.RepoName != repo ||
+			p.JobType != entity.JobTypeParse || !p.ParseIssues || p.CallbackURL != "" {
+			t.Errorf("child %d params = %+v", i, p)
+		}
+	}
+
+	parent := waitForJobStatus(t, s, started.ID)
+	if parent.Status != entity.JobStatusFailed || parent.ErrorMessage != "1 of 3 repositories failed" || parent.Progress != 100 {
+		t.Fatalf("batch finished as %s (%d%%): %q", parent.Status, parent.Progress, parent.ErrorMessage)
+	}
+	if b := parent.Batch; b.Total != 3 || b.Completed != 2 || b.Failed != 1 || b.Cancelled != 0 || b.Running != 0 {
+		t.Errorf("batch summary = %+v", b)
+	}
+
+	status, err := s.GetParsingJobStatus(ctx, started.ID)
+	if err != nil {
+		t.Fatalf("GetParsingJobStatus: %v", err)
+	}
+	if len(status.Children) != 3 || status.Children[1].RepoName != "missing" || status.Children[1].Status != entity.JobStatusFailed {
+		t.Errorf("children = %+v", status.Children)
+	}
+}
+
+func TestSummarizeBatch(t *testing.T) {
+	parent := &entity.ParsingJob{Batch: &entity.BatchSummary{JobIDs: []string{"a", "b", "c", "d"}}}
+	summarizeBatch(parent, []*entity.ParsingJob{
+		{ID: "a", Status: entity.JobStatusCompleted, Progress: 100},
+		{ID: "b", Status: entity.JobStatusDeadLetter, Progress: 40},
+		{ID: "c", Status: entity.JobStatusInProgress, Progress: 50},
+		{ID: "d", Status: entity.JobStatusPending},
+	})
+
+	// A finished child counts as done whatever its outcome
+	if b := parent.Batch; b.Total != 4 || b.Completed != 1 || b.Failed != 1 || b.Running != 2 {
+		t.Errorf("batch summary = %+v", b)
+	}
+	if parent.Progress != 62 {
+		t.Errorf("progress = %d, want 62", parent.Progress)
+	}
+}
+
+func TestCancelBatchParsingJob(t *testing.T) {
+	fake := fakegithub.New(nil)
+	fake.SetLatency(100 * time.Millisecond)
+	s := newFakeParserService(t, fake)
+	ctx := context.Background()
+
+	// Two workers take the first children, the third waits in the queue
+	started, err := s.StartBatchParsingJob(ctx, entity.ParsingJobParams{ParseIssues: true},
+		[]string{"octo/demo", "octo/tools", "octo/other"})
+	if err != nil {
+		t.Fatalf("StartBatchParsingJob: %v", err)
+	}
+
+	status, err := s.CancelParsingJob(ctx, started.ID)
+	if err != nil {
+		t.Fatalf("CancelParsingJob: %v", err)
+	}
+	if status.Status != entity.JobStatusCancelled || status.Batch.Cancelled != 3 || status.Batch.Running != 0 {
+		t.Fatalf("batch after cancel = %s, summary %+v", status.Status, status.Batch)
+	}
+	for _, child := range status.Children {
+		if child.Status != entity.JobStatusCancelled {
+			t.Errorf("child %s is %s, want cancelled", child.RepoName, child.Status)
+		}
+	}
+
+	// Finishing children do not reopen the batch
+	if parent := waitForJobStatus(t, s, started.ID); parent.Status != entity.JobStatusCancelled {
+		t.Errorf("stored batch = %s, want cancelled", parent.Status)
+	}
+	if _, err := s.CancelParsingJob(ctx, started.ID); !errors.Is(err, domainService.ErrJobState) {
+		t.Errorf("cancelling a cancelled batch: got %v, want ErrJobState", err)
+	}
+}
@@ -0,0 +1,386 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/uuid"
+)
+
+// Headers of a callback request
+const (
+	CallbackEventHeader     = "X-Parser-Event"
+	CallbackDeliveryHeader  = "X-Parser-Delivery"
+	CallbackTimestampHeader = "X-Parser-Timestamp"
+	CallbackSignatureHeader = "X-Parser-Signature-256"
+)
+
+// Defaults of CallbackConfig
+var defaultCallbackRetry = entity.RetryPolicy{MaxAttempts: 5, Backoff: time.Second, MaxBackoff: time.Minute}
+
+const defaultCallbackTimeout = 10 * time.Second
+
+// CallbackConfig controls how job callbacks are delivered
+type CallbackConfig struct {
+	// Secret signs every callback; jobs asking for a callback are rejected while it is empty
+	Secret string
+	// Retry sets the number of delivery attempts and the backoff between them
+	Retry entity.RetryPolicy
+	// Timeout bounds one attempt
+	Timeout time.Duration
+}
+
+// idle is the longest a delivery in progress goes without an attempt:
+// the longest backoff followed by an attempt that times out
+func (c CallbackConfig) idle() time.Duration {
+	return c.Retry.Delay(c.Retry.MaxAttempts) + c.Timeout
+}
+
+// withDefaults fills in unset fields
+func (c CallbackConfig) withDefaults() CallbackConfig {
+	if c.Retry.MaxAttempts <= 0 {
+		c.Retry = defaultCallbackRetry
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = defaultCallbackTimeout
+	}
+	return c
+}
+
+// SignCallback returns the signature header value of a callback body sent at timestamp:
+// "sha256=" and the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the secret
+func SignCallback(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// CheckCallback rejects a callback URL this replica cannot deliver to
+func (s *ParserServiceImpl) CheckCallback(callbackURL string) error {
+	if callbackURL == "" {
+		return nil
+	}
+	if err := domainService.ValidateCallbackURL(callbackURL); err != nil {
+		return err
+	}
+	if s.callbacks.Secret == "" {
+		return fmt.Errorf("%w: callbacks are disabled, no signing secret is configured", domainService.ErrInvalidCallback)
+	}
+	return nil
+}
+
+// sendCallback records a pending callback for a job that reached a final status and delivers it in the background
+func (s *ParserServiceImpl) sendCallback(ctx context.Context, job *entity.ParsingJob) {
+	delivery := entity.CallbackDelivery{
+		ID:        uuid.New(),
+		Event:     entity.CallbackEvent(job.Status),
+		URL:       job.Params.CallbackURL,
+		Status:    entity.CallbackStatusPending,
+		CreatedAt: time.Now(),
+	}
+	if delivery.Event == "" {
+		return
+	}
+
+	s.saveCallback(ctx, job.ID, delivery)
+	s.startDelivery(job.ID, delivery)
+}
+
+// startDelivery delivers a callback in the background unless this replica is delivering it already
+func (s *ParserServiceImpl) startDelivery(jobID string, delivery entity.CallbackDelivery) bool {
+	s.deliveringMu.Lock()
+	defer s.deliveringMu.Unlock()
+
+	if _, ok := s.delivering[delivery.ID]; ok {
+		return false
+	}
+	s.delivering[delivery.ID] = struct{}{}
+
+	go func() {
+		defer func() {
+			s.deliveringMu.Lock()
+			delete(s.delivering, delivery.ID)
+			s.deliveringMu.Unlock()
+		}()
+		s.deliverCallback(jobID, delivery)
+	}()
+	return true
+}
+
+// recoverCallbacks takes over callback deliveries left pending by a stopped server or replica.
+// A delivery is left over when no attempt was made for longer than a delivery in progress waits
+// between attempts. With resume it continues after its last attempt, otherwise it is marked failed.
+// Two replicas may take over the same delivery at once; receivers drop the repeat by its ID.
+func (s *ParserServiceImpl) recoverCallbacks(ctx context.Context, resume bool) error {
+	jobs, err := s.jobRepo.ListPendingCallbacks(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list pending callbacks: %v", err)
+		return err
+	}
+
+	idleSince := time.Now().Add(-s.callbacks.idle())
+	for _, job := range jobs {
+		for _, delivery := range job.Callbacks {
+			if delivery.Status != entity.CallbackStatusPending || lastCallbackActivity(delivery).After(idleSince) {
+				continue
+			}
+
+			if resume {
+				if s.startDelivery(job.ID, delivery) {
+					s.logger.Info("Resuming %s of job %s to %s after %d attempts", delivery.Event, job.ID, delivery.URL, len(delivery.Attempts))
+				}
+				continue
+			}
+
+			s.deliveringMu.Lock()
+			_, delivering := s.delivering[delivery.ID]
+			s.deliveringMu.Unlock()
+			if delivering {
+				continue
+			}
+
+			s.logger.Warn("Marking %s of job %s left pending as failed", delivery.Event, job.ID)
+			delivery.Status = entity.CallbackStatusFailed
+			delivery.Error = "delivery interrupted by a server restart"
+			s.saveCallback(ctx, job.ID, delivery)
+		}
+	}
+
+	return nil
+}
+
+// lastCallbackActivity returns when a delivery was last attempted, or created when it has no attempts
+func lastCallbackActivity(delivery entity.CallbackDelivery) time.Time {
+	if n := len(delivery.Attempts); n > 0 {
+		return delivery.Attempts[n-1].At
+	}
+	return delivery.CreatedAt
+}
+
+// deliverCallback posts the report of a job to its callback URL until the receiver accepts it,
+// the attempts run out or the workers stop. Every attempt is saved with the job; a resumed
+// delivery goes on after its saved attempts.
+func (s *ParserServiceImpl) deliverCallback(jobID string, delivery entity.CallbackDelivery) {
+	ctx := s.callbackCtx
+
+	report, err := s.GetParsingJobReport(ctx, jobID)
+	if err != nil {
+		s.logger.Error("Failed to build the callback of job %s: %v", jobID, err)
+		delivery.Status = entity.CallbackStatusFailed
+		delivery.Error = fmt.Sprintf("failed to build the job report: %v", err)
+		s.saveCallback(ctx, jobID, delivery)
+		return
+	}
+
+	body, err := json.Marshal(newCallbackPayload(delivery, report))
+	if err != nil {
+		s.logger.Error("Failed to encode the callback of job %s: %v", jobID, err)
+		delivery.Status = entity.CallbackStatusFailed
+		delivery.Error = fmt.Sprintf("failed to encode the callback: %v", err)
+		s.saveCallback(ctx, jobID, delivery)
+		return
+	}
+
+	policy := s.callbacks.Retry
+	for number := len(delivery.Attempts) + 1; ; number++ {
+		attempt, retry := s.postCallback(ctx, delivery, body)
+		attempt.Number = number
+		delivery.Attempts = append(delivery.Attempts, attempt)
+
+		switch {
+		case attempt.Error == "":
+			delivery.Status = entity.CallbackStatusDelivered
+		case !retry || number >= policy.MaxAttempts:
+			delivery.Status = entity.CallbackStatusFailed
+		}
+		s.saveCallback(ctx, jobID, delivery)
+
+		switch delivery.Status {
+		case entity.CallbackStatusDelivered:
+			s.logger.Info("Delivered %s of job %s to %s", delivery.Event, jobID, delivery.URL)
+			return
+		case entity.CallbackStatusFailed:
+			s.logger.Error("Gave up delivering %s of job %s to %s after %d attempts: %s", delivery.Event, jobID, delivery.URL, number, attempt.Error)
+			return
+		}
+
+		delay := policy.Delay(number)
+		s.logger.Warn("Callback of job %s failed attempt %d, retrying in %s: %s", jobID, number, delay, attempt.Error)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			// Left pending: the workers stopped, recoverCallbacks picks it up after a restart
+			return
+		}
+	}
+}
+
+// postCallback makes one delivery attempt; it reports whether a failed attempt is worth repeating
+func (s *ParserServiceImpl) postCallback(ctx context.Context, delivery entity.CallbackDelivery, body []byte) (entity.CallbackAttempt, bool) {
+	attempt := entity.CallbackAttempt{At: time.Now()}
+
+	ctx, cancel := context.WithTimeout(ctx, s.callbacks.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt, false
+	}
+
+	timestamp := strconv.FormatInt(attempt.At.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "GitHub-Parser-Callbacks")
+	req.Header.Set(CallbackEventHeader, delivery.Event)
+	req.Header.Set(CallbackDeliveryHeader, delivery.ID)
+	req.Header.Set(CallbackTimestampHeader, timestamp)
+	req.Header.Set(CallbackSignatureHeader, SignCallback(s.callbacks.Secret, timestamp, body))
+
+	resp, err := s.callbackClient.Do(req)
+	attempt.Duration = time.Since(attempt.At)
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt, true
+	}
+	resp.Body.Close()
+
+	attempt.StatusCode = resp.StatusCode
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return attempt, false
+	}
+
+	attempt.Error = resp.Status
+	retry := resp.StatusCode >= http.StatusInternalServerError ||
+		resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode == http.StatusRequestTimeout
+	return attempt, retry
+}
+
+// saveCallback stores a callback delivery; a failed save is only logged so the delivery goes on
+func (s *ParserServiceImpl) saveCallback(ctx context.Context, jobID string, delivery entity.CallbackDelivery) {
+	if err := s.jobRepo.SaveCallback(ctx, jobID, delivery); err != nil {
+		s.logger.Error("Failed to save callback %s of job %s: %v", delivery.ID, jobID, err)
+	}
+}
+
+// callbackPayload is the JSON body of a callback
+type callbackPayload struct {
+	DeliveryID string         `json:"delivery_id"`
+	Event      string         `json:"event"`
+	JobID      string         `json:"job_id"`
+	Status     string         `json:"status"`
+	Report     callbackReport `json:"report"`
+}
+
+// callbackReport mirrors the ParsingJobReport message
+type callbackReport struct {
+	JobType      string               `json:"job_type"`
+	Progress     int                  `json:"progress"`
+	ErrorMessage string               `json:"error_message,omitempty"`
+	Attempts     int                  `json:"attempts"`
+	StartedAt    string               `json:"started_at,omitempty"`
+	FinishedAt   string               `json:"finished_at,omitempty"`
+	DurationMs   int64                `json:"duration_ms"`
+	Steps        []callbackStepReport `json:"steps"`
+	Items        int                  `json:"items"`
+	Usage        callbackUsage        `json:"usage"`
+}
+
+type callbackStepReport struct {
+	Step       string        `json:"step"`
+	Status     string        `json:"status"`
+	Items      int           `json:"items"`
+	Total      int           `json:"total"`
+	Error      string        `json:"error,omitempty"`
+	StartedAt  string        `json:"started_at"`
+	FinishedAt string        `json:"finished_at,omitempty"`
+	Usage      callbackUsage `json:"usage"`
+}
+
+type callbackUsage struct {
+	Saved           int                  `json:"saved"`
+	Failed          int                  `json:"failed"`
+	Created         int                  `json:"created"`
+	Updated         int                  `json:"updated"`
+	Unchanged       int                  `json:"unchanged"`
+	SampleErrors    []string             `json:"sample_errors,omitempty"`
+	FailedItems     []callbackFailedItem `json:"failed_items,omitempty"`
+	APICalls        int                  `json:"api_calls"`
+	RateLimitHits   int                  `json:"rate_limit_hits"`
+	RateLimitWaitMs int64                `json:"rate_limit_wait_ms"`
+}
+
+type callbackFailedItem struct {
+	ID     int64  `json:"id"`
+	Number int    `json:"number"`
+	Reason string `json:"reason"`
+}
+
+func newCallbackPayload(delivery entity.CallbackDelivery, report *domainService.ParsingJobReport) callbackPayload {
+	payload := callbackPayload{
+		DeliveryID: delivery.ID,
+		Event:      delivery.Event,
+		JobID:      report.JobID,
+		Status:     report.Status,
+		Report: callbackReport{
+			JobType:      report.JobType,
+			Progress:     report.Progress,
+			ErrorMessage: report.ErrorMessage,
+			Attempts:     report.Attempts,
+			StartedAt:    report.StartedAt,
+			FinishedAt:   report.FinishedAt,
+			DurationMs:   report.Duration.Milliseconds(),
+			Steps:        []callbackStepReport{},
+			Items:        report.Items,
+			Usage:        newCallbackUsage(report.Usage),
+		},
+	}
+
+	for _, step := range report.Steps {
+		stepReport := callbackStepReport{
+			Step:      step.Step,
+			Status:    step.Status,
+			Items:     step.Items,
+			Total:     step.Total,
+			Error:     step.Error,
+			StartedAt: step.StartedAt.Format(time.RFC3339),
+			Usage:     newCallbackUsage(step.StepUsage),
+		}
+		if step.FinishedAt != nil {
+			stepReport.FinishedAt = step.FinishedAt.Format(time.RFC3339)
+		}
+		payload.Report.Steps = append(payload.Report.Steps, stepReport)
+	}
+
+	return payload
+}
+
+func newCallbackUsage(usage entity.StepUsage) callbackUsage {
+	callback := callbackUsage{
+		Saved:           usage.Saved,
+		Failed:          usage.Failed,
+		Created:         usage.Created,
+		Updated:         usage.Updated,
+		Unchanged:       usage.Unchanged,
+		SampleErrors:    usage.SampleErrors,
+		APICalls:        usage.APICalls,
+		RateLimitHits:   usage.RateLimitHits,
+		RateLimitWaitMs: usage.RateLimitWait.Milliseconds(),
+	}
+	for _, failure := range usage.FailedItems {
+		callback.FailedItems = append(callback.FailedItems, callbackFailedItem(failure))
+	}
+	return callback
+}
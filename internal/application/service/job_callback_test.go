@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/fakegithub"
+)
+
+const testCallbackSecret = "test-secret"
+
+// testCallbacks delivers callbacks of test services without long waits between attempts
+var testCallbacks = CallbackConfig{
+	Secret: testCallbackSecret,
+	Retry:  entity.RetryPolicy{MaxAttempts: 3, Backoff: 10 * time.Millisecond},
+}
+
+// callbackReceiver is a local HTTP receiver of callbacks that answers with the given statuses in turn
+type callbackReceiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *callbackReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+	status := http.StatusNoContent
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	r.mu.Unlock()
+
+	w.WriteHeader(status)
+}
+
+func TestJobCallback(t *testing.T) {
+	receiver := &callbackReceiver{statuses: []int{http.StatusInternalServerError}}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	s := newFakeParserService(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	id, err := s.StartParsingJob(ctx, entity.ParsingJobParams{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		CallbackURL: server.URL + "/hooks/parser",
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJob(t, s.jobRepo, id, func(job *entity.ParsingJob) bool {
+		return len(job.Callbacks) == 1 && job.Callbacks[0].Status != entity.CallbackStatusPending
+	})
+	if job.Status != entity.JobStatusCompleted {
+		t.Fatalf("job finished as %s: %s", job.Status, job.ErrorMessage)
+	}
+
+	delivery := job.Callbacks[0]
+	if delivery.Status != entity.CallbackStatusDelivered || delivery.Event != entity.CallbackEventCompleted || len(delivery.Attempts) != 2 {
+		t.Fatalf("delivery = %+v, want job.completed delivered on the second attempt", delivery)
+	}
+	if first := delivery.Attempts[0]; first.Number != 1 || first.StatusCode != http.StatusInternalServerError || first.Error == "" {
+		t.Errorf("first attempt = %+v, want a 500", first)
+	}
+	if second := delivery.Attempts[1]; second.Number != 2 || second.StatusCode != http.StatusNoContent || second.Error != "" {
+		t.Errorf("second attempt = %+v, want a 204", second)
+	}
+
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+
+	req, body := receiver.requests[1], receiver.bodies[1]
+	if req.URL.Path != "/hooks/parser" || req.Header.Get(CallbackEventHeader) != entity.CallbackEventCompleted || req.Header.Get(CallbackDeliveryHeader) != delivery.ID {
+		t.Errorf("callback request %s with headers %v", req.URL.Path, req.Header)
+	}
+	if signature := SignCallback(testCallbackSecret, req.Header.Get(CallbackTimestampHeader), body); req.Header.Get(CallbackSignatureHeader) != signature {
+		t.Errorf("signature = %q, want %q", req.Header.Get(CallbackSignatureHeader), signature)
+	}
+
+	var payload callbackPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("decode callback: %v", err)
+	}
+	if payload.JobID != id || payload.Status != entity.JobStatusCompleted || payload.DeliveryID != delivery.ID ||
+		payload.Report.Items != 46 || len(payload.Report.Steps) != 2 || payload.Report.Usage.APICalls == 0 {
+		t.Errorf("payload = %+v", payload)
+	}
+}
+
+func TestJobCallbackGivesUp(t *testing.T) {
+	receiver := &callbackReceiver{statuses: []int{http.StatusBadGateway, http.StatusGone}}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	fake := fakegithub.New(nil)
+	fake.InjectFault(fakegithub.Fault{Kind: fakegithub.FaultNotFound, PathPrefix: "/repos/octo/demo"})
+	s := newFakeParserService(t, fake)
+
+	id, err := s.StartParsingJob(context.Background(), entity.ParsingJobParams{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		CallbackURL: server.URL,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	// A 4xx other than 408 and 429 is final
+	job := waitForJob(t, s.jobRepo, id, func(job *entity.ParsingJob) bool {
+		return len(job.Callbacks) == 1 && job.Callbacks[0].Status != entity.CallbackStatusPending
+	})
+	delivery := job.Callbacks[0]
+	if delivery.Status != entity.CallbackStatusFailed || delivery.Event != entity.CallbackEventFailed || len(delivery.Attempts) != 2 {
+		t.Errorf("delivery = %+v, want job.failed given up after 2 attempts", delivery)
+	}
+}
+
+func TestJobCallbackRejected(t *testing.T) {
+	s := newFakeParserService(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	for _, callbackURL := range []string{"example.com/hook", "ftp://example.com/hook", "http://"} {
+		_, err := s.StartParsingJob(ctx, entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo", CallbackURL: callbackURL})
+		if !errors.Is(err, domainService.ErrInvalidCallback) {
+			t.Errorf("callback %q: got %v, want ErrInvalidCallback", callbackURL, err)
+		}
+	}
+
+	// Without a secret callbacks could not be signed
+	s.callbacks.Secret = ""
+	_, err := s.StartParsingJob(ctx, entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo", CallbackURL: "https://example.com/hook"})
+	if !errors.Is(err, domainService.ErrInvalidCallback) {
+		t.Errorf("callback without a secret: got %v, want ErrInvalidCallback", err)
+	}
+}
+
+func TestRecoverPendingCallbacks(t *testing.T) {
+	receiver := &callbackReceiver{}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	s := newFakeParserService(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	// Deliveries a stopped server left pending during its backoff, and one a live replica is still working on
+	stopped := time.Now().Add(-time.Hour)
+	save := func(id string, created time.Time, attempts ...entity.CallbackAttempt) {
+		job := &entity.ParsingJob{
+			ID:        id,
+			Params:    entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo", CallbackURL: server.URL},
+			Status:    entity.JobStatusCompleted,
+			CreatedAt: created,
+		}
+		if err := s.jobRepo.Save(ctx, job); err != nil {
+			t.Fatalf("save job: %v", err)
+		}
+		delivery := entity.CallbackDelivery{
+			ID:        "delivery-" + id,
+			Event:     entity.CallbackEventCompleted,
+			URL:       server.URL,
+			Status:    entity.CallbackStatusPending,
+			Attempts:  attempts,
+			CreatedAt: created,
+		}
+		if err := s.jobRepo.SaveCallback(ctx, id, delivery); err != nil {
+			t.Fatalf("save callback: %v", err)
+		}
+	}
+	save("left", stopped, entity.CallbackAttempt{Number: 1, At: stopped, StatusCode: http.StatusBadGateway, Error: "502 Bad Gateway"})
+	save("live", time.Now())
+
+	if err := s.RecoverJobs(ctx, true); err != nil {
+		t.Fatalf("RecoverJobs: %v", err)
+	}
+
+	job := waitForJob(t, s.jobRepo, "left", func(job *entity.ParsingJob) bool {
+		return job.Callbacks[0].Status != entity.CallbackStatusPending
+	})
+	delivery := job.Callbacks[0]
+	if delivery.Status != entity.CallbackStatusDelivered || len(delivery.Attempts) != 2 || delivery.Attempts[1].Number != 2 {
+		t.Fatalf("resumed delivery = %+v, want delivered on attempt 2", delivery)
+	}
+	if got := receiver.requests[0].Header.Get(CallbackDeliveryHeader); got != delivery.ID {
+		t.Errorf("delivery header = %q, want %q", got, delivery.ID)
+	}
+
+	live, _ := s.jobRepo.FindByID(ctx, "live")
+	if live.Callbacks[0].Status != entity.CallbackStatusPending || len(live.Callbacks[0].Attempts) != 0 {
+		t.Errorf("delivery of a live replica = %+v, want it left alone", live.Callbacks[0])
+	}
+
+	// Without resume a left delivery is given up with a reason
+	save("given-up", stopped)
+	if err := s.RecoverJobs(ctx, false); err != nil {
+		t.Fatalf("RecoverJobs: %v", err)
+	}
+	givenUp, _ := s.jobRepo.FindByID(ctx, "given-up")
+	if delivery := givenUp.Callbacks[0]; delivery.Status != entity.CallbackStatusFailed || delivery.Error == "" {
+		t.Errorf("delivery left pending = %+v, want failed with a reason", delivery)
+	}
+}
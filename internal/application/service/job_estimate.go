@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/callstats"
+)
+
+// reconcilePageSize is the page size of ListIssueNumbers and ListPullRequestNumbers
+const reconcilePageSize = 100
+
+// EstimateParsingJob works out the requests each step of a job would make from the list sizes GitHub reports,
+// and how long they would take at the current rate limit. Nothing is stored.
+func (s *ParserServiceImpl) EstimateParsingJob(ctx context.Context, params domainService.ParsingJobParams) (*domainService.ParsingEstimate, error) {
+	if err := domainService.ValidateJobOptions(params); err != nil {
+		return nil, err
+	}
+
+	var calls callstats.Stats
+	ctx = callstats.WithStats(ctx, &calls)
+	started := time.Now()
+
+	owner, name := params.OwnerName, params.RepoName
+	if _, err := s.githubService.GetRepository(ctx, owner, name); err != nil {
+		s.logger.Error("Failed to get repository for the estimate: %v", err)
+		return nil, err
+	}
+
+	// Reconciliation checks everything, whatever the options
+	opts := s.listOptions(params)
+	if params.JobType == domainService.JobTypeReconcile {
+		opts = domainService.ListOptions{}
+	}
+
+	// Both issue steps need the pull request count: the issue list includes pull requests
+	var listed, prs int
+	if params.ParseIssues || params.ParsePRs || params.JobType == domainService.JobTypeReconcile {
+		var err error
+		if listed, err = s.githubService.CountIssues(ctx, owner, name, opts); err != nil {
+			return nil, err
+		}
+		if prs, err = s.githubService.CountPullRequests(ctx, owner, name, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	// GitHub cuts the issue list at Since but not the pull request list, so the two cannot be subtracted:
+	// the pull requests of the window are among the listed issues, which bounds both counts
+	issues := max(listed-prs, 0)
+	if !opts.Since.IsZero() {
+		issues = listed
+		prs = min(prs, listed)
+	}
+
+	// capped applies the item cap of the job
+	capped := func(n int) int {
+		if params.MaxItems > 0 {
+			return min(n, params.MaxItems)
+		}
+		return n
+	}
+
+	estimate := &domainService.ParsingEstimate{
+		OwnerName: owner,
+		RepoName:  name,
+		JobType:   params.JobType,
+	}
+	if estimate.JobType == "" {
+		estimate.JobType = domainService.JobTypeParse
+	}
+
+	if params.JobType == domainService.JobTypeReconcile {
+		// Issues missing from the listing cost one more request each, which cannot be known up front
+		issuePages, prPages := pageCount(listed, reconcilePageSize), pageCount(prs, reconcilePageSize)
+		estimate.Steps = append(estimate.Steps, domainService.StepEstimate{
+			Step:     entity.JobStepReconcile,
+			Items:    listed,
+			Pages:    issuePages + prPages,
+			Requests: 1 + issuePages + prPages,
+		})
+	} else {
+		estimate.Steps = append(estimate.Steps, domainService.StepEstimate{Step: entity.JobStepRepository, Items: 1, Requests: 1})
+
+		// The steps share the repository of the first step, so a list costs a request per page;
+		// pull requests in the issue list take room on its pages
+		if params.ParseIssues {
+			issues := capped(issues)
+			issuePages := pageCount(min(listed, issues+prs), opts.PerPage)
+			estimate.Steps = append(estimate.Steps, domainService.StepEstimate{
+				Step:     entity.JobStepIssues,
+				Items:    issues,
+				Pages:    issuePages,
+				Requests: issuePages,
+			})
+		}
+
+		// On top of that, the CI checks of every pull request take two requests
+		if params.ParsePRs {
+			prs := capped(prs)
+			prPages := pageCount(prs, opts.PerPage)
+			estimate.Steps = append(estimate.Steps, domainService.StepEstimate{
+				Step:     entity.JobStepPullRequests,
+				Items:    prs,
+				Pages:    prPages,
+				Requests: prPages + 2*prs,
+			})
+		}
+
+		// At most the README, the CODEOWNERS locations and the manifests
+		if params.ParseContents {
+			files := 1 + len(codeOwnersPaths) + len(manifestPaths)
+			estimate.Steps = append(estimate.Steps, domainService.StepEstimate{
+				Step:     entity.JobStepContents,
+				Items:    files,
+				Requests: files,
+			})
+		}
+
+		// At least one page of Dependabot alerts and one of advisories
+		if params.ParseSecurityAlerts {
+			estimate.Steps = append(estimate.Steps, domainService.StepEstimate{
+				Step:     entity.JobStepSecurityAlerts,
+				Pages:    2,
+				Requests: 2,
+			})
+		}
+
+		if params.ParseUsers {
+			estimate.Steps = append(estimate.Steps, domainService.StepEstimate{Step: entity.JobStepUsers, Items: 1, Requests: 1})
+		}
+	}
+
+	for _, step := range estimate.Steps {
+		estimate.Requests += step.Requests
+	}
+
+	rate, err := s.githubService.GetRateLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+	estimate.RateLimit = *rate
+
+	// The requests of the estimate itself give the latency to expect
+	estimate.EstimateRequests = calls.Calls()
+	perRequest := time.Since(started) / time.Duration(max(estimate.EstimateRequests, 1))
+	estimate.Duration, estimate.RateLimitWait = domainService.EstimateDuration(estimate.Requests, perRequest, *rate, time.Now())
+
+	s.logger.Info("Estimated %d requests for %s/%s, %d remaining until %s",
+		estimate.Requests, owner, name, rate.Remaining, rate.Reset.Format(time.RFC3339))
+	return estimate, nil
+}
+
+// pageCount returns the number of pages a list of n items takes; an empty list still takes one request
+func pageCount(n, perPage int) int {
+	return max((n+perPage-1)/perPage, 1)
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/fakegithub"
+)
+
+func TestEstimateParsingJob(t *testing.T) {
+	fake := fakegithub.New(nil)
+	s := newFakeParserService(t, fake)
+	s.pageSize = 10
+	ctx := context.Background()
+
+	params := entity.ParsingJobParams{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		ParsePRs:    true,
+	}
+	estimate, err := s.EstimateParsingJob(ctx, params)
+	if err != nil {
+		t.Fatalf("EstimateParsingJob: %v", err)
+	}
+
+	want := []domainService.StepEstimate{
+		{Step: entity.JobStepRepository, Items: 1, Requests: 1},
+		{Step: entity.JobStepIssues, Items: 45, Pages: 6, Requests: 6},
+		{Step: entity.JobStepPullRequests, Items: 12, Pages: 2, Requests: 26},
+	}
+	if len(estimate.Steps) != len(want) {
+		t.Fatalf("steps = %+v", estimate.Steps)
+	}
+	for i, step := range estimate.Steps {
+		if step != want[i] {
+			t.Errorf("step %d = %+v, want %+v", i, step, want[i])
+		}
+	}
+	if estimate.Requests != 33 || estimate.RateLimit.Limit != 5000 || estimate.RateLimitWait != 0 {
+		t.Errorf("estimate = %+v", estimate)
+	}
+	if calls := len(fake.RequestLog()); estimate.EstimateRequests != calls {
+		t.Errorf("estimate counts %d of its own requests, the API got %d", estimate.EstimateRequests, calls)
+	}
+
+	// A dry run writes nothing
+	repos, err := s.repoRepo.List(ctx, repository.RepositoryFilter{})
+	if err != nil || len(repos) != 0 {
+		t.Errorf("stored repositories after the estimate: %d, %v", len(repos), err)
+	}
+
+	// The job itself makes as many requests as estimated
+	id, err := s.StartParsingJob(ctx, params)
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+	if job := waitForJobStatus(t, s, id); job.Status != entity.JobStatusCompleted {
+		t.Fatalf("job finished as %s: %s", job.Status, job.ErrorMessage)
+	}
+	report, err := s.GetParsingJobReport(ctx, id)
+	if err != nil {
+		t.Fatalf("GetParsingJobReport: %v", err)
+	}
+	if report.Usage.APICalls != estimate.Requests {
+		t.Errorf("job made %d requests, estimated %d", report.Usage.APICalls, estimate.Requests)
+	}
+}
+
+func TestEstimateDuration(t *testing.T) {
+	now := time.Now()
+	rate := domainService.RateLimit{Limit: 100, Remaining: 10, Reset: now.Add(20 * time.Minute)}
+
+	for _, tc := range []struct {
+		requests       int
+		duration, wait time.Duration
+	}{
+		{requests: 10, duration: 10 * time.Second},
+		// Past the remaining requests the job waits for the reset, then for every further hour of the limit
+		{requests: 110, duration: 110*time.Second + 20*time.Minute, wait: 20 * time.Minute},
+		{requests: 111, duration: 111*time.Second + 80*time.Minute, wait: 80 * time.Minute},
+	} {
+		duration, wait := domainService.EstimateDuration(tc.requests, time.Second, rate, now)
+		if duration != tc.duration || wait != tc.wait {
+			t.Errorf("%d requests: duration %s, wait %s; want %s and %s", tc.requests, duration, wait, tc.duration, tc.wait)
+		}
+	}
+}
+
+func TestEstimateParsingJobWithTimeWindow(t *testing.T) {
+	s := newFakeParserService(t, fakegithub.New(nil))
+	s.pageSize = 10
+
+	// Since the 50th day only 8 of the 12 pull requests and none of the issues were updated,
+	// but GitHub counts all 12 pull requests
+	params := entity.ParsingJobParams{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		ParsePRs:    true,
+		Since:       time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC),
+	}
+	estimate, err := s.EstimateParsingJob(context.Background(), params)
+	if err != nil {
+		t.Fatalf("EstimateParsingJob: %v", err)
+	}
+
+	want := []domainService.StepEstimate{
+		{Step: entity.JobStepRepository, Items: 1, Requests: 1},
+		{Step: entity.JobStepIssues, Items: 8, Pages: 1, Requests: 1},
+		{Step: entity.JobStepPullRequests, Items: 8, Pages: 1, Requests: 17},
+	}
+	if len(estimate.Steps) != len(want) {
+		t.Fatalf("steps = %+v", estimate.Steps)
+	}
+	for i, step := range estimate.Steps {
+		if step != want[i] {
+			t.Errorf("step %d = %+v, want %+v", i, step, want[i])
+		}
+	}
+}
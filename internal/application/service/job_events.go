@@ -0,0 +1,83 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+)
+
+// jobWatcherBuffer is how many events a slow watcher may fall behind before the oldest are dropped
+const jobWatcherBuffer = 64
+
+// jobEvents fans job events out to the watchers of each job
+type jobEvents struct {
+	mu       sync.Mutex
+	watchers map[string]map[*jobWatcher]struct{}
+}
+
+type jobWatcher struct {
+	events chan entity.JobEvent
+}
+
+func newJobEvents() *jobEvents {
+	return &jobEvents{watchers: make(map[string]map[*jobWatcher]struct{})}
+}
+
+// subscribe starts collecting events of a job
+func (b *jobEvents) subscribe(jobID string) *jobWatcher {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	w := &jobWatcher{events: make(chan entity.JobEvent, jobWatcherBuffer)}
+	if b.watchers[jobID] == nil {
+		b.watchers[jobID] = make(map[*jobWatcher]struct{})
+	}
+	b.watchers[jobID][w] = struct{}{}
+	return w
+}
+
+// unsubscribe stops a watcher; it is a no-op for watchers already closed by a final event
+func (b *jobEvents) unsubscribe(jobID string, w *jobWatcher) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.watchers[jobID][w]; !ok {
+		return
+	}
+	b.remove(jobID, w)
+}
+
+// publish delivers an event to the watchers of its job without blocking the job.
+// A watcher that fell behind loses its oldest event; after a final status all watchers are closed.
+func (b *jobEvents) publish(event entity.JobEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	final := event.Type == entity.JobEventStatus && entity.FinalJobStatus(event.Status)
+
+	for w := range b.watchers[event.JobID] {
+		select {
+		case w.events <- event:
+		default:
+			// Only publish sends on the channel, so after dropping one event there is room
+			select {
+			case <-w.events:
+			default:
+			}
+			w.events <- event
+		}
+
+		if final {
+			b.remove(event.JobID, w)
+		}
+	}
+}
+
+// remove closes a watcher; callers hold b.mu
+func (b *jobEvents) remove(jobID string, w *jobWatcher) {
+	delete(b.watchers[jobID], w)
+	if len(b.watchers[jobID]) == 0 {
+		delete(b.watchers, jobID)
+	}
+	close(w.events)
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+)
+
+func TestJobEventsDropOldestForSlowWatcher(t *testing.T) {
+	b := newJobEvents()
+	w := b.subscribe("job")
+
+	for i := 1; i <= jobWatcherBuffer+10; i++ {
+		b.publish(entity.JobEvent{Type: entity.JobEventProgress, JobID: "job", Progress: i})
+	}
+	b.publish(entity.JobEvent{Type: entity.JobEventStatus, JobID: "job", Status: entity.JobStatusCompleted})
+
+	var events []entity.JobEvent
+	for event := range w.events {
+		events = append(events, event)
+	}
+
+	if len(events) != jobWatcherBuffer {
+		t.Fatalf("got %d events, want %d", len(events), jobWatcherBuffer)
+	}
+	if first := events[0]; first.Progress != 12 {
+		t.Errorf("first kept event has progress %d, want 12", first.Progress)
+	}
+	if last := events[len(events)-1]; last.Status != entity.JobStatusCompleted {
+		t.Errorf("last event = %+v, want the final status", last)
+	}
+
+	// Closed watchers are forgotten, unsubscribing them again is harmless
+	b.unsubscribe("job", w)
+	if len(b.watchers) != 0 {
+		t.Errorf("watchers left after final event: %v", b.watchers)
+	}
+}
+
+func TestJobEventsOnlyReachWatchersOfTheJob(t *testing.T) {
+	b := newJobEvents()
+	w := b.subscribe("a")
+	b.subscribe("b")
+
+	b.publish(entity.JobEvent{Type: entity.JobEventWarning, JobID: "b"})
+	b.publish(entity.JobEvent{Type: entity.JobEventWarning, JobID: "a", Message: "for a"})
+
+	if event := <-w.events; event.Message != "for a" {
+		t.Errorf("got %+v, want the event of job a", event)
+	}
+
+	b.unsubscribe("a", w)
+	if _, ok := <-w.events; ok {
+		t.Error("watcher channel still open after unsubscribe")
+	}
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+)
+
+// Defaults of JobLeaseConfig
+const (
+	defaultLeaseTTL          = 30 * time.Second
+	defaultLeasePollInterval = 5 * time.Second
+)
+
+// stopPollInterval is how often a job stopped through another replica is checked
+const stopPollInterval = 100 * time.Millisecond
+
+// errLeaseLost is the cause passed to the context of a running job another replica took over,
+// and what the job store returns for a save of such a job
+var errLeaseLost = repository.ErrLeaseLost
+
+// errJobLeased is returned when another replica holds a job
+var errJobLeased = errors.New("job is held by another replica")
+
+// JobLeaseConfig controls how replicas sharing a job store divide the jobs.
+// A replica leases every job it queues, runs or waits to retry and renews the leases while it holds them;
+// jobs whose lease expired are taken over by any replica.
+type JobLeaseConfig struct {
+	// Owner identifies the replica in leases, the host name and a unique suffix when empty
+	Owner string
+	// TTL is how long a lease lasts without being renewed
+	TTL time.Duration
+	// PollInterval is how often jobs without a live lease are looked for
+	PollInterval time.Duration
+	// Resume continues jobs taken over from another replica instead of marking them interrupted
+	Resume bool
+}
+
+// withDefaults fills in unset fields
+func (c JobLeaseConfig) withDefaults() JobLeaseConfig {
+	if c.Owner == "" {
+		host, err := os.Hostname()
+		if err != nil {
+			host = "replica"
+		}
+		c.Owner = host + "-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	if c.TTL <= 0 {
+		c.TTL = defaultLeaseTTL
+	}
+	if c.PollInterval <= 0 {
+		c.PollInterval = defaultLeasePollInterval
+	}
+	return c
+}
+
+// heartbeat is how often held leases are renewed, several times per TTL
+func (c JobLeaseConfig) heartbeat() time.Duration {
+	return min(c.TTL/3, c.PollInterval)
+}
+
+// runLeases renews the leases of this replica and takes over jobs no replica holds until ctx is done
+func (s *ParserServiceImpl) runLeases(ctx context.Context) {
+	heartbeat := time.NewTicker(s.leases.heartbeat())
+	defer heartbeat.Stop()
+	poll := time.NewTicker(s.leases.PollInterval)
+	defer poll.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			s.renewLeases(ctx)
+		case <-poll.C:
+			if err := s.RecoverJobs(ctx, s.leases.Resume); err != nil {
+				s.logger.Error("Failed to take over parsing jobs: %v", err)
+			}
+		}
+	}
+}
+
+// claimJob leases a job in one of statuses to this replica
+func (s *ParserServiceImpl) claimJob(ctx context.Context, jobID string, statuses ...string) (*entity.ParsingJob, error) {
+	now := time.Now()
+	return s.jobRepo.Claim(ctx, jobID, s.leases.Owner, now, now.Add(s.leases.TTL), statuses...)
+}
+
+// releaseJob gives up the lease of a job that left this replica
+func (s *ParserServiceImpl) releaseJob(ctx context.Context, jobID string) {
+	if err := s.jobRepo.Release(ctx, jobID, s.leases.Owner); err != nil {
+		s.logger.Error("Failed to release job %s: %v", jobID, err)
+	}
+}
+
+// tracked reports whether this replica queues, runs or waits to retry a job
+func (s *ParserServiceImpl) tracked(jobID string) bool {
+	s.activeMu.Lock()
+	defer s.activeMu.Unlock()
+
+	_, ok := s.active[jobID]
+	return ok
+}
+
+// renewLeases extends the leases of tracked jobs, drops jobs another replica took over
+// and applies stop requests received through other replicas
+func (s *ParserServiceImpl) renewLeases(ctx context.Context) {
+	s.activeMu.Lock()
+	ids := make([]string, 0, len(s.active))
+	for id := range s.active {
+		ids = append(ids, id)
+	}
+	s.activeMu.Unlock()
+
+	for _, id := range ids {
+		job, err := s.claimJob(ctx, id, entity.LeasedJobStatuses...)
+		if err != nil {
+			// The lease may still be renewed on the next heartbeat
+			s.logger.Error("Failed to renew lease of job %s: %v", id, err)
+			continue
+		}
+		if job == nil {
+			s.dropJob(id)
+			continue
+		}
+
+		switch job.StopRequest {
+		case entity.JobStopCancel:
+			go s.stopParsingJob(ctx, id, errJobCancelled)
+		case entity.JobStopPause:
+			go s.stopParsingJob(ctx, id, errJobPaused)
+		}
+	}
+}
+
+// dropJob stops tracking a job whose lease this replica lost; the replica holding it now records its outcome
+func (s *ParserServiceImpl) dropJob(jobID string) {
+	s.activeMu.Lock()
+	defer s.activeMu.Unlock()
+
+	active, ok := s.active[jobID]
+	if !ok {
+		return
+	}
+
+	switch {
+	case active.retry != nil:
+		active.retry.Stop()
+		delete(s.active, jobID)
+		close(active.done)
+	case active.cancel != nil:
+		// The worker forgets the job once its current call returns
+		active.cancel(errLeaseLost)
+		return
+	default:
+		if _, queued := s.jobQueue.Remove(jobID); queued {
+			delete(s.active, jobID)
+		} else {
+			active.stop = errLeaseLost
+		}
+	}
+
+	s.logger.Warn("Lost the lease of job %s to another replica", jobID)
+}
+
+// stopRemoteJob cancels or pauses a job this replica does not track. A job no replica holds is stopped
+// right away, a job held by another replica is asked to stop and waited for.
+func (s *ParserServiceImpl) stopRemoteJob(ctx context.Context, job *entity.ParsingJob, cause error) (*domainService.ParsingJobStatus, error) {
+	claimed, err := s.claimJob(ctx, job.ID, entity.LeasedJobStatuses...)
+	if err != nil {
+		return nil, err
+	}
+	if claimed != nil {
+		s.recordJobStop(ctx, claimed, cause, claimed.Status)
+		s.releaseJob(ctx, claimed.ID)
+		return s.GetParsingJobStatus(ctx, job.ID)
+	}
+
+	stop := entity.JobStopCancel
+	if cause == errJobPaused {
+		stop = entity.JobStopPause
+	}
+	if err := s.jobRepo.RequestStop(ctx, job.ID, stop); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Asked replica %s to %s job %s", job.LeaseOwner, stop, job.ID)
+
+	ticker := time.NewTicker(stopPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		current, err := s.findJob(ctx, job.ID)
+		if err != nil {
+			return nil, err
+		}
+		if current.Finished() || current.Status == entity.JobStatusPaused {
+			return s.GetParsingJobStatus(ctx, job.ID)
+		}
+	}
+}
+
+// leasedError reports a job held by another replica
+func leasedError(job *entity.ParsingJob) error {
+	return fmt.Errorf("%w: %w: job %s", domainService.ErrJobState, errJobLeased, job.ID)
+}
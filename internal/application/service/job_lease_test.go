@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	githubClient "github.com/Dhoini/GitHub_Parser/internal/infrastructure/github"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/fakegithub"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/persistence/memory"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/persistence/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// testLeases keep leases short so takeovers happen within a test
+var testLeases = JobLeaseConfig{TTL: 300 * time.Millisecond, PollInterval: 50 * time.Millisecond, Resume: true}
+
+// jobStores returns the job repositories the replica tests run against: memory always,
+// and Mongo when MONGODB_TEST_URI points at a server, e.g. mongodb://localhost:27017
+func jobStores(t *testing.T) map[string]func(t *testing.T) repository.JobRepository {
+	stores := map[string]func(t *testing.T) repository.JobRepository{
+		"memory": func(t *testing.T) repository.JobRepository { return memory.NewJobRepository() },
+	}
+
+	uri := os.Getenv("MONGODB_TEST_URI")
+	stores["mongo"] = func(t *testing.T) repository.JobRepository {
+		if uri == "" {
+			t.Skip("MONGODB_TEST_URI is not set")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+		if err != nil {
+			t.Fatalf("connect to %s: %v", uri, err)
+		}
+		if err := client.Ping(ctx, nil); err != nil {
+			t.Fatalf("ping %s: %v", uri, err)
+		}
+
+		db := client.Database(fmt.Sprintf("github_parser_test_%d", time.Now().UnixNano()))
+		t.Cleanup(func() {
+			db.Drop(context.Background())
+			client.Disconnect(context.Background())
+		})
+		if err := mongodb.EnsureJobIndexes(ctx, db); err != nil {
+			t.Fatalf("create job indexes: %v", err)
+		}
+		return mongodb.NewJobRepository(db, testLogger())
+	}
+
+	return stores
+}
+
+// newReplicas starts two parser services sharing one job store and the fake GitHub API
+func newReplicas(t *testing.T, jobRepo repository.JobRepository, fake *fakegithub.Server) (*ParserServiceImpl, *ParserServiceImpl) {
+	t.Helper()
+
+	api := httptest.NewServer(fake)
+	t.Cleanup(api.Close)
+
+	newGithubService := func() domainService.GithubService {
+		client := githubClient.NewGithubClient("test-token", nil, testLogger(), githubClient.WithBaseURL(api.URL))
+		return NewGithubService(client.GetClient(), testLogger())
+	}
+
+	a := testLeases
+	a.Owner = "replica-a"
+	b := testLeases
+	b.Owner = "replica-b"
+	return newReplica(t, newGithubService(), jobRepo, a), newReplica(t, newGithubService(), jobRepo, b)
+}
+
+// waitForJob polls a job until check accepts it
+func waitForJob(t *testing.T, jobRepo repository.JobRepository, id string, check func(job *entity.ParsingJob) bool) *entity.ParsingJob {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := jobRepo.FindByID(context.Background(), id)
+		if err != nil || job == nil {
+			t.Fatalf("find job %s: %v", id, err)
+		}
+		if check(job) {
+			return job
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("job %s did not reach the expected state", id)
+	return nil
+}
+
+func TestJobLeasesAcrossReplicas(t *testing.T) {
+	for name, newStore := range jobStores(t) {
+		t.Run(name, func(t *testing.T) {
+			t.Run("status from any replica", func(t *testing.T) {
+				jobRepo := newStore(t)
+				a, b := newReplicas(t, jobRepo, fakegithub.New(nil))
+				ctx := context.Background()
+
+				id, err := a.StartParsingJob(ctx, entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo", ParseIssues: true})
+				if err != nil {
+					t.Fatalf("StartParsingJob: %v", err)
+				}
+
+				status, err := b.GetParsingJobStatus(ctx, id)
+				if err != nil {
+					t.Fatalf("GetParsingJobStatus on the other replica: %v", err)
+				}
+				if status.ID != id {
+					t.Errorf("status of job %s, want %s", status.ID, id)
+				}
+
+				job := waitForJob(t, jobRepo, id, (*entity.ParsingJob).Finished)
+				if job.Status != entity.JobStatusCompleted || job.Attempts != 1 {
+					t.Errorf("job is %s after %d attempts, want completed after 1", job.Status, job.Attempts)
+				}
+				if job.LeaseOwner != "" {
+					t.Errorf("finished job is still leased to %s", job.LeaseOwner)
+				}
+			})
+
+			t.Run("takeover after lease expiry", func(t *testing.T) {
+				jobRepo := newStore(t)
+				a, b := newReplicas(t, jobRepo, fakegithub.New(nil))
+				ctx := context.Background()
+
+				created := time.Now().Add(-time.Hour)
+				expired := time.Now().Add(-time.Second)
+				live := time.Now().Add(time.Hour)
+				for _, job := range []*entity.ParsingJob{
+					{ID: "orphan", Status: entity.JobStatusInProgress, Attempts: 1, CompletedSteps: []string{entity.JobStepRepository},
+						LeaseOwner: "replica-gone", LeaseExpiresAt: &expired},
+					{ID: "held", Status: entity.JobStatusInProgress, Attempts: 1, LeaseOwner: "replica-busy", LeaseExpiresAt: &live},
+				} {
+					job.Params = entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo", ParseIssues: true}
+					job.CreatedAt = created
+					if err := jobRepo.Save(ctx, job); err != nil {
+						t.Fatalf("save job: %v", err)
+					}
+				}
+
+				job := waitForJob(t, jobRepo, "orphan", (*entity.ParsingJob).Finished)
+				if job.Status != entity.JobStatusCompleted || job.Attempts != 2 {
+					t.Errorf("orphan is %s after %d attempts, want completed after 2", job.Status, job.Attempts)
+				}
+
+				// Exactly one replica took the job over
+				takeovers := 0
+				for _, transition := range job.Transitions {
+					if strings.Contains(transition.Message, "expired lease of replica replica-gone") {
+						takeovers++
+					}
+				}
+				if takeovers != 1 {
+					t.Errorf("job was taken over %d times, want once: %+v", takeovers, job.Transitions)
+				}
+
+				held, _ := jobRepo.FindByID(ctx, "held")
+				if held.Status != entity.JobStatusInProgress || held.LeaseOwner != "replica-busy" || len(held.Transitions) != 0 {
+					t.Errorf("job with a live lease was touched: %+v", held)
+				}
+				if a.tracked("held") || b.tracked("held") {
+					t.Error("a replica tracks a job another replica holds")
+				}
+			})
+
+			t.Run("stop through another replica", func(t *testing.T) {
+				jobRepo := newStore(t)
+				fake := fakegithub.New(nil)
+				a, b := newReplicas(t, jobRepo, fake)
+				a.pageSize = 5
+				fake.SetLatency(50 * time.Millisecond)
+				ctx := context.Background()
+
+				id, err := a.StartParsingJob(ctx, entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo", ParseIssues: true})
+				if err != nil {
+					t.Fatalf("StartParsingJob: %v", err)
+				}
+				waitForJob(t, jobRepo, id, func(job *entity.ParsingJob) bool { return job.Status == entity.JobStatusInProgress })
+
+				stopCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+				defer cancel()
+				status, err := b.PauseParsingJob(stopCtx, id)
+				if err != nil {
+					t.Fatalf("PauseParsingJob on the other replica: %v", err)
+				}
+				if status.Status != entity.JobStatusPaused {
+					t.Fatalf("job is %s, want paused", status.Status)
+				}
+
+				// The pause request is gone with the lease, so the resumed job runs to the end
+				if _, err := b.ResumeParsingJob(ctx, id); err != nil {
+					t.Fatalf("ResumeParsingJob: %v", err)
+				}
+				fake.SetLatency(0)
+				job := waitForJob(t, jobRepo, id, (*entity.ParsingJob).Finished)
+				if job.Status != entity.JobStatusCompleted || job.StopRequest != "" {
+					t.Errorf("resumed job is %s with stop request %q, want completed", job.Status, job.StopRequest)
+				}
+			})
+
+			t.Run("watch through another replica", func(t *testing.T) {
+				jobRepo := newStore(t)
+				fake := fakegithub.New(nil)
+				a, b := newReplicas(t, jobRepo, fake)
+				a.pageSize = 10
+				fake.SetLatency(20 * time.Millisecond)
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				id, err := a.StartParsingJob(ctx, entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo", ParseIssues: true})
+				if err != nil {
+					t.Fatalf("StartParsingJob: %v", err)
+				}
+
+				events, err := b.WatchParsingJob(ctx, id)
+				if err != nil {
+					t.Fatalf("WatchParsingJob on the other replica: %v", err)
+				}
+
+				var last entity.JobEvent
+				for event := range events {
+					last = event
+				}
+				if last.Type != entity.JobEventStatus || last.Status != entity.JobStatusCompleted {
+					t.Errorf("last event = %s %s, want status completed", last.Type, last.Status)
+				}
+			})
+
+			t.Run("lost lease", func(t *testing.T) {
+				jobRepo := newStore(t)
+				fake := fakegithub.New(nil)
+				a, _ := newReplicas(t, jobRepo, fake)
+				a.pageSize = 5
+				fake.SetLatency(50 * time.Millisecond)
+				ctx := context.Background()
+
+				id, err := a.StartParsingJob(ctx, entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo", ParseIssues: true})
+				if err != nil {
+					t.Fatalf("StartParsingJob: %v", err)
+				}
+				waitForJob(t, jobRepo, id, func(job *entity.ParsingJob) bool { return job.Status == entity.JobStatusInProgress })
+
+				// A replica whose clock is far ahead considers the lease expired and takes the job
+				later := time.Now().Add(time.Hour)
+				claimed, err := jobRepo.Claim(ctx, id, "replica-c", later, later.Add(time.Hour), entity.JobStatusInProgress)
+				if err != nil || claimed == nil {
+					t.Fatalf("Claim = %v, %v", claimed, err)
+				}
+
+				deadline := time.Now().Add(5 * time.Second)
+				for a.tracked(id) && time.Now().Before(deadline) {
+					time.Sleep(10 * time.Millisecond)
+				}
+				if a.tracked(id) {
+					t.Fatal("replica kept running a job it lost")
+				}
+
+				job, _ := jobRepo.FindByID(ctx, id)
+				if job.Status != entity.JobStatusInProgress || job.LeaseOwner != "replica-c" {
+					t.Errorf("job is %s held by %q, want in_progress held by replica-c", job.Status, job.LeaseOwner)
+				}
+			})
+
+			t.Run("save after losing the lease", func(t *testing.T) {
+				jobRepo := newStore(t)
+				a, _ := newReplicas(t, jobRepo, fakegithub.New(nil))
+				ctx := context.Background()
+
+				// The copy of a replica that has not noticed yet that its lease was taken over
+				live := time.Now().Add(time.Hour)
+				stale := &entity.ParsingJob{ID: "stale", Status: entity.JobStatusInProgress, Attempts: 1,
+					LeaseOwner: "replica-gone", LeaseExpiresAt: &live, CreatedAt: time.Now()}
+				stale.Params = entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo", ParseIssues: true}
+				if err := jobRepo.Save(ctx, stale); err != nil {
+					t.Fatalf("save job: %v", err)
+				}
+				later := live.Add(time.Minute)
+				claimed, err := jobRepo.Claim(ctx, stale.ID, "replica-c", later, later.Add(time.Hour), entity.JobStatusInProgress)
+				if err != nil || claimed == nil {
+					t.Fatalf("Claim = %v, %v", claimed, err)
+				}
+
+				stale.Progress = 50
+				if err := jobRepo.Save(ctx, stale); !errors.Is(err, repository.ErrLeaseLost) {
+					t.Errorf("save of the stale copy: %v, want lease lost", err)
+				}
+				a.completeJob(ctx, stale)
+
+				job, _ := jobRepo.FindByID(ctx, stale.ID)
+				if job.Status != entity.JobStatusInProgress || job.Progress != 0 || job.LeaseOwner != "replica-c" {
+					t.Errorf("stale copy overwrote the job: %s at %d%% held by %q", job.Status, job.Progress, job.LeaseOwner)
+				}
+
+				// The replica holding the job saves as usual
+				claimed.Progress = 50
+				if err := jobRepo.Save(ctx, claimed); err != nil {
+					t.Fatalf("save by the lease owner: %v", err)
+				}
+				if job, _ := jobRepo.FindByID(ctx, stale.ID); job.Progress != 50 {
+					t.Errorf("progress = %d after the lease owner saved 50", job.Progress)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/fakegithub"
+)
+
+// fakeDay is the time of day n of the fake dataset; issue n is created on day n, pull request 100+n on day 45+n
+func fakeDay(n int) time.Time {
+	return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, n)
+}
+
+func TestJobTimeWindow(t *testing.T) {
+	fake := fakegithub.New(nil)
+	s := newFakeParserService(t, fake)
+	ctx := context.Background()
+
+	// Open issues updated from day 40 to day 56
+	id, err := s.StartParsingJob(ctx, entity.ParsingJobParams{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		Since:       fakeDay(40),
+		Until:       fakeDay(56),
+		State:       entity.ItemStateOpen,
+		PerPage:     2,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+	if job := waitForJobStatus(t, s, id); job.Status != entity.JobStatusCompleted || job.Results.Issues != 4 {
+		t.Fatalf("issues job finished as %s with %d issues: %s", job.Status, job.Results.Issues, job.ErrorMessage)
+	}
+
+	issues, err := s.issueRepo.List(ctx, repository.IssueFilter{RepositoryID: 1001})
+	if err != nil {
+		t.Fatalf("List issues: %v", err)
+	}
+	var numbers []int
+	for _, issue := range issues {
+		numbers = append(numbers, issue.Number)
+	}
+	slices.Sort(numbers)
+	if !slices.Equal(numbers, []int{40, 41, 43, 44}) {
+		t.Errorf("stored issues %v, want the open ones of days 40 to 45: 40, 41, 43, 44", numbers)
+	}
+
+	// Pull requests in any state updated from day 50 to day 56
+	id, err = s.StartParsingJob(ctx, entity.ParsingJobParams{
+		OwnerName: "octo",
+		RepoName:  "demo",
+		ParsePRs:  true,
+		Since:     fakeDay(50),
+		Until:     fakeDay(56),
+		PerPage:   2,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+	if job := waitForJobStatus(t, s, id); job.Status != entity.JobStatusCompleted || job.Results.PullRequests != 6 {
+		t.Fatalf("pull requests job finished as %s with %d pull requests: %s", job.Status, job.Results.PullRequests, job.ErrorMessage)
+	}
+
+	// The list is sorted by update time, so it stops at the page reaching past Since
+	var pages []string
+	for _, request := range fake.RequestLog() {
+		if strings.HasPrefix(request, "/repos/octo/demo/pulls?") {
+			pages = append(pages, request)
+		}
+	}
+	if len(pages) != 5 || !strings.Contains(pages[0], "sort=updated") || !strings.Contains(pages[0], "state=all") {
+		t.Errorf("pull request pages fetched: %v", pages)
+	}
+}
+
+func TestJobMaxItems(t *testing.T) {
+	s := newFakeParserService(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	id, err := s.StartParsingJob(ctx, entity.ParsingJobParams{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		ParsePRs:    true,
+		MaxItems:    5,
+		PerPage:     2,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJobStatus(t, s, id)
+	if job.Status != entity.JobStatusCompleted || job.Progress != 100 {
+		t.Fatalf("job finished as %s at %d%%: %s", job.Status, job.Progress, job.ErrorMessage)
+	}
+	if job.Results.Issues != 5 || job.Results.PullRequests != 5 {
+		t.Errorf("job fetched %d issues and %d pull requests, want 5 of each", job.Results.Issues, job.Results.PullRequests)
+	}
+	for _, step := range []string{entity.JobStepIssues, entity.JobStepPullRequests} {
+		if result := job.StepResult(step); result.Items != 5 || result.Total != 5 {
+			t.Errorf("step %s fetched %d of %d items", step, result.Items, result.Total)
+		}
+	}
+
+	prs, err := s.prRepo.List(ctx, repository.PullRequestFilter{RepositoryID: 1001})
+	if err != nil || len(prs) != 5 {
+		t.Errorf("stored %d pull requests, want 5: %v", len(prs), err)
+	}
+}
+
+func TestJobTimeout(t *testing.T) {
+	fake := fakegithub.New(nil)
+	fake.SetLatency(100 * time.Millisecond)
+	s := newFakeParserService(t, fake)
+
+	id, err := s.StartParsingJob(context.Background(), entity.ParsingJobParams{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		Timeout:     150 * time.Millisecond,
+		Retry:       entity.RetryPolicy{MaxAttempts: 1},
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJobStatus(t, s, id)
+	if job.Status != entity.JobStatusFailed || !strings.Contains(job.ErrorMessage, "deadline exceeded") {
+		t.Errorf("job finished as %s: %q, want failed on the deadline", job.Status, job.ErrorMessage)
+	}
+}
+
+func TestJobOptionsRejected(t *testing.T) {
+	s := newFakeParserService(t, fakegithub.New(nil))
+
+	for name, params := range map[string]entity.ParsingJobParams{
+		"window":   {Since: fakeDay(2), Until: fakeDay(1)},
+		"state":    {State: "merged"},
+		"max":      {MaxItems: -1},
+		"timeout":  {Timeout: -time.Second},
+		"per page": {PerPage: 101},
+	} {
+		params.OwnerName, params.RepoName = "octo", "demo"
+		if _, err := s.StartParsingJob(context.Background(), params); !errors.Is(err, domainService.ErrInvalidJobOptions) {
+			t.Errorf("%s: got %v, want ErrInvalidJobOptions", name, err)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/fakegithub"
+)
+
+func TestJobStepsRunConcurrently(t *testing.T) {
+	fake := fakegithub.New(nil)
+	fake.SetLatency(20 * time.Millisecond)
+	s := newFakeParserService(t, fake)
+	s.pageSize = 10
+
+	id, err := s.StartParsingJob(context.Background(), entity.ParsingJobParams{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		ParsePRs:    true,
+		ParseUsers:  true,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJobStatus(t, s, id)
+	if job.Status != entity.JobStatusCompleted || job.Results.Issues != 45 || job.Results.PullRequests != 12 || job.Results.Users != 1 {
+		t.Fatalf("job finished as %s with %+v: %s", job.Status, job.Results, job.ErrorMessage)
+	}
+
+	// The issue and pull request steps overlap
+	issues, prs := job.StepResult(entity.JobStepIssues), job.StepResult(entity.JobStepPullRequests)
+	if !issues.StartedAt.Before(*prs.FinishedAt) || !prs.StartedAt.Before(*issues.FinishedAt) {
+		t.Errorf("issues ran from %s to %s, pull requests from %s to %s",
+			issues.StartedAt, issues.FinishedAt, prs.StartedAt, prs.FinishedAt)
+	}
+
+	// The repository is looked up once for all steps
+	lookups := 0
+	for _, request := range fake.RequestLog() {
+		if request == "/repos/octo/demo" {
+			lookups++
+		}
+	}
+	if lookups != 1 {
+		t.Errorf("repository looked up %d times, want once", lookups)
+	}
+}
+
+func TestJobStepFailureStopsOthers(t *testing.T) {
+	fake := fakegithub.New(nil)
+	fake.SetLatency(20 * time.Millisecond)
+	fake.InjectFault(fakegithub.Fault{Kind: fakegithub.FaultNotFound, PathPrefix: "/repos/octo/demo/issues"})
+	s := newFakeParserService(t, fake)
+	s.pageSize = 1
+
+	id, err := s.StartParsingJob(context.Background(), entity.ParsingJobParams{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		ParsePRs:    true,
+		Retry:       entity.RetryPolicy{MaxAttempts: 1},
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJobStatus(t, s, id)
+	if job.Status != entity.JobStatusFailed {
+		t.Fatalf("job finished as %s, want failed", job.Status)
+	}
+	if result := job.StepResult(entity.JobStepIssues); result.Status != entity.StepStatusFailed {
+		t.Errorf("issues step = %+v, want failed", result)
+	}
+	if result := job.StepResult(entity.JobStepPullRequests); result.Status != entity.StepStatusStopped || result.Items >= 12 {
+		t.Errorf("pull requests step = %+v, want stopped early", result)
+	}
+}
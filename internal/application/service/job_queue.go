@@ -0,0 +1,169 @@
+package service
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/metrics"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+)
+
+// JobQueue is a bounded priority queue of parsing jobs drained by a fixed number of workers.
+// Jobs with a higher priority run first, jobs of equal priority in FIFO order.
+type JobQueue struct {
+	workers   int
+	maxLength int
+	metrics   *metrics.Metrics
+	logger    *logger.Logger
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	items  queuedJobs
+	seq    uint64
+	closed bool
+}
+
+// NewJobQueue creates a queue holding at most maxLength waiting jobs for the given number of workers
+func NewJobQueue(workers, maxLength int, metrics *metrics.Metrics, logger *logger.Logger) *JobQueue {
+	if workers < 1 {
+		workers = 1
+	}
+
+	q := &JobQueue{
+		workers:   workers,
+		maxLength: maxLength,
+		metrics:   metrics,
+		logger:    logger,
+	}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+// Push adds a job to the queue; it returns ErrJobQueueFull when maxLength jobs are already waiting
+func (q *JobQueue) Push(job *entity.ParsingJob) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.maxLength > 0 && len(q.items) >= q.maxLength {
+		if q.metrics != nil {
+			q.metrics.JobQueueRejected.Inc()
+		}
+		return domainService.ErrJobQueueFull
+	}
+
+	q.seq++
+	heap.Push(&q.items, &queuedJob{job: job, seq: q.seq, enqueuedAt: time.Now()})
+	q.updateDepth()
+	q.cond.Signal()
+	return nil
+}
+
+// Remove takes a waiting job out of the queue; it reports false when the job is not waiting
+func (q *JobQueue) Remove(jobID string) (*entity.ParsingJob, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, item := range q.items {
+		if item.job.ID == jobID {
+			heap.Remove(&q.items, i)
+			q.updateDepth()
+			return item.job, true
+		}
+	}
+	return nil, false
+}
+
+// Len returns the number of waiting jobs
+func (q *JobQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
+
+// Start runs the workers until ctx is done; each job is passed to handle.
+// Jobs still waiting when ctx is done stay pending and are picked up by job recovery on the next start.
+func (q *JobQueue) Start(ctx context.Context, handle func(ctx context.Context, job *entity.ParsingJob)) {
+	go func() {
+		<-ctx.Done()
+		q.mu.Lock()
+		q.closed = true
+		q.mu.Unlock()
+		q.cond.Broadcast()
+	}()
+
+	for i := 0; i < q.workers; i++ {
+		go func() {
+			for {
+				job, ok := q.pop()
+				if !ok {
+					return
+				}
+				handle(ctx, job)
+			}
+		}()
+	}
+
+	q.logger.Info("Started %d parsing job workers, queue length limit %d", q.workers, q.maxLength)
+}
+
+// pop blocks until a job is available or the queue is closed
+func (q *JobQueue) pop() (*entity.ParsingJob, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.items) == 0 && !q.closed {
+		q.cond.Wait()
+	}
+	if q.closed {
+		return nil, false
+	}
+
+	item := heap.Pop(&q.items).(*queuedJob)
+	q.updateDepth()
+	if q.metrics != nil {
+		q.metrics.JobQueueWait.Observe(time.Since(item.enqueuedAt).Seconds())
+	}
+
+	return item.job, true
+}
+
+// updateDepth reports the queue length; callers hold q.mu
+func (q *JobQueue) updateDepth() {
+	if q.metrics != nil {
+		q.metrics.JobQueueDepth.Set(float64(len(q.items)))
+	}
+}
+
+type queuedJob struct {
+	job        *entity.ParsingJob
+	seq        uint64
+	enqueuedAt time.Time
+}
+
+// queuedJobs implements heap.Interface ordered by priority, then by arrival
+type queuedJobs []*queuedJob
+
+func (h queuedJobs) Len() int { return len(h) }
+
+func (h queuedJobs) Less(i, j int) bool {
+	if h[i].job.Params.Priority != h[j].job.Params.Priority {
+		return h[i].job.Params.Priority > h[j].job.Params.Priority
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h queuedJobs) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *queuedJobs) Push(x interface{}) { *h = append(*h, x.(*queuedJob)) }
+
+func (h *queuedJobs) Pop() interface{} {
+	old := *h
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return item
+}
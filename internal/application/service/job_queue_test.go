@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+)
+
+func TestJobQueueOrder(t *testing.T) {
+	q := NewJobQueue(1, 0, nil, testLogger())
+
+	for _, job := range []struct {
+		id       string
+		priority int
+	}{
+		{"low-1", 0}, {"high-1", 5}, {"low-2", 0}, {"high-2", 5}, {"mid", 1},
+	} {
+		if err := q.Push(&entity.ParsingJob{ID: job.id, Params: entity.ParsingJobParams{Priority: job.priority}}); err != nil {
+			t.Fatalf("Push(%s): %v", job.id, err)
+		}
+	}
+
+	var (
+		mu    sync.Mutex
+		order []string
+		done  = make(chan struct{})
+	)
+	q.Start(t.Context(), func(ctx context.Context, job *entity.ParsingJob) {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, job.ID)
+		if len(order) == 5 {
+			close(done)
+		}
+	})
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("jobs were not processed")
+	}
+
+	want := []string{"high-1", "high-2", "mid", "low-1", "low-2"}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("order = %v, want %v", order, want)
+		}
+	}
+}
+
+func TestJobQueueFull(t *testing.T) {
+	q := NewJobQueue(1, 2, nil, testLogger())
+
+	for i := 0; i < 2; i++ {
+		if err := q.Push(&entity.ParsingJob{}); err != nil {
+			t.Fatalf("Push %d: %v", i, err)
+		}
+	}
+
+	if err := q.Push(&entity.ParsingJob{}); !errors.Is(err, domainService.ErrJobQueueFull) {
+		t.Fatalf("err = %v, want ErrJobQueueFull", err)
+	}
+	if q.Len() != 2 {
+		t.Errorf("len = %d, want 2", q.Len())
+	}
+}
+
+func TestJobQueueBoundsConcurrency(t *testing.T) {
+	const workers = 3
+	q := NewJobQueue(workers, 0, nil, testLogger())
+
+	var running, peak, processed atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		q.Push(&entity.ParsingJob{})
+	}
+
+	q.Start(t.Context(), func(ctx context.Context, job *entity.ParsingJob) {
+		defer wg.Done()
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+		processed.Add(1)
+	})
+
+	wg.Wait()
+	if peak.Load() > workers {
+		t.Errorf("peak concurrency = %d, want at most %d", peak.Load(), workers)
+	}
+	if processed.Load() != 20 {
+		t.Errorf("processed = %d, want 20", processed.Load())
+	}
+}
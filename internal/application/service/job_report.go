@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/callstats"
+)
+
+// stepStats counts what the running step of a job saved and the GitHub API calls it made;
+// parse functions reach it through the step context
+type stepStats struct {
+	mu    sync.Mutex
+	usage entity.StepUsage
+	calls callstats.Stats
+}
+
+type stepStatsKey struct{}
+
+// withStepStats starts counting a step on top of the usage recorded when it started
+func withStepStats(ctx context.Context, base entity.StepUsage) (context.Context, *stepStats) {
+	stats := &stepStats{usage: base}
+	stats.usage.SampleErrors = slices.Clone(base.SampleErrors)
+	stats.usage.FailedItems = slices.Clone(base.FailedItems)
+
+	ctx = callstats.WithStats(ctx, &stats.calls)
+	return context.WithValue(ctx, stepStatsKey{}, stats), stats
+}
+
+// snapshot returns the counts so far
+func (st *stepStats) snapshot() entity.StepUsage {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	usage := st.usage
+	usage.SampleErrors = slices.Clone(st.usage.SampleErrors)
+	usage.FailedItems = slices.Clone(st.usage.FailedItems)
+	usage.APICalls += st.calls.Calls()
+	usage.RateLimitHits += st.calls.RateLimited()
+	return usage
+}
+
+// itemsSaved counts objects saved by the job step running in ctx
+func itemsSaved(ctx context.Context, n int) {
+	stats, ok := ctx.Value(stepStatsKey{}).(*stepStats)
+	if !ok || n == 0 {
+		return
+	}
+
+	stats.mu.Lock()
+	stats.usage.Saved += n
+	stats.mu.Unlock()
+}
+
+// pageSaved counts the issues or pull requests of a page saved by the job step running in ctx
+// and keeps the ones that failed; itemFailed counts those already
+func pageSaved(ctx context.Context, result entity.SaveResult) {
+	stats, ok := ctx.Value(stepStatsKey{}).(*stepStats)
+	if !ok {
+		return
+	}
+
+	stats.mu.Lock()
+	defer stats.mu.Unlock()
+	stats.usage.Saved += result.Saved()
+	stats.usage.Created += result.Created
+	stats.usage.Updated += result.Updated
+	stats.usage.Unchanged += result.Unchanged
+	for _, failure := range result.Failures {
+		stats.usage.AddFailedItem(failure)
+	}
+}
+
+// itemFailed counts an object the job step running in ctx could not save and tells the watchers of the job
+func (s *ParserServiceImpl) itemFailed(ctx context.Context, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+
+	if stats, ok := ctx.Value(stepStatsKey{}).(*stepStats); ok {
+		stats.mu.Lock()
+		stats.usage.Failed++
+		stats.usage.AddSample(message)
+		stats.mu.Unlock()
+	}
+
+	s.jobWarning(ctx, "%s", message)
+}
+
+// updateProgress moves the progress of a running job up to the done share of its steps.
+// Progress never goes back when a step reports its total and stays below 100 until the job completes.
+func (s *ParserServiceImpl) updateProgress(job *entity.ParsingJob, steps []string) {
+	progress := min(job.ComputeProgress(steps), 99)
+	if progress <= job.Progress {
+		return
+	}
+
+	job.Progress = progress
+	s.publishJobEvent(job, entity.JobEventProgress, "")
+}
+
+// stepNames lists the names of steps in order
+func stepNames(steps []jobStep) []string {
+	names := make([]string, len(steps))
+	for i, step := range steps {
+		names[i] = step.name
+	}
+	return names
+}
+
+// GetParsingJobReport returns what a job did per step; the report of a batch job sums up its children
+func (s *ParserServiceImpl) GetParsingJobReport(ctx context.Context, jobID string) (*domainService.ParsingJobReport, error) {
+	job, err := s.findJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := domainService.NewParsingJobReport(job, time.Now())
+	if job.Batch == nil {
+		return report, nil
+	}
+
+	children, err := s.batchChildren(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range children {
+		for _, step := range child.Steps {
+			report.Items += step.Items
+			report.Usage.Add(step.StepUsage)
+		}
+	}
+
+	return report, nil
+}
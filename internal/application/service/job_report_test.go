@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/fakegithub"
+)
+
+// flakyIssueRepo fails the save of one issue
+type flakyIssueRepo struct {
+	repository.IssueRepository
+	mu     sync.Mutex
+	saves  int
+	failAt int
+}
+
+func (r *flakyIssueRepo) Save(ctx context.Context, issue *entity.Issue) (entity.SaveOutcome, error) {
+	r.mu.Lock()
+	r.saves++
+	fail := r.saves == r.failAt
+	r.mu.Unlock()
+
+	if fail {
+		return "", errors.New("disk full")
+	}
+	return r.IssueRepository.Save(ctx, issue)
+}
+
+func TestParsingJobReport(t *testing.T) {
+	fake := fakegithub.New(nil)
+	s := newFakeParserService(t, fake)
+	s.pageSize = 10
+	s.issueRepo = &flakyIssueRepo{IssueRepository: s.issueRepo, failAt: 3}
+	ctx := context.Background()
+
+	// The third page of issues hits the secondary rate limit once; the retry continues with it
+	fake.InjectFault(fakegithub.Fault{Kind: fakegithub.FaultAbuseLimit, PathPrefix: "/repos/octo/demo/issues", Skip: 2, Count: 1, RetryAfter: time.Second})
+
+	backoff := 20 * time.Millisecond
+	id, err := s.StartParsingJob(ctx, entity.ParsingJobParams{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		Retry:       entity.RetryPolicy{MaxAttempts: 2, Backoff: backoff},
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJobStatus(t, s, id)
+	if job.Status != entity.JobStatusCompleted || job.Progress != 100 {
+		t.Fatalf("job %s at %d%%: %s", job.Status, job.Progress, job.ErrorMessage)
+	}
+
+	report, err := s.GetParsingJobReport(ctx, id)
+	if err != nil {
+		t.Fatalf("GetParsingJobReport: %v", err)
+	}
+	if len(report.Steps) != 2 || report.Duration <= 0 || report.Attempts != 2 {
+		t.Fatalf("report = %+v, want 2 steps of 2 attempts", report)
+	}
+
+	issues := report.Steps[1]
+	if issues.Step != entity.JobStepIssues || issues.Items != 45 || issues.Total != 45 {
+		t.Errorf("issues step fetched %d of %d items, want 45 of 45", issues.Items, issues.Total)
+	}
+	if issues.Saved != 44 || issues.Failed != 1 || len(issues.SampleErrors) != 1 ||
+		!strings.HasPrefix(issues.SampleErrors[0], "failed to save issue #") || !strings.HasSuffix(issues.SampleErrors[0], "disk full") {
+		t.Errorf("issues step saved %d, failed %d with %q", issues.Saved, issues.Failed, issues.SampleErrors)
+	}
+	if issues.Created != 44 || issues.Updated != 0 || issues.Unchanged != 0 ||
+		len(issues.FailedItems) != 1 || issues.FailedItems[0].Reason != "disk full" {
+		t.Errorf("issues step created %d, updated %d, unchanged %d, failed items %+v",
+			issues.Created, issues.Updated, issues.Unchanged, issues.FailedItems)
+	}
+	if issues.RateLimitHits != 1 || issues.RateLimitWait != backoff {
+		t.Errorf("issues step hit the rate limit %d times and waited %s, want once for %s", issues.RateLimitHits, issues.RateLimitWait, backoff)
+	}
+
+	// Every request to the API is counted by exactly one step, across both attempts
+	if calls := len(fake.RequestLog()); report.Usage.APICalls != calls {
+		t.Errorf("report counts %d API calls, the API got %d", report.Usage.APICalls, calls)
+	}
+	if report.Items != 46 || report.Usage.Saved != 45 || report.Usage.Failed != 1 {
+		t.Errorf("report totals: %d items, %d saved, %d failed", report.Items, report.Usage.Saved, report.Usage.Failed)
+	}
+}
+
+func TestComputeProgress(t *testing.T) {
+	steps := []string{entity.JobStepRepository, entity.JobStepIssues, entity.JobStepPullRequests}
+	job := &entity.ParsingJob{}
+
+	if progress := job.ComputeProgress(steps); progress != 0 {
+		t.Errorf("progress before any step = %d, want 0", progress)
+	}
+
+	job.StartStep(entity.JobStepRepository, time.Now())
+	job.CompletedSteps = append(job.CompletedSteps, entity.JobStepRepository)
+	if progress := job.ComputeProgress(steps); progress != 33 {
+		t.Errorf("progress after 1 of 3 steps = %d, want 33", progress)
+	}
+
+	// Once the issues step knows its total, it weighs its items
+	job.StartStep(entity.JobStepIssues, time.Now())
+	result := job.StepResult(entity.JobStepIssues)
+	result.Total = 98
+	result.Items = 49
+	if progress := job.ComputeProgress(steps); progress != 50 {
+		t.Errorf("progress at half of the issues = %d, want 50", progress)
+	}
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/google/go-github/v39/github"
+)
+
+// retryOrFailJob handles a failed step: after a transient error the job waits and is queued again
+// while its retry policy allows, a job out of attempts goes to dead_letter, anything else fails.
+// It reports a cancel or pause request that arrived meanwhile instead.
+func (s *ParserServiceImpl) retryOrFailJob(ctx, jobCtx context.Context, job *entity.ParsingJob, step jobStep, err error) {
+	message := step.failure
+	policy := job.Params.Retry
+	if !retryableError(err) || policy.MaxAttempts <= 1 {
+		s.failJob(ctx, job, entity.JobStatusFailed, message, err)
+		return
+	}
+
+	job.FailedAttempts++
+	if job.FailedAttempts >= policy.MaxAttempts {
+		s.failJob(ctx, job, entity.JobStatusDeadLetter, fmt.Sprintf("%s after %d attempts", message, job.FailedAttempts), err)
+		return
+	}
+
+	// The lock keeps a stop request from slipping in between the check and the retry being registered;
+	// the job is saved after the lock is released, the timer and stop requests wait for the save
+	s.activeMu.Lock()
+	if stopRequested(jobCtx) {
+		s.activeMu.Unlock()
+		s.jobStopped(ctx, jobCtx, job)
+		return
+	}
+
+	delay := policy.Delay(job.FailedAttempts)
+	next := time.Now().Add(delay)
+	if result := job.StepResult(step.name); result != nil && rateLimitError(err) {
+		result.RateLimitWait += delay
+	}
+	job.ErrorMessage = fmt.Sprintf("%s: %v", message, err)
+	job.NextAttemptAt = &next
+	saved := make(chan struct{})
+	s.armRetry(job, delay, saved)
+	s.activeMu.Unlock()
+
+	s.logger.Warn("Job %s failed attempt %d of %d, retrying in %s: %s", job.ID, job.FailedAttempts, policy.MaxAttempts, delay, job.ErrorMessage)
+	s.transitionJob(ctx, job, entity.JobStatusRetrying, fmt.Sprintf("%s; retrying in %s", job.ErrorMessage, delay))
+	s.moveJobMetric(entity.JobStatusInProgress, entity.JobStatusRetrying)
+	close(saved)
+}
+
+// armRetry tracks a retrying job and queues it again after delay; callers hold s.activeMu.
+// A non-nil saved is closed by the caller once it stored the job.
+func (s *ParserServiceImpl) armRetry(job *entity.ParsingJob, delay time.Duration, saved chan struct{}) {
+	active := &activeJob{job: job, saved: saved, done: make(chan struct{})}
+	active.retry = time.AfterFunc(delay, func() { s.retryJob(active) })
+	s.active[job.ID] = active
+}
+
+// retryJob queues a job whose backoff has passed, unless it was stopped while waiting.
+// The job is saved as pending outside s.activeMu; a stop request arriving meanwhile is recorded here.
+func (s *ParserServiceImpl) retryJob(active *activeJob) {
+	active.waitSaved()
+
+	s.activeMu.Lock()
+	job := active.job
+	if s.active[job.ID] != active || active.retry == nil {
+		s.activeMu.Unlock()
+		return
+	}
+	// From here a stop request leaves its cause in active.stop, as for a job not yet started
+	active.retry = nil
+	s.activeMu.Unlock()
+
+	ctx := context.Background()
+	s.transitionJob(ctx, job, entity.JobStatusPending, fmt.Sprintf("retry attempt %d", job.Attempts+1))
+
+	s.activeMu.Lock()
+	if s.active[job.ID] != active {
+		s.activeMu.Unlock()
+		return
+	}
+
+	if cause := active.stop; cause != nil {
+		delete(s.active, job.ID)
+		s.activeMu.Unlock()
+
+		// A job whose lease was lost is recorded by the replica holding it
+		if cause != errLeaseLost {
+			s.recordJobStop(ctx, job, cause, entity.JobStatusRetrying)
+			s.releaseJob(ctx, job.ID)
+		}
+		close(active.done)
+		return
+	}
+
+	if err := s.jobQueue.Push(job); err != nil {
+		// Try again later rather than losing the job
+		delay := job.Params.Retry.Delay(job.FailedAttempts)
+		next := time.Now().Add(delay)
+		job.NextAttemptAt = &next
+		saved := make(chan struct{})
+		active.saved = saved
+		active.retry = time.AfterFunc(delay, func() { s.retryJob(active) })
+		s.activeMu.Unlock()
+
+		s.logger.Warn("Could not queue retry of job %s, trying again in %s: %v", job.ID, delay, err)
+		s.transitionJob(ctx, job, entity.JobStatusRetrying, fmt.Sprintf("retry rejected: %v", err))
+		close(saved)
+		return
+	}
+	s.activeMu.Unlock()
+
+	s.moveJobMetric(entity.JobStatusRetrying, entity.JobStatusPending)
+}
+
+// RetryParsingJob queues a failed, dead-lettered or interrupted job again with a fresh retry budget.
+// Completed steps are not repeated.
+func (s *ParserServiceImpl) RetryParsingJob(ctx context.Context, jobID string) (*domainService.ParsingJobStatus, error) {
+	job, err := s.findJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Params.JobType == entity.JobTypeBatch {
+		return nil, fmt.Errorf("%w: batch job %s cannot be retried, retry its child jobs instead", domainService.ErrJobState, jobID)
+	}
+
+	from := job.Status
+	switch from {
+	case entity.JobStatusFailed, entity.JobStatusDeadLetter, entity.JobStatusInterrupted:
+	default:
+		return nil, fmt.Errorf("%w: job %s is %s", domainService.ErrJobState, jobID, job.Status)
+	}
+
+	job.FailedAttempts = 0
+	job.ErrorMessage = ""
+	s.transitionJob(ctx, job, entity.JobStatusPending, "retried by request")
+	if err := s.enqueueJob(ctx, job); err != nil {
+		// Another replica picked the pending job up first and runs it
+		if errors.Is(err, errJobLeased) {
+			return s.GetParsingJobStatus(ctx, jobID)
+		}
+
+		job.ErrorMessage = fmt.Sprintf("retry rejected: %v", err)
+		s.transitionJob(ctx, job, from, job.ErrorMessage)
+		s.releaseJob(ctx, jobID)
+		return nil, err
+	}
+
+	s.moveJobMetric(from, entity.JobStatusPending)
+
+	return s.GetParsingJobStatus(ctx, jobID)
+}
+
+// retryableError reports whether a failed step may succeed when tried again:
+// rate limits, server errors, timeouts and network or database trouble are transient,
+// while other GitHub API errors such as 404 or 401 are not.
+func retryableError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var gone *domainService.RepositoryGoneError
+	if errors.As(err, &gone) {
+		return false
+	}
+
+	if rateLimitError(err) {
+		return true
+	}
+
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		code := errResp.Response.StatusCode
+		return code >= http.StatusInternalServerError || code == http.StatusTooManyRequests
+	}
+
+	return true
+}
+
+// rateLimitError reports whether GitHub refused a call because of its primary or secondary rate limit
+func rateLimitError(err error) bool {
+	var rateLimit *github.RateLimitError
+	var abuse *github.AbuseRateLimitError
+	return errors.As(err, &rateLimit) || errors.As(err, &abuse)
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/fakegithub"
+	"github.com/google/go-github/v39/github"
+)
+
+func TestRetryableError(t *testing.T) {
+	apiError := func(code int) error {
+		return fmt.Errorf("failed to list issues: %w", &github.ErrorResponse{Response: &http.Response{StatusCode: code}})
+	}
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"server error", apiError(http.StatusBadGateway), true},
+		{"too many requests", apiError(http.StatusTooManyRequests), true},
+		{"rate limit", &github.RateLimitError{}, true},
+		{"abuse limit", &github.AbuseRateLimitError{}, true},
+		{"timeout", fmt.Errorf("request: %w", context.DeadlineExceeded), true},
+		{"network", errors.New("connection reset by peer"), true},
+		{"not found", apiError(http.StatusNotFound), false},
+		{"unauthorized", apiError(http.StatusUnauthorized), false},
+		{"repository gone", &domainService.RepositoryGoneError{FullName: "octo/demo", Reason: "not_found"}, false},
+		{"cancelled", context.Canceled, false},
+	} {
+		if got := retryableError(tc.err); got != tc.want {
+			t.Errorf("%s: retryableError = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := entity.RetryPolicy{MaxAttempts: 10, Backoff: 30 * time.Second, MaxBackoff: 5 * time.Minute}
+
+	for failures, want := range map[int]time.Duration{
+		1: 30 * time.Second,
+		2: time.Minute,
+		4: 4 * time.Minute,
+		5: 5 * time.Minute,
+		9: 5 * time.Minute,
+	} {
+		if got := policy.Delay(failures); got != want {
+			t.Errorf("Delay(%d) = %s, want %s", failures, got, want)
+		}
+	}
+}
+
+// slowRetryRepo holds up the first save of a retrying job until release is closed
+type slowRetryRepo struct {
+	repository.JobRepository
+	blocked chan struct{}
+	release chan struct{}
+	once    sync.Once
+}
+
+func (r *slowRetryRepo) Save(ctx context.Context, job *entity.ParsingJob) error {
+	if job.Status == entity.JobStatusRetrying {
+		first := false
+		r.once.Do(func() { first = true })
+		if first {
+			close(r.blocked)
+			<-r.release
+		}
+	}
+	return r.JobRepository.Save(ctx, job)
+}
+
+func TestRetrySavesOutsideTheActiveLock(t *testing.T) {
+	fake := fakegithub.New(nil)
+	fake.InjectFault(fakegithub.Fault{Kind: fakegithub.FaultServerError, PathPrefix: "/repos/octo/demo/issues", Count: 1})
+	s := newFakeParserService(t, fake)
+	slow := &slowRetryRepo{JobRepository: s.jobRepo, blocked: make(chan struct{}), release: make(chan struct{})}
+	s.jobRepo = slow
+	ctx := context.Background()
+
+	id, err := s.StartParsingJob(ctx, entity.ParsingJobParams{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		Retry:       entity.RetryPolicy{MaxAttempts: 2, Backoff: 10 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	select {
+	case <-slow.blocked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the job was not saved as retrying")
+	}
+
+	// Other jobs are not held up by the save
+	tracked := make(chan bool)
+	go func() { tracked <- s.tracked(id) }()
+	select {
+	case ok := <-tracked:
+		if !ok {
+			t.Error("retrying job is not tracked while it is saved")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("tracked jobs wait for the save of a retrying job")
+	}
+
+	// A cancel arriving meanwhile waits for the save and is not overwritten by it
+	cancelled := make(chan error)
+	go func() {
+		_, err := s.CancelParsingJob(ctx, id)
+		cancelled <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	close(slow.release)
+	if err := <-cancelled; err != nil {
+		t.Fatalf("CancelParsingJob: %v", err)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if job := waitForJobStatus(t, s, id); job.Status != entity.JobStatusCancelled {
+		t.Errorf("job = %s, want cancelled", job.Status)
+	}
+}
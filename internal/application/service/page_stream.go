@@ -0,0 +1,101 @@
+package service
+
+import (
+	"iter"
+
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+)
+
+// pageCheckpoint is called after every saved page with the position of the page
+// and the number of objects fetched with it
+type pageCheckpoint func(page domainService.Page, fetched int)
+
+// listing tells parseIssues and parsePullRequests which items to fetch and where to continue
+type listing struct {
+	opts domainService.ListOptions
+	// page is the first page to fetch
+	page int
+	// max caps the items fetched, fetched counts those fetched before page; max is 0 for no cap
+	max     int
+	fetched int
+}
+
+// fullListing lists all items from the first page
+func (s *ParserServiceImpl) fullListing() listing {
+	return listing{opts: domainService.ListOptions{PerPage: s.pageSize}, page: 1}
+}
+
+// take cuts a fetched page to the cap of the listing and counts it;
+// it reports whether the cap is reached, which ends the list
+func (l *listing) take(n int) (int, bool) {
+	if l.max > 0 && l.fetched+n >= l.max {
+		n = l.max - l.fetched
+		l.fetched = l.max
+		return n, true
+	}
+	l.fetched += n
+	return n, false
+}
+
+// more reports whether the listing goes on with page
+func (l *listing) more(page int) bool {
+	return page > 0 && (l.max == 0 || l.fetched < l.max)
+}
+
+// listPage is one page of a listing; next is where the listing goes on, zero after the last page
+type listPage[T any] struct {
+	number int
+	items  []T
+	next   domainService.Page
+}
+
+// fetchedPage is a page fetched ahead by prefetch
+type fetchedPage[T any] struct {
+	items []T
+	next  domainService.Page
+	err   error
+}
+
+// prefetch fetches page in the background, so the page before it can be saved meanwhile
+func prefetch[T any](page int, fetch func(page int) ([]T, domainService.Page, error)) <-chan fetchedPage[T] {
+	result := make(chan fetchedPage[T], 1)
+	go func() {
+		items, next, err := fetch(page)
+		result <- fetchedPage[T]{items: items, next: next, err: err}
+	}()
+	return result
+}
+
+// streamPages yields the pages of list one at a time, cut to its cap. The page after the one being handled
+// is fetched meanwhile, so no more than two pages are held at once whatever the size of the list.
+// A failed fetch is yielded with the number of its page and ends the stream.
+func streamPages[T any](list *listing, fetch func(page int) ([]T, domainService.Page, error)) iter.Seq2[listPage[T], error] {
+	return func(yield func(listPage[T], error) bool) {
+		if !list.more(list.page) {
+			return
+		}
+
+		pending := prefetch(list.page, fetch)
+		for page := list.page; list.more(page); {
+			fetched := <-pending
+			if fetched.err != nil {
+				yield(listPage[T]{number: page}, fetched.err)
+				return
+			}
+
+			items, next := fetched.items, fetched.next
+			n, capped := list.take(len(items))
+			if items = items[:n]; capped {
+				next = domainService.Page{}
+			}
+			if list.more(next.Next) {
+				pending = prefetch(next.Next, fetch)
+			}
+
+			if !yield(listPage[T]{number: page, items: items, next: next}, nil) {
+				return
+			}
+			page = next.Next
+		}
+	}
+}
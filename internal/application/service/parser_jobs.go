@@ -0,0 +1,1129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/uuid"
+)
+
+// Causes passed to the context of a running job to stop it
+var (
+	errJobCancelled = errors.New("job cancelled")
+	errJobPaused    = errors.New("job paused")
+	// errStepFailed stops the steps running beside a failed one
+	errStepFailed = errors.New("another step failed")
+)
+
+// activeJob tracks a queued or running job so it can be stopped
+type activeJob struct {
+	// cancel is set once a worker starts the job
+	cancel context.CancelCauseFunc
+	// stop holds a stop requested after a worker took the job but before it started
+	stop error
+	// retry is set while the job waits for its next attempt, job is the waiting job
+	retry *time.Timer
+	job   *entity.ParsingJob
+	// saved is closed once the waiting job is stored; job is not touched before that
+	saved chan struct{}
+	done  chan struct{}
+}
+
+// waitSaved waits until the job a retry is armed for is stored
+func (a *activeJob) waitSaved() {
+	if a.saved != nil {
+		<-a.saved
+	}
+}
+
+// jobStep is one unit of work of a parsing job
+type jobStep struct {
+	name    string
+	failure string // prefix of the job error message when the step fails
+	// always steps run again on resume because later steps depend on them
+	always bool
+	// run returns the number of objects the step fetched
+	run func(ctx context.Context) (int, error)
+}
+
+func (s *ParserServiceImpl) StartParsingJob(ctx context.Context, params domainService.ParsingJobParams) (string, error) {
+	if err := domainService.ValidateJobOptions(params); err != nil {
+		return "", err
+	}
+	if err := s.CheckCallback(params.CallbackURL); err != nil {
+		return "", err
+	}
+	if params.Retry.MaxAttempts == 0 {
+		params.Retry = s.retryPolicy
+	}
+
+	// Checked submissions go one at a time, so two of them cannot both pass the checks;
+	// across replicas the unique index on idempotency keys catches the rest
+	if params.IdempotencyKey != "" || params.Dedupe {
+		s.submitMu.Lock()
+		defer s.submitMu.Unlock()
+	}
+
+	if params.IdempotencyKey != "" {
+		existing, err := s.jobRepo.FindByIdempotencyKey(ctx, params.IdempotencyKey)
+		if err != nil {
+			s.logger.Error("Failed to look up idempotency key: %v", err)
+			return "", err
+		}
+		if existing != nil {
+			return s.submittedJob(existing, params)
+		}
+	}
+
+	if params.Dedupe {
+		if err := s.checkDuplicateJob(ctx, params); err != nil {
+			return "", err
+		}
+	}
+
+	now := time.Now()
+	job := newPendingJob(uuid.New(), params, now)
+
+	// The job is created leased, so no other replica takes it over before it is queued here
+	leaseExpiresAt := now.Add(s.leases.TTL)
+	job.LeaseOwner = s.leases.Owner
+	job.LeaseExpiresAt = &leaseExpiresAt
+
+	// Save the job
+	if err := s.jobRepo.Save(ctx, job); err != nil {
+		// Another replica saved a job with the same key first
+		if errors.Is(err, repository.ErrDuplicateJob) {
+			if existing, findErr := s.jobRepo.FindByIdempotencyKey(ctx, params.IdempotencyKey); findErr == nil && existing != nil {
+				return s.submittedJob(existing, params)
+			}
+		}
+		s.logger.Error("Failed to save parsing job: %v", err)
+		return "", err
+	}
+
+	// Queue the job for a worker; a rejected job is kept as failed for the record
+	if err := s.enqueueJob(ctx, job); err != nil {
+		s.logger.Warn("Rejected parsing job %s: %v", job.ID, err)
+		job.ErrorMessage = fmt.Sprintf("rejected: %v", err)
+		s.transitionJob(ctx, job, entity.JobStatusFailed, job.ErrorMessage)
+		s.releaseJob(ctx, job.ID)
+		return "", err
+	}
+
+	// Increment job metrics
+	if s.metrics != nil {
+		s.metrics.ParsingJobs.WithLabelValues(entity.JobStatusPending).Inc()
+		s.metrics.ParsingJobsTotal.Inc()
+	}
+
+	return job.ID, nil
+}
+
+// submittedJob answers a repeated submission with the job created for its idempotency key
+func (s *ParserServiceImpl) submittedJob(existing *entity.ParsingJob, params domainService.ParsingJobParams) (string, error) {
+	if !existing.Params.SameWork(params) {
+		return "", fmt.Errorf("%w: key %q was used for job %s on %s/%s with other options",
+			domainService.ErrIdempotencyConflict, params.IdempotencyKey, existing.ID, existing.Params.OwnerName, existing.Params.RepoName)
+	}
+
+	s.logger.Info("Idempotency key %q matches job %s", params.IdempotencyKey, existing.ID)
+	return existing.ID, nil
+}
+
+// checkDuplicateJob rejects a job while an unfinished job does the same work
+func (s *ParserServiceImpl) checkDuplicateJob(ctx context.Context, params domainService.ParsingJobParams) error {
+	jobType := params.JobType
+	if jobType == "" {
+		jobType = entity.JobTypeParse
+	}
+
+	jobs, err := s.jobRepo.List(ctx, repository.JobFilter{
+		Statuses:  activeJobStatuses,
+		OwnerName: params.OwnerName,
+		RepoName:  params.RepoName,
+		JobType:   jobType,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list active jobs: %v", err)
+		return err
+	}
+
+	for _, job := range jobs {
+		if job.Params.SameWork(params) {
+			return &domainService.DuplicateJobError{JobID: job.ID, Status: job.Status}
+		}
+	}
+	return nil
+}
+
+// newPendingJob creates a job waiting to be queued
+func newPendingJob(id string, params domainService.ParsingJobParams, now time.Time) *entity.ParsingJob {
+	return &entity.ParsingJob{
+		ID:          id,
+		Params:      params,
+		Status:      entity.JobStatusPending,
+		Transitions: []entity.JobTransition{{Status: entity.JobStatusPending, At: now}},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// StartWorkers starts processing queued jobs and renewing their leases until ctx is done
+func (s *ParserServiceImpl) StartWorkers(ctx context.Context) {
+	s.jobQueue.Start(ctx, s.processParsingJob)
+	go s.runLeases(ctx)
+	context.AfterFunc(ctx, s.stopCallbacks)
+
+	s.logger.Info("Holding job leases as %s for %s", s.leases.Owner, s.leases.TTL)
+}
+
+// enqueueJob leases a pending job, queues it and tracks it until it finishes.
+// It fails with errJobLeased when another replica holds the job.
+func (s *ParserServiceImpl) enqueueJob(ctx context.Context, job *entity.ParsingJob) error {
+	if s.tracked(job.ID) {
+		return fmt.Errorf("%w: job %s is already queued or running", domainService.ErrJobState, job.ID)
+	}
+
+	// Claiming is a database call, so it is done before taking the lock
+	claimed, err := s.claimJob(ctx, job.ID, entity.JobStatusPending)
+	if err != nil {
+		return err
+	}
+	if claimed == nil {
+		return leasedError(job)
+	}
+	// The queued copy saves as the lease owner
+	job.LeaseOwner = claimed.LeaseOwner
+	job.LeaseExpiresAt = claimed.LeaseExpiresAt
+
+	s.activeMu.Lock()
+	defer s.activeMu.Unlock()
+
+	if _, ok := s.active[job.ID]; ok {
+		return fmt.Errorf("%w: job %s is already queued or running", domainService.ErrJobState, job.ID)
+	}
+
+	// The lock is held while pushing, so a worker cannot start the job before it is tracked
+	if err := s.jobQueue.Push(job); err != nil {
+		return err
+	}
+
+	s.active[job.ID] = &activeJob{done: make(chan struct{})}
+	return nil
+}
+
+func (s *ParserServiceImpl) GetParsingJobStatus(ctx context.Context, jobID string) (*domainService.ParsingJobStatus, error) {
+	job, err := s.findJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Batch != nil {
+		return s.batchStatus(ctx, job)
+	}
+
+	return domainService.NewParsingJobStatus(job), nil
+}
+
+// ListParsingJobs returns the jobs matching filter, newest first
+func (s *ParserServiceImpl) ListParsingJobs(ctx context.Context, filter repository.JobFilter) ([]*domainService.ParsingJobStatus, error) {
+	jobs, err := s.jobRepo.List(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list parsing jobs: %v", err)
+		return nil, err
+	}
+
+	statuses := make([]*domainService.ParsingJobStatus, 0, len(jobs))
+	for _, job := range jobs {
+		statuses = append(statuses, domainService.NewParsingJobStatus(job))
+	}
+
+	return statuses, nil
+}
+
+// WatchParsingJob streams the events of a job, starting with a state event holding the current job.
+// The channel is closed after the job finishes or when ctx is done.
+// Events of a job run by another replica are read from the store, so only status and progress changes are seen.
+func (s *ParserServiceImpl) WatchParsingJob(ctx context.Context, jobID string) (<-chan entity.JobEvent, error) {
+	// Subscribe before reading the job, so no change between the two is lost
+	w := s.events.subscribe(jobID)
+
+	job, err := s.findJob(ctx, jobID)
+	if err != nil {
+		s.events.unsubscribe(jobID, w)
+		return nil, err
+	}
+
+	out := make(chan entity.JobEvent)
+	go func() {
+		defer close(out)
+		defer s.events.unsubscribe(jobID, w)
+
+		state := entity.JobEvent{
+			Type:     entity.JobEventState,
+			JobID:    job.ID,
+			Status:   job.Status,
+			Progress: job.Progress,
+			At:       job.UpdatedAt,
+			Job:      job,
+		}
+		select {
+		case out <- state:
+		case <-ctx.Done():
+			return
+		}
+		if job.Finished() {
+			return
+		}
+
+		poll := time.NewTicker(s.leases.PollInterval)
+		defer poll.Stop()
+
+		last := state
+		for {
+			var event entity.JobEvent
+			select {
+			case e, ok := <-w.events:
+				if !ok {
+					return
+				}
+				event = e
+			case <-poll.C:
+				remote, ok := s.remoteJobEvent(ctx, jobID, last)
+				if !ok {
+					continue
+				}
+				event = remote
+			case <-ctx.Done():
+				return
+			}
+
+			if event.Status != "" {
+				last = event
+			}
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return
+			}
+			if event.Type == entity.JobEventStatus && entity.FinalJobStatus(event.Status) {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// remoteJobEvent reports a change of a job run by another replica since last, which this replica publishes no events for
+func (s *ParserServiceImpl) remoteJobEvent(ctx context.Context, jobID string, last entity.JobEvent) (entity.JobEvent, bool) {
+	if s.tracked(jobID) {
+		return entity.JobEvent{}, false
+	}
+
+	job, err := s.jobRepo.FindByID(ctx, jobID)
+	if err != nil || job == nil {
+		return entity.JobEvent{}, false
+	}
+
+	event := entity.JobEvent{JobID: job.ID, Status: job.Status, Progress: job.Progress, At: job.UpdatedAt}
+	switch {
+	case job.Status != last.Status:
+		event.Type = entity.JobEventStatus
+	case job.Progress != last.Progress:
+		event.Type = entity.JobEventProgress
+	default:
+		return entity.JobEvent{}, false
+	}
+	return event, true
+}
+
+// findJob loads a job, returning ErrJobNotFound for unknown IDs
+func (s *ParserServiceImpl) findJob(ctx context.Context, jobID string) (*entity.ParsingJob, error) {
+	job, err := s.jobRepo.FindByID(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, fmt.Errorf("%w: %s", domainService.ErrJobNotFound, jobID)
+	}
+	return job, nil
+}
+
+// CancelParsingJob stops a queued, running or paused job for good
+func (s *ParserServiceImpl) CancelParsingJob(ctx context.Context, jobID string) (*domainService.ParsingJobStatus, error) {
+	job, err := s.findJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Params.JobType == entity.JobTypeBatch {
+		return s.cancelBatch(ctx, jobID)
+	}
+
+	return s.stopParsingJob(ctx, jobID, errJobCancelled)
+}
+
+// PauseParsingJob stops a queued or running job after its current step; ResumeParsingJob continues it
+func (s *ParserServiceImpl) PauseParsingJob(ctx context.Context, jobID string) (*domainService.ParsingJobStatus, error) {
+	job, err := s.findJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Params.JobType == entity.JobTypeBatch {
+		return nil, fmt.Errorf("%w: batch job %s cannot be paused, pause its child jobs instead", domainService.ErrJobState, jobID)
+	}
+
+	return s.stopParsingJob(ctx, jobID, errJobPaused)
+}
+
+// stopParsingJob cancels or pauses a job. A running job is stopped through its context
+// and waited for, so the returned status shows how far it got.
+func (s *ParserServiceImpl) stopParsingJob(ctx context.Context, jobID string, cause error) (*domainService.ParsingJobStatus, error) {
+	s.activeMu.Lock()
+	active, ok := s.active[jobID]
+	if !ok {
+		s.activeMu.Unlock()
+
+		job, err := s.findJob(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Another replica may queue or run the job
+		if slices.Contains(entity.LeasedJobStatuses, job.Status) {
+			return s.stopRemoteJob(ctx, job, cause)
+		}
+
+		// Otherwise only a paused job can still be cancelled
+		if cause != errJobCancelled || job.Status != entity.JobStatusPaused {
+			return nil, fmt.Errorf("%w: job %s is %s", domainService.ErrJobState, jobID, job.Status)
+		}
+
+		s.recordJobStop(ctx, job, cause, entity.JobStatusPaused)
+		return s.GetParsingJobStatus(ctx, jobID)
+	}
+
+	// A job still waiting in the queue is stopped right away
+	if job, queued := s.jobQueue.Remove(jobID); queued {
+		delete(s.active, jobID)
+		s.activeMu.Unlock()
+
+		s.recordJobStop(ctx, job, cause, entity.JobStatusPending)
+		s.releaseJob(ctx, jobID)
+		return s.GetParsingJobStatus(ctx, jobID)
+	}
+
+	// So is a job waiting for its next attempt
+	if active.retry != nil {
+		active.retry.Stop()
+		delete(s.active, jobID)
+		close(active.done)
+		s.activeMu.Unlock()
+
+		active.waitSaved()
+		active.job.NextAttemptAt = nil
+		s.recordJobStop(ctx, active.job, cause, entity.JobStatusRetrying)
+		s.releaseJob(ctx, jobID)
+		return s.GetParsingJobStatus(ctx, jobID)
+	}
+
+	if active.cancel != nil {
+		active.cancel(cause)
+	} else {
+		active.stop = cause
+	}
+	s.activeMu.Unlock()
+
+	select {
+	case <-active.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return s.GetParsingJobStatus(ctx, jobID)
+}
+
+// ResumeParsingJob queues a paused job again; it continues after its last completed step
+func (s *ParserServiceImpl) ResumeParsingJob(ctx context.Context, jobID string) (*domainService.ParsingJobStatus, error) {
+	job, err := s.findJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != entity.JobStatusPaused {
+		return nil, fmt.Errorf("%w: job %s is %s", domainService.ErrJobState, jobID, job.Status)
+	}
+
+	s.transitionJob(ctx, job, entity.JobStatusPending, "resumed by request")
+	if err := s.enqueueJob(ctx, job); err != nil {
+		// Another replica picked the pending job up first and runs it
+		if errors.Is(err, errJobLeased) {
+			return s.GetParsingJobStatus(ctx, jobID)
+		}
+
+		// Keep the job paused so it can be resumed later
+		s.transitionJob(ctx, job, entity.JobStatusPaused, fmt.Sprintf("resume rejected: %v", err))
+		s.releaseJob(ctx, jobID)
+		return nil, err
+	}
+
+	s.moveJobMetric(entity.JobStatusPaused, entity.JobStatusPending)
+
+	return s.GetParsingJobStatus(ctx, jobID)
+}
+
+// RecoverJobs takes over jobs left pending, in progress or retrying without a live lease,
+// by a previous run of the server or by a replica that stopped renewing its leases.
+// With resume they are queued again and continue after their last completed step,
+// otherwise they are marked interrupted. Callback deliveries left pending are handled
+// the same way, see recoverCallbacks. StartWorkers repeats it every lease poll interval.
+func (s *ParserServiceImpl) RecoverJobs(ctx context.Context, resume bool) error {
+	s.recoverMu.Lock()
+	defer s.recoverMu.Unlock()
+
+	jobs, err := s.jobRepo.ListUnleased(ctx, time.Now(), entity.LeasedJobStatuses...)
+	if err != nil {
+		s.logger.Error("Failed to list unfinished jobs: %v", err)
+		return err
+	}
+
+	var batches []string
+	for _, job := range jobs {
+		// Batch jobs have no work of their own, they are brought up to date once their children are handled
+		if job.Params.JobType == entity.JobTypeBatch {
+			batches = append(batches, job.ID)
+			continue
+		}
+
+		// Children a batch has not queued yet are not left over, the batch queues them as earlier ones finish
+		if !resume && job.Params.ParentID != "" && job.Status == entity.JobStatusPending && job.LeaseOwner == "" {
+			continue
+		}
+
+		// A job of this replica whose lease renewal is late
+		if s.tracked(job.ID) {
+			continue
+		}
+
+		previousOwner := job.LeaseOwner
+		claimed, err := s.claimJob(ctx, job.ID, entity.LeasedJobStatuses...)
+		if err != nil {
+			s.logger.Error("Failed to claim job %s: %v", job.ID, err)
+			continue
+		}
+		if claimed == nil {
+			// Another replica was faster
+			continue
+		}
+		job = claimed
+
+		leftBy := "a previous run"
+		if previousOwner != "" {
+			leftBy = "replica " + previousOwner
+		}
+
+		if resume && job.Status == entity.JobStatusRetrying && job.NextAttemptAt != nil {
+			// Keep waiting for the rest of the backoff
+			s.logger.Info("Job %s left by %s retries at %s", job.ID, leftBy, job.NextAttemptAt.Format(time.RFC3339))
+			s.activeMu.Lock()
+			s.armRetry(job, time.Until(*job.NextAttemptAt), nil)
+			s.activeMu.Unlock()
+			if s.metrics != nil {
+				s.metrics.ParsingJobs.WithLabelValues(entity.JobStatusRetrying).Inc()
+			}
+			continue
+		}
+
+		if resume {
+			s.logger.Info("Resuming job %s left %s by %s", job.ID, job.Status, leftBy)
+			if job.Status != entity.JobStatusPending {
+				s.transitionJob(ctx, job, entity.JobStatusPending, "resumed after "+recoveryReason(previousOwner))
+			}
+
+			err := s.enqueueJob(ctx, job)
+			if err == nil {
+				if s.metrics != nil {
+					s.metrics.ParsingJobs.WithLabelValues(entity.JobStatusPending).Inc()
+				}
+				continue
+			}
+			// A batch queues the rest of its children as earlier ones finish
+			if job.Params.ParentID != "" && errors.Is(err, domainService.ErrJobQueueFull) {
+				s.releaseJob(ctx, job.ID)
+				continue
+			}
+		}
+
+		s.logger.Warn("Marking job %s left unfinished by %s as interrupted", job.ID, leftBy)
+		job.ErrorMessage = "interrupted by " + recoveryReason(previousOwner)
+		s.transitionJob(ctx, job, entity.JobStatusInterrupted, job.ErrorMessage)
+		s.releaseJob(ctx, job.ID)
+	}
+
+	for _, id := range batches {
+		s.updateBatch(ctx, id)
+	}
+
+	return s.recoverCallbacks(ctx, resume)
+}
+
+// recoveryReason explains why a job was taken over from its previous lease owner
+func recoveryReason(previousOwner string) string {
+	if previousOwner == "" {
+		return "server restart"
+	}
+	return fmt.Sprintf("expired lease of replica %s", previousOwner)
+}
+
+func (s *ParserServiceImpl) processParsingJob(ctx context.Context, job *entity.ParsingJob) {
+	// The job context carries cancel and pause requests into GitHub calls and database writes,
+	// while job updates are saved with ctx so a stopped job is still recorded
+	jobCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	s.activeMu.Lock()
+	active := s.active[job.ID]
+	if active != nil {
+		active.cancel = cancel
+		if active.stop != nil {
+			cancel(active.stop)
+		}
+	}
+	s.activeMu.Unlock()
+
+	defer func() {
+		// A job waiting for a retry is tracked by a new entry and keeps its lease
+		s.activeMu.Lock()
+		done := s.active[job.ID] == active
+		if done {
+			delete(s.active, job.ID)
+		}
+		s.activeMu.Unlock()
+
+		if done {
+			s.releaseJob(ctx, job.ID)
+		}
+
+		if active != nil {
+			close(active.done)
+		}
+	}()
+
+	// Update job status
+	job.Attempts++
+	s.transitionJob(ctx, job, entity.JobStatusInProgress, "")
+	s.moveJobMetric(entity.JobStatusPending, entity.JobStatusInProgress)
+
+	// Create a context with timeout
+	timeout := defaultJobTimeout
+	if job.Params.Timeout > 0 {
+		timeout = job.Params.Timeout
+	}
+	timeoutCtx, cancelTimeout := context.WithTimeout(context.WithValue(jobCtx, jobIDKey{}, job.ID), timeout)
+	defer cancelTimeout()
+
+	// Steps running side by side update the job under mu
+	var mu sync.Mutex
+	steps := s.jobSteps(ctx, job, &mu)
+	names := stepNames(steps)
+
+	// The first step looks the repository up for the others, which then run side by side
+	failed, err := s.runSteps(ctx, jobCtx, timeoutCtx, job, &mu, steps[:1], names, 1)
+	if failed == nil && len(steps) > 1 {
+		failed, err = s.runSteps(ctx, jobCtx, timeoutCtx, job, &mu, steps[1:], names, s.stepParallelism)
+	}
+
+	if failed == nil && !slices.ContainsFunc(names, func(step string) bool { return !job.StepCompleted(step) }) {
+		s.completeJob(ctx, job)
+		return
+	}
+	if s.jobStopped(ctx, jobCtx, job) {
+		return
+	}
+	s.retryOrFailJob(ctx, jobCtx, job, *failed, err)
+}
+
+// runSteps runs steps in order with up to parallelism of them at a time and returns the first step that failed
+// with its error. A failure stops the steps still running and keeps the rest from starting, as does a stop request.
+func (s *ParserServiceImpl) runSteps(ctx, jobCtx, timeoutCtx context.Context, job *entity.ParsingJob, mu *sync.Mutex,
+	steps []jobStep, names []string, parallelism int) (*jobStep, error) {
+	runCtx, stop := context.WithCancelCause(timeoutCtx)
+	defer stop(nil)
+
+	var (
+		wg       sync.WaitGroup
+		failedMu sync.Mutex
+		failed   *jobStep
+		failure  error
+	)
+	slots := make(chan struct{}, max(parallelism, 1))
+	for _, step := range steps {
+		mu.Lock()
+		skip := !step.always && job.StepCompleted(step.name)
+		mu.Unlock()
+		if skip {
+			continue
+		}
+
+		slots <- struct{}{}
+		if stopRequested(jobCtx) || context.Cause(runCtx) == errStepFailed {
+			<-slots
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			if err := s.runStep(ctx, jobCtx, runCtx, job, mu, step, names); err != nil {
+				failedMu.Lock()
+				if failed == nil {
+					failed, failure = &step, err
+					stop(errStepFailed)
+				}
+				failedMu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return failed, failure
+}
+
+// runStep runs one step of a job and records its outcome. It returns the error of a failed step;
+// a step stopped by a stop request or by another step failing is recorded as stopped and returns nil.
+func (s *ParserServiceImpl) runStep(ctx, jobCtx, runCtx context.Context, job *entity.ParsingJob, mu *sync.Mutex, step jobStep, names []string) error {
+	mu.Lock()
+	s.startStep(ctx, job, step.name)
+	stepCtx, stats := withStepStats(runCtx, job.StepResult(step.name).StepUsage)
+	mu.Unlock()
+
+	items, err := step.run(stepCtx)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	switch {
+	case err == nil:
+		s.finishStep(job, step.name, entity.StepStatusCompleted, items, stats, nil)
+		if !job.StepCompleted(step.name) {
+			job.CompletedSteps = append(job.CompletedSteps, step.name)
+		}
+		s.updateProgress(job, names)
+		job.UpdatedAt = time.Now()
+		s.saveJob(ctx, job)
+		return nil
+	case stopRequested(jobCtx):
+		s.finishStep(job, step.name, entity.StepStatusStopped, items, stats, context.Cause(jobCtx))
+		return nil
+	case context.Cause(runCtx) == errStepFailed:
+		s.finishStep(job, step.name, entity.StepStatusStopped, items, stats, errStepFailed)
+		return nil
+	}
+
+	s.finishStep(job, step.name, entity.StepStatusFailed, items, stats, err)
+	return err
+}
+
+// jobSteps lists the steps of a job in execution order; the steps update the job under mu.
+// Checkpoints of paginated steps are saved with ctx, so they are kept when the job is stopped.
+func (s *ParserServiceImpl) jobSteps(ctx context.Context, job *entity.ParsingJob, mu *sync.Mutex) []jobStep {
+	params := job.Params
+
+	if params.JobType == domainService.JobTypeReconcile {
+		return []jobStep{{
+			name:    entity.JobStepReconcile,
+			failure: "failed to reconcile repository",
+			run: func(ctx context.Context) (int, error) {
+				result, err := s.ReconcileRepository(ctx, params.OwnerName, params.RepoName)
+				if err != nil {
+					return 0, err
+				}
+				mu.Lock()
+				job.Reconciliation = result
+				mu.Unlock()
+				return result.IssuesChecked + result.PullRequestsChecked, nil
+			},
+		}}
+	}
+
+	// Start with parsing the repository
+	var repo *entity.Repository
+	steps := []jobStep{{
+		name:    entity.JobStepRepository,
+		failure: "failed to parse repository",
+		always:  true,
+		run: func(ctx context.Context) (int, error) {
+			var err error
+			repo, err = s.ParseRepository(ctx, params.OwnerName, params.RepoName)
+			if err != nil {
+				return 0, err
+			}
+			return 1, nil
+		},
+	}}
+
+	// If we need to parse issues
+	if params.ParseIssues {
+		steps = append(steps, jobStep{
+			name:    entity.JobStepIssues,
+			failure: "failed to parse issues",
+			run: func(stepCtx context.Context) (int, error) {
+				_, err := s.parseIssues(stepCtx, params.OwnerName, params.RepoName, s.jobListing(job, entity.JobStepIssues),
+					params.SavePolicy, s.pageCheckpoint(ctx, stepCtx, job, mu, entity.JobStepIssues, stepNames(steps), &job.Results.Issues), nil)
+
+				mu.Lock()
+				defer mu.Unlock()
+				return job.Checkpoint(entity.JobStepIssues).Items, err
+			},
+		})
+	}
+
+	// If we need to parse pull requests
+	if params.ParsePRs {
+		steps = append(steps, jobStep{
+			name:    entity.JobStepPullRequests,
+			failure: "failed to parse pull requests",
+			run: func(stepCtx context.Context) (int, error) {
+				_, err := s.parsePullRequests(stepCtx, params.OwnerName, params.RepoName, s.jobListing(job, entity.JobStepPullRequests),
+					params.SavePolicy, s.pageCheckpoint(ctx, stepCtx, job, mu, entity.JobStepPullRequests, stepNames(steps), &job.Results.PullRequests), nil)
+
+				mu.Lock()
+				defer mu.Unlock()
+				return job.Checkpoint(entity.JobStepPullRequests).Items, err
+			},
+		})
+	}
+
+	// If we need to parse repository contents
+	if params.ParseContents {
+		steps = append(steps, jobStep{
+			name:    entity.JobStepContents,
+			failure: "failed to parse contents",
+			run: func(ctx context.Context) (int, error) {
+				files, err := s.ParseContents(ctx, params.OwnerName, params.RepoName)
+				if err != nil {
+					return 0, err
+				}
+				mu.Lock()
+				job.Results.Files = len(files)
+				mu.Unlock()
+				return len(files), nil
+			},
+		})
+	}
+
+	// If we need to parse security alerts
+	if params.ParseSecurityAlerts {
+		steps = append(steps, jobStep{
+			name:    entity.JobStepSecurityAlerts,
+			failure: "failed to parse security alerts",
+			run: func(ctx context.Context) (int, error) {
+				alerts, err := s.ParseSecurityAlerts(ctx, params.OwnerName, params.RepoName)
+				if err != nil {
+					return 0, err
+				}
+				mu.Lock()
+				job.Results.SecurityAlerts = len(alerts)
+				mu.Unlock()
+				return len(alerts), nil
+			},
+		})
+	}
+
+	// If we need to parse users
+	if params.ParseUsers {
+		steps = append(steps, jobStep{
+			name:    entity.JobStepUsers,
+			failure: "failed to parse owner",
+			run: func(ctx context.Context) (int, error) {
+				// Here we just parse the repository owner
+				// In a real application, you might want to parse other contributors as well
+				if _, err := s.ParseUser(ctx, repo.OwnerLogin); err != nil {
+					return 0, err
+				}
+				mu.Lock()
+				job.Results.Users = 1
+				mu.Unlock()
+				return 1, nil
+			},
+		})
+	}
+
+	// The steps after the first take the repository it looked up instead of getting it again
+	for i := 1; i < len(steps); i++ {
+		run := steps[i].run
+		steps[i].run = func(ctx context.Context) (int, error) {
+			return run(withRepository(ctx, repo))
+		}
+	}
+
+	return steps
+}
+
+// listOptions returns the list options of a job's issues and pull requests
+func (s *ParserServiceImpl) listOptions(params domainService.ParsingJobParams) domainService.ListOptions {
+	opts := domainService.ListOptions{
+		PerPage: s.pageSize,
+		State:   params.State,
+		Since:   params.Since,
+		Until:   params.Until,
+	}
+	if params.PerPage > 0 {
+		opts.PerPage = params.PerPage
+	}
+	return opts
+}
+
+// jobListing returns the listing of a paginated step of a job, continuing from its checkpoint.
+// Checkpoints hold page numbers, which shift in a windowed list sorted by update time (see listSort):
+// a windowed job resumed or retried may skip items updated in between, the next run picks them up.
+func (s *ParserServiceImpl) jobListing(job *entity.ParsingJob, step string) listing {
+	checkpoint := job.Checkpoint(step)
+	return listing{
+		opts:    s.listOptions(job.Params),
+		page:    checkpoint.NextPage,
+		max:     job.Params.MaxItems,
+		fetched: checkpoint.Items,
+	}
+}
+
+// pageCheckpoint returns a callback saving the page cursor of a paginated step after every page;
+// the running item count is kept in result. The callback also records the counts of the step so far,
+// estimates its total from the last page GitHub reports and moves the job progress along.
+func (s *ParserServiceImpl) pageCheckpoint(ctx, stepCtx context.Context, job *entity.ParsingJob, mu *sync.Mutex, step string, steps []string, result *int) pageCheckpoint {
+	return func(page domainService.Page, fetched int) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		checkpoint := job.Checkpoint(step)
+		checkpoint.NextPage = page.Next
+		checkpoint.Items += fetched
+		checkpoint.UpdatedAt = time.Now()
+
+		job.SetCheckpoint(checkpoint)
+		*result = checkpoint.Items
+
+		if stepResult := job.StepResult(step); stepResult != nil {
+			stepResult.Items = checkpoint.Items
+			switch {
+			case page.Next == 0:
+				stepResult.Total = checkpoint.Items
+			case page.Last > 0:
+				stepResult.Total = checkpoint.Items + (page.Last-page.Next+1)*s.listOptions(job.Params).PerPage
+				if job.Params.MaxItems > 0 {
+					stepResult.Total = min(stepResult.Total, job.Params.MaxItems)
+				}
+			}
+			if stats, ok := stepCtx.Value(stepStatsKey{}).(*stepStats); ok {
+				stepResult.StepUsage = stats.snapshot()
+			}
+		}
+		s.updateProgress(job, steps)
+
+		job.UpdatedAt = checkpoint.UpdatedAt
+		s.saveJob(ctx, job)
+	}
+}
+
+// jobStopped records a cancel or pause request delivered through jobCtx; it reports whether the job was stopped.
+// A job that lost its lease is left to the replica that took it over.
+func (s *ParserServiceImpl) jobStopped(ctx, jobCtx context.Context, job *entity.ParsingJob) bool {
+	if !stopRequested(jobCtx) {
+		return false
+	}
+
+	cause := context.Cause(jobCtx)
+	if cause == errLeaseLost {
+		s.logger.Warn("Job %s stopped at %d%%: its lease was taken over", job.ID, job.Progress)
+		if s.metrics != nil {
+			s.metrics.ParsingJobs.WithLabelValues(entity.JobStatusInProgress).Dec()
+		}
+		return true
+	}
+
+	s.recordJobStop(ctx, job, cause, entity.JobStatusInProgress)
+	return true
+}
+
+// stopRequested reports whether jobCtx was cancelled to stop the job rather than by a failure
+func stopRequested(jobCtx context.Context) bool {
+	switch context.Cause(jobCtx) {
+	case errJobCancelled, errJobPaused, errLeaseLost:
+		return true
+	}
+	return false
+}
+
+// recordJobStop moves a job stopped in status from to cancelled or paused
+func (s *ParserServiceImpl) recordJobStop(ctx context.Context, job *entity.ParsingJob, cause error, from string) {
+	if cause == errJobPaused {
+		s.transitionJob(ctx, job, entity.JobStatusPaused, "paused by request")
+		s.moveJobMetric(from, entity.JobStatusPaused)
+	} else {
+		job.ErrorMessage = "cancelled by request"
+		s.transitionJob(ctx, job, entity.JobStatusCancelled, job.ErrorMessage)
+		s.moveJobMetric(from, entity.JobStatusCancelled)
+	}
+
+	s.logger.Info("Job %s %s at %d%%, completed steps: %v", job.ID, job.Status, job.Progress, job.CompletedSteps)
+}
+
+// transitionJob moves a job to status, records the transition and saves the job
+func (s *ParserServiceImpl) transitionJob(ctx context.Context, job *entity.ParsingJob, status, message string) {
+	now := time.Now()
+	job.Status = status
+	job.UpdatedAt = now
+	job.Transitions = append(job.Transitions, entity.JobTransition{Status: status, At: now, Message: message})
+
+	// Every run by a worker is an attempt
+	if status == entity.JobStatusInProgress {
+		job.StartAttempt(now)
+	} else {
+		job.FinishAttempt(status, message, now)
+	}
+	if status != entity.JobStatusRetrying {
+		job.NextAttemptAt = nil
+	}
+
+	switch {
+	case status == entity.JobStatusInProgress:
+		job.StartedAt = &now
+		job.FinishedAt = nil
+	case job.Finished():
+		job.FinishedAt = &now
+	default:
+		job.FinishedAt = nil
+	}
+
+	// Watchers read the saved job first, so the event goes out after the save;
+	// a job this replica lost is reported by the replica holding it
+	if errors.Is(s.saveJob(ctx, job), errLeaseLost) {
+		return
+	}
+	s.publishJobEvent(job, entity.JobEventStatus, message)
+
+	if job.Params.ParentID != "" && job.Finished() {
+		s.updateBatch(ctx, job.Params.ParentID)
+	}
+
+	if job.Params.CallbackURL != "" && job.Finished() {
+		s.sendCallback(ctx, job)
+	}
+}
+
+// startStep records and announces the start of a step
+func (s *ParserServiceImpl) startStep(ctx context.Context, job *entity.ParsingJob, step string) {
+	job.StartStep(step, time.Now())
+	job.UpdatedAt = time.Now()
+	s.saveJob(ctx, job)
+	s.publishStepEvent(job, entity.JobEventStepStarted, step)
+}
+
+// finishStep records and announces the outcome of a step with the counts of stats; the job is saved by the caller
+func (s *ParserServiceImpl) finishStep(job *entity.ParsingJob, step, status string, items int, stats *stepStats, err error) {
+	if result := job.StepResult(step); result != nil {
+		result.StepUsage = stats.snapshot()
+	}
+	job.FinishStep(step, status, items, err, time.Now())
+	s.publishStepEvent(job, entity.JobEventStepFinished, step)
+}
+
+// publishJobEvent sends the current status and progress of a job to its watchers
+func (s *ParserServiceImpl) publishJobEvent(job *entity.ParsingJob, eventType, message string) {
+	s.events.publish(entity.JobEvent{
+		Type:     eventType,
+		JobID:    job.ID,
+		Status:   job.Status,
+		Progress: job.Progress,
+		Message:  message,
+		At:       time.Now(),
+	})
+}
+
+func (s *ParserServiceImpl) publishStepEvent(job *entity.ParsingJob, eventType, step string) {
+	for _, result := range job.Steps {
+		if result.Step != step {
+			continue
+		}
+		s.events.publish(entity.JobEvent{
+			Type:     eventType,
+			JobID:    job.ID,
+			Status:   job.Status,
+			Progress: job.Progress,
+			Step:     &result,
+			At:       time.Now(),
+		})
+		return
+	}
+}
+
+// jobIDKey marks the context of a running job with its ID
+type jobIDKey struct{}
+
+// jobWarning tells the watchers of the job running in ctx about a problem that did not stop it
+func (s *ParserServiceImpl) jobWarning(ctx context.Context, format string, args ...interface{}) {
+	jobID, ok := ctx.Value(jobIDKey{}).(string)
+	if !ok {
+		return
+	}
+
+	s.events.publish(entity.JobEvent{
+		Type:    entity.JobEventWarning,
+		JobID:   jobID,
+		Message: fmt.Sprintf(format, args...),
+		At:      time.Now(),
+	})
+}
+
+// saveJob saves a job; a failed save is only logged so the job itself can go on.
+// A job another replica took over is not saved but dropped, and errLeaseLost is returned.
+func (s *ParserServiceImpl) saveJob(ctx context.Context, job *entity.ParsingJob) error {
+	err := s.jobRepo.Save(ctx, job)
+	switch {
+	case errors.Is(err, errLeaseLost):
+		s.logger.Warn("Job %s is held by another replica, dropping its changes", job.ID)
+		// Callers may hold s.activeMu
+		go s.dropJob(job.ID)
+	case err != nil:
+		s.logger.Error("Failed to save job %s: %v", job.ID, err)
+	}
+	return err
+}
+
+// failJob moves a running job to failed or dead_letter with the error of its current step
+func (s *ParserServiceImpl) failJob(ctx context.Context, job *entity.ParsingJob, status, message string, err error) {
+	job.ErrorMessage = fmt.Sprintf("%s: %v", message, err)
+	s.logger.Error("Job %s %s: %s", job.ID, status, job.ErrorMessage)
+	s.transitionJob(ctx, job, status, job.ErrorMessage)
+
+	// Update metrics
+	s.moveJobMetric(entity.JobStatusInProgress, status)
+	if s.metrics != nil {
+		s.metrics.ParsingJobsErrors.Inc()
+	}
+}
+
+// completeJob marks a running job completed
+func (s *ParserServiceImpl) completeJob(ctx context.Context, job *entity.ParsingJob) {
+	job.Progress = 100
+	s.transitionJob(ctx, job, entity.JobStatusCompleted, "")
+	s.moveJobMetric(entity.JobStatusInProgress, entity.JobStatusCompleted)
+
+	s.logger.Info("Job %s completed successfully", job.ID)
+}
+
+// moveJobMetric moves a job between the status labels of the ParsingJobs gauge
+func (s *ParserServiceImpl) moveJobMetric(from, to string) {
+	if s.metrics != nil {
+		s.metrics.ParsingJobs.WithLabelValues(from).Dec()
+		s.metrics.ParsingJobs.WithLabelValues(to).Inc()
+	}
+}
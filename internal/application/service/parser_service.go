@@ -2,7 +2,11 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
@@ -13,49 +17,114 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
-type JobInfo struct {
-	ID           string
-	Status       string // "pending", "in_progress", "completed", "failed"
-	Progress     int    // 0-100
-	ErrorMessage string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	Params       domainService.ParsingJobParams
-}
-
 type ParserServiceImpl struct {
 	githubService domainService.GithubService
 	repoRepo      repository.RepositoryRepository
 	issueRepo     repository.IssueRepository
 	prRepo        repository.PullRequestRepository
 	userRepo      repository.UserRepository
-	logger        *logger.Logger
-	metrics       *metrics.Metrics
-	mongoClient   *mongo.Client // Added for transaction support
-	// Map for storing active parsing jobs
-	jobs map[string]*JobInfo
+	ciCheckRepo   repository.CICheckRepository
+	fileRepo      repository.RepositoryFileRepository
+	codeOwnerRepo repository.CodeOwnerRepository
+	depRepo       repository.DependencyRepository
+	alertRepo     repository.SecurityAlertRepository
+	jobRepo       repository.JobRepository
+	jobQueue      *JobQueue
+	// stepParallelism bounds the steps of one job running at a time
+	stepParallelism int
+	// pageSize is the number of issues or PRs requested per page
+	pageSize int
+	// retryPolicy applies to jobs submitted without one
+	retryPolicy entity.RetryPolicy
+	// leases divides jobs between the replicas sharing jobRepo
+	leases JobLeaseConfig
+	// callbacks are delivered with callbackClient until callbackCtx is done, which happens when the workers stop
+	callbacks      CallbackConfig
+	callbackClient *http.Client
+	callbackCtx    context.Context
+	stopCallbacks  context.CancelFunc
+	// delivering holds the IDs of callback deliveries this replica is working on
+	deliveringMu sync.Mutex
+	delivering   map[string]struct{}
+	// submitMu serializes the idempotency and dedupe checks of submissions in this replica
+	submitMu sync.Mutex
+	// recoverMu keeps the startup recovery and the periodic takeover of jobs apart
+	recoverMu sync.Mutex
+	// active tracks queued and running jobs by ID so they can be cancelled or paused
+	activeMu sync.Mutex
+	active   map[string]*activeJob
+	// batchMu serializes updates of batch jobs by their children
+	batchMu sync.Mutex
+	// events delivers job changes to WatchParsingJob streams
+	events      *jobEvents
+	logger      *logger.Logger
+	metrics     *metrics.Metrics
+	mongoClient *mongo.Client // Added for transaction support
 }
 
+// defaultPageSize is the largest page the GitHub API serves
+const defaultPageSize = domainService.MaxPerPage
+
+// defaultStepParallelism is the number of steps a job runs at a time when not configured
+const defaultStepParallelism = 3
+
+// defaultJobTimeout bounds one attempt of a job that sets no timeout
+const defaultJobTimeout = 10 * time.Minute
+
 func NewParserService(
 	githubService domainService.GithubService,
 	repoRepo repository.RepositoryRepository,
 	issueRepo repository.IssueRepository,
 	prRepo repository.PullRequestRepository,
 	userRepo repository.UserRepository,
+	ciCheckRepo repository.CICheckRepository,
+	fileRepo repository.RepositoryFileRepository,
+	codeOwnerRepo repository.CodeOwnerRepository,
+	depRepo repository.DependencyRepository,
+	alertRepo repository.SecurityAlertRepository,
+	jobRepo repository.JobRepository,
+	jobQueue *JobQueue,
+	stepParallelism int,
+	retryPolicy entity.RetryPolicy,
+	leases JobLeaseConfig,
+	callbacks CallbackConfig,
 	mongoClient *mongo.Client,
 	metrics *metrics.Metrics,
 	logger *logger.Logger,
 ) *ParserServiceImpl {
+	callbacks = callbacks.withDefaults()
+	if stepParallelism <= 0 {
+		stepParallelism = defaultStepParallelism
+	}
+	callbackCtx, stopCallbacks := context.WithCancel(context.Background())
+
 	return &ParserServiceImpl{
-		githubService: githubService,
-		repoRepo:      repoRepo,
-		issueRepo:     issueRepo,
-		prRepo:        prRepo,
-		userRepo:      userRepo,
-		mongoClient:   mongoClient,
-		metrics:       metrics,
-		logger:        logger,
-		jobs:          make(map[string]*JobInfo),
+		githubService:   githubService,
+		repoRepo:        repoRepo,
+		issueRepo:       issueRepo,
+		prRepo:          prRepo,
+		userRepo:        userRepo,
+		ciCheckRepo:     ciCheckRepo,
+		fileRepo:        fileRepo,
+		codeOwnerRepo:   codeOwnerRepo,
+		depRepo:         depRepo,
+		alertRepo:       alertRepo,
+		jobRepo:         jobRepo,
+		jobQueue:        jobQueue,
+		stepParallelism: stepParallelism,
+		pageSize:        defaultPageSize,
+		retryPolicy:     retryPolicy,
+		leases:          leases.withDefaults(),
+		callbacks:       callbacks,
+		callbackClient:  &http.Client{Timeout: callbacks.Timeout},
+		callbackCtx:     callbackCtx,
+		stopCallbacks:   stopCallbacks,
+		delivering:      make(map[string]struct{}),
+		active:          make(map[string]*activeJob),
+		events:          newJobEvents(),
+		mongoClient:     mongoClient,
+		metrics:         metrics,
+		logger:          logger,
 	}
 }
 
@@ -63,13 +132,45 @@ func (s *ParserServiceImpl) ParseRepository(ctx context.Context, owner, name str
 	repo, err := s.githubService.GetRepository(ctx, owner, name)
 	if err != nil {
 		s.logger.Error("Failed to get repository from GitHub API: %v", err)
+
+		var gone *domainService.RepositoryGoneError
+		if errors.As(err, &gone) {
+			s.tombstoneRepository(ctx, owner, name, gone.Reason)
+		}
+
+		return nil, err
+	}
+
+	// Match the stored record by stable ID to detect renames and transfers
+	stored, err := s.repoRepo.FindByID(ctx, repo.ID)
+	if err != nil {
+		s.logger.Error("Error finding stored repository: %v", err)
 		return nil, err
 	}
 
+	if stored != nil {
+		repo.Renames = stored.Renames
+	}
+
+	previousFullName := owner + "/" + name
+	if stored != nil && stored.FullName != "" {
+		previousFullName = stored.FullName
+	}
+
+	if !strings.EqualFold(previousFullName, repo.FullName) {
+		s.logger.Info("Repository %s was renamed or transferred to %s", previousFullName, repo.FullName)
+		repo.Renames = append(repo.Renames, entity.RepositoryRename{
+			FromFullName: previousFullName,
+			ToFullName:   repo.FullName,
+			DetectedAt:   time.Now(),
+		})
+	}
+
 	if err := s.repoRepo.Save(ctx, repo); err != nil {
 		s.logger.Error("Error saving repository: %v", err)
 		return nil, err
 	}
+	itemsSaved(ctx, 1)
 
 	// Increment metrics after successful parsing and saving
 	if s.metrics != nil {
@@ -80,74 +181,261 @@ func (s *ParserServiceImpl) ParseRepository(ctx context.Context, owner, name str
 	return repo, nil
 }
 
-func (s *ParserServiceImpl) ParseIssues(ctx context.Context, owner, repo string) ([]*entity.Issue, error) {
+// tombstoneRepository marks a stored repository as deleted after GitHub reported it gone
+func (s *ParserServiceImpl) tombstoneRepository(ctx context.Context, owner, name, reason string) {
+	stored, err := s.repoRepo.FindByOwnerAndName(ctx, owner, name)
+	if err != nil || stored == nil || stored.DeletedAt != nil {
+		return
+	}
+
+	s.logger.Warn("Repository %s/%s is gone (%s), marking as deleted", owner, name, reason)
+	if err := s.repoRepo.MarkDeleted(ctx, stored.ID, time.Now(), reason); err != nil {
+		s.logger.Error("Error marking repository %d as deleted: %v", stored.ID, err)
+	}
+}
+
+// jobRepositoryKey carries the repository looked up by the first step of a job to the steps after it
+type jobRepositoryKey struct{}
+
+// withRepository returns a context in which parse functions take repo instead of getting it from GitHub again
+func withRepository(ctx context.Context, repo *entity.Repository) context.Context {
+	return context.WithValue(ctx, jobRepositoryKey{}, repo)
+}
+
+// lookupRepository returns the repository set by withRepository, or gets it from GitHub
+func (s *ParserServiceImpl) lookupRepository(ctx context.Context, owner, name string) (*entity.Repository, error) {
+	if repo, ok := ctx.Value(jobRepositoryKey{}).(*entity.Repository); ok && repo != nil {
+		return repo, nil
+	}
+	return s.githubService.GetRepository(ctx, owner, name)
+}
+
+// ParseIssues saves the issues of a repository page by page as GitHub returns them and reports what it stored.
+// Every page goes to onPage with its result when it is set; no page is kept after that.
+func (s *ParserServiceImpl) ParseIssues(ctx context.Context, owner, repo, savePolicy string, onPage func([]*entity.Issue, entity.SaveResult) error) (*entity.SaveResult, error) {
+	if err := domainService.ValidateSavePolicy(savePolicy); err != nil {
+		return nil, err
+	}
+	return s.parseIssues(ctx, owner, repo, s.fullListing(), savePolicy, nil, onPage)
+}
+
+// parseIssues fetches and saves issues from the page of the listing up to the last page or the cap
+// and returns what it stored. An error of onPage stops the listing, and so does an issue that cannot be stored
+// with the fail-fast save policy.
+func (s *ParserServiceImpl) parseIssues(ctx context.Context, owner, repo string, list listing, savePolicy string, checkpoint pageCheckpoint, onPage func([]*entity.Issue, entity.SaveResult) error) (*entity.SaveResult, error) {
+	result := &entity.SaveResult{}
+
 	// Get repository to ensure it exists and we have its ID
-	repository, err := s.githubService.GetRepository(ctx, owner, repo)
+	repository, err := s.lookupRepository(ctx, owner, repo)
 	if err != nil {
 		s.logger.Error("Failed to get repository for issues parsing: %v", err)
-		return nil, err
+		return result, err
 	}
 
-	// Get issues from GitHub API (first page, 100 issues)
-	issues, err := s.githubService.GetIssues(ctx, owner, repo, 1, 100)
-	if err != nil {
-		s.logger.Error("Failed to get issues from GitHub API: %v", err)
-		return nil, err
+	fetch := func(page int) ([]*entity.Issue, domainService.Page, error) {
+		return s.githubService.GetIssues(ctx, owner, repo, page, list.opts)
 	}
 
-	// Save each issue to the database
-	for _, issue := range issues {
-		// Make sure the issue is linked to the correct repository
-		issue.RepositoryID = repository.ID
+	for page, err := range streamPages(&list, fetch) {
+		if err != nil {
+			s.logger.Error("Failed to get issues page %d from GitHub API: %v", page.number, err)
+			return result, err
+		}
+
+		// Save each issue to the database
+		var saved entity.SaveResult
+		var stop error
+		for _, issue := range page.items {
+			// Stop early when the caller gave up, e.g. a cancelled job
+			if stop = ctx.Err(); stop != nil {
+				break
+			}
+
+			// Make sure the issue is linked to the correct repository
+			issue.RepositoryID = repository.ID
 
-		if err := s.issueRepo.Save(ctx, issue); err != nil {
-			s.logger.Error("Error saving issue #%d: %v", issue.Number, err)
-			// Continue even if there's an error saving one issue
+			outcome, err := s.issueRepo.Save(ctx, issue)
+			if err != nil {
+				// Continue even if there's an error saving one issue, unless the caller wants to fail fast
+				if stop = s.saveFailed(ctx, &saved, savePolicy, "issue", issue.ID, issue.Number, err); stop != nil {
+					break
+				}
+				continue
+			}
+			saved.Count(outcome)
 		}
-	}
+		result.Add(saved)
+		pageSaved(ctx, saved)
 
-	// Increment metrics
-	if s.metrics != nil {
-		s.metrics.ParsedIssues.Add(float64(len(issues)))
-		s.metrics.DBOperations.WithLabelValues("save", "issue").Add(float64(len(issues)))
+		// Increment metrics
+		if s.metrics != nil {
+			s.metrics.ParsedIssues.Add(float64(saved.Fetched()))
+			s.metrics.DBOperations.WithLabelValues("save", "issue").Add(float64(saved.Fetched()))
+		}
+
+		if stop != nil {
+			return result, stop
+		}
+		if checkpoint != nil {
+			checkpoint(page.next, len(page.items))
+		}
+		if onPage != nil {
+			if err := onPage(page.items, saved); err != nil {
+				return result, err
+			}
+		}
 	}
 
-	return issues, nil
+	return result, nil
 }
 
-func (s *ParserServiceImpl) ParsePullRequests(ctx context.Context, owner, repo string) ([]*entity.PullRequest, error) {
+// ParsePullRequests saves the pull requests of a repository with their CI checks page by page, like ParseIssues
+func (s *ParserServiceImpl) ParsePullRequests(ctx context.Context, owner, repo, savePolicy string, onPage func([]*entity.PullRequest, entity.SaveResult) error) (*entity.SaveResult, error) {
+	if err := domainService.ValidateSavePolicy(savePolicy); err != nil {
+		return nil, err
+	}
+	return s.parsePullRequests(ctx, owner, repo, s.fullListing(), savePolicy, nil, onPage)
+}
+
+// parsePullRequests fetches and saves pull requests with their CI checks from the page of the listing
+// up to the last page or the cap and returns what it stored, stopping like parseIssues.
+func (s *ParserServiceImpl) parsePullRequests(ctx context.Context, owner, repo string, list listing, savePolicy string, checkpoint pageCheckpoint, onPage func([]*entity.PullRequest, entity.SaveResult) error) (*entity.SaveResult, error) {
+	result := &entity.SaveResult{}
+
 	// Get repository to ensure it exists and we have its ID
-	repository, err := s.githubService.GetRepository(ctx, owner, repo)
+	repository, err := s.lookupRepository(ctx, owner, repo)
 	if err != nil {
 		s.logger.Error("Failed to get repository for PR parsing: %v", err)
-		return nil, err
+		return result, err
+	}
+
+	fetch := func(page int) ([]*entity.PullRequest, domainService.Page, error) {
+		return s.githubService.GetPullRequests(ctx, owner, repo, page, list.opts)
+	}
+
+	for page, err := range streamPages(&list, fetch) {
+		if err != nil {
+			s.logger.Error("Failed to get pull requests page %d from GitHub API: %v", page.number, err)
+			return result, err
+		}
+
+		// Save each PR to the database
+		var saved entity.SaveResult
+		var stop error
+		for _, pr := range page.items {
+			// Stop early when the caller gave up, e.g. a cancelled job
+			if stop = ctx.Err(); stop != nil {
+				break
+			}
+
+			// Make sure the PR is linked to the correct repository
+			pr.RepositoryID = repository.ID
+
+			// Attach the CI summary of the head commit
+			if pr.HeadSHA != "" {
+				pr.CI = s.parseCIChecks(ctx, owner, repo, pr)
+			}
+
+			outcome, err := s.prRepo.Save(ctx, pr)
+			if err != nil {
+				// Continue even if there's an error saving one PR, unless the caller wants to fail fast
+				if stop = s.saveFailed(ctx, &saved, savePolicy, "PR", pr.ID, pr.Number, err); stop != nil {
+					break
+				}
+				continue
+			}
+			saved.Count(outcome)
+		}
+		result.Add(saved)
+		pageSaved(ctx, saved)
+
+		// Increment metrics
+		if s.metrics != nil {
+			s.metrics.ParsedPullRequests.Add(float64(saved.Fetched()))
+			s.metrics.DBOperations.WithLabelValues("save", "pull_request").Add(float64(saved.Fetched()))
+		}
+
+		if stop != nil {
+			return result, stop
+		}
+		if checkpoint != nil {
+			checkpoint(page.next, len(page.items))
+		}
+		if onPage != nil {
+			if err := onPage(page.items, saved); err != nil {
+				return result, err
+			}
+		}
 	}
 
-	// Get PRs from GitHub API (first page, 100 PRs)
-	prs, err := s.githubService.GetPullRequests(ctx, owner, repo, 1, 100)
+	return result, nil
+}
+
+// saveFailed records an issue or pull request that could not be stored in the page result and the running job step.
+// With the fail-fast save policy it returns the error that stops the parse.
+func (s *ParserServiceImpl) saveFailed(ctx context.Context, saved *entity.SaveResult, savePolicy, kind string, id int64, number int, err error) error {
+	s.logger.Error("Error saving %s #%d: %v", kind, number, err)
+	s.itemFailed(ctx, "failed to save %s #%d: %v", kind, number, err)
+
+	failure := entity.ItemFailure{ID: id, Number: number, Reason: err.Error()}
+	saved.Fail(failure)
+	if savePolicy == entity.SavePolicyFailFast {
+		return &domainService.ItemSaveError{Kind: kind, Failure: failure, Err: err}
+	}
+	return nil
+}
+
+// parseCIChecks fetches and stores the CI checks of a PR head commit and returns their summary
+func (s *ParserServiceImpl) parseCIChecks(ctx context.Context, owner, repo string, pr *entity.PullRequest) entity.CISummary {
+	checks, err := s.githubService.GetCIChecks(ctx, owner, repo, pr.HeadSHA)
 	if err != nil {
-		s.logger.Error("Failed to get pull requests from GitHub API: %v", err)
-		return nil, err
+		// CI data is optional: the token may lack access to statuses or checks
+		s.logger.Warn("Failed to get CI checks for PR #%d: %v", pr.Number, err)
+		s.jobWarning(ctx, "failed to get CI checks for PR #%d: %v", pr.Number, err)
+		return pr.CI
 	}
 
-	// Save each PR to the database
-	for _, pr := range prs {
-		// Make sure the PR is linked to the correct repository
-		pr.RepositoryID = repository.ID
+	for _, check := range checks {
+		check.PullRequestID = pr.ID
+		check.RepositoryID = pr.RepositoryID
 
-		if err := s.prRepo.Save(ctx, pr); err != nil {
-			s.logger.Error("Error saving PR #%d: %v", pr.Number, err)
-			// Continue even if there's an error saving one PR
+		if err := s.ciCheckRepo.Save(ctx, check); err != nil {
+			s.logger.Error("Error saving CI check %s for PR #%d: %v", check.Name, pr.Number, err)
+			s.jobWarning(ctx, "failed to save CI check %s for PR #%d: %v", check.Name, pr.Number, err)
 		}
 	}
 
-	// Increment metrics
 	if s.metrics != nil {
-		s.metrics.ParsedPullRequests.Add(float64(len(prs)))
-		s.metrics.DBOperations.WithLabelValues("save", "pull_request").Add(float64(len(prs)))
+		s.metrics.DBOperations.WithLabelValues("save", "ci_check").Add(float64(len(checks)))
+	}
+
+	return summarizeCIChecks(checks)
+}
+
+// summarizeCIChecks counts checks per state; any failure fails the summary, otherwise any pending check keeps it pending
+func summarizeCIChecks(checks []*entity.CICheck) entity.CISummary {
+	var summary entity.CISummary
+	for _, check := range checks {
+		switch check.State {
+		case entity.CIStatePassed:
+			summary.Passed++
+		case entity.CIStateFailed:
+			summary.Failed++
+		default:
+			summary.Pending++
+		}
 	}
 
-	return prs, nil
+	switch {
+	case summary.Failed > 0:
+		summary.State = entity.CIStateFailed
+	case summary.Pending > 0:
+		summary.State = entity.CIStatePending
+	case summary.Passed > 0:
+		summary.State = entity.CIStatePassed
+	}
+
+	return summary
 }
 
 func (s *ParserServiceImpl) ParseUser(ctx context.Context, username string) (*entity.User, error) {
@@ -163,6 +451,7 @@ func (s *ParserServiceImpl) ParseUser(ctx context.Context, username string) (*en
 		s.logger.Error("Error saving user %s: %v", username, err)
 		return nil, err
 	}
+	itemsSaved(ctx, 1)
 
 	// Increment metrics
 	if s.metrics != nil {
@@ -173,173 +462,288 @@ func (s *ParserServiceImpl) ParseUser(ctx context.Context, username string) (*en
 	return user, nil
 }
 
-func (s *ParserServiceImpl) StartParsingJob(ctx context.Context, params domainService.ParsingJobParams) (string, error) {
-	// Generate a unique job ID
-	jobID := fmt.Sprintf("job-%d", time.Now().Unix())
+// ParseContents fetches README, CODEOWNERS and dependency manifests of a repository
+// and parses them into structured records. Files whose blob SHA did not change are skipped;
+// the returned slice holds the files that were stored in this run.
+func (s *ParserServiceImpl) ParseContents(ctx context.Context, owner, repo string) ([]*entity.RepositoryFile, error) {
+	repository, err := s.lookupRepository(ctx, owner, repo)
+	if err != nil {
+		s.logger.Error("Failed to get repository for contents parsing: %v", err)
+		return nil, err
+	}
+
+	var changed []*entity.RepositoryFile
 
-	// Create job info
-	job := &JobInfo{
-		ID:        jobID,
-		Status:    "pending",
-		Progress:  0,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-		Params:    params,
+	readme, err := s.githubService.GetReadme(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	if readme != nil {
+		stored, err := s.storeFile(ctx, repository.ID, readme, nil)
+		if err != nil {
+			return nil, err
+		}
+		if stored {
+			changed = append(changed, readme)
+		}
 	}
 
-	// Save the job
-	s.jobs[jobID] = job
+	// The first CODEOWNERS location that exists wins, as on GitHub
+	var codeOwners *entity.RepositoryFile
+	for _, path := range codeOwnersPaths {
+		file, err := s.githubService.GetFileContent(ctx, owner, repo, path)
+		if err != nil {
+			return nil, err
+		}
+		if file != nil {
+			codeOwners = file
+			break
+		}
+	}
+	if codeOwners == nil {
+		// Drop the rules of a CODEOWNERS file removed upstream
+		if err := s.codeOwnerRepo.ReplaceForRepository(ctx, repository.ID, nil); err != nil {
+			return nil, err
+		}
+		if err := s.forgetFiles(ctx, repository.ID, codeOwnersPaths...); err != nil {
+			return nil, err
+		}
+	} else {
+		stored, err := s.storeFile(ctx, repository.ID, codeOwners, func(file *entity.RepositoryFile) error {
+			rules := parseCodeOwners(file.Content)
+			for _, rule := range rules {
+				rule.RepositoryID = repository.ID
+				rule.Path = file.Path
+			}
+			return s.codeOwnerRepo.ReplaceForRepository(ctx, repository.ID, rules)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if stored {
+			changed = append(changed, codeOwners)
+		}
+	}
 
-	// Start the job in a separate goroutine
-	go s.processParsingJob(context.Background(), jobID)
+	for _, path := range manifestPaths {
+		file, err := s.githubService.GetFileContent(ctx, owner, repo, path)
+		if err != nil {
+			return nil, err
+		}
+		if file == nil {
+			// Drop dependencies of a manifest removed upstream
+			if err := s.depRepo.ReplaceForManifest(ctx, repository.ID, path, nil); err != nil {
+				return nil, err
+			}
+			if err := s.forgetFiles(ctx, repository.ID, path); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		stored, err := s.storeFile(ctx, repository.ID, file, func(file *entity.RepositoryFile) error {
+			deps := manifestParsers[path](file.Content)
+			for _, dep := range deps {
+				dep.RepositoryID = repository.ID
+				dep.RepositoryFullName = repository.FullName
+				dep.Manifest = path
+			}
+			return s.depRepo.ReplaceForManifest(ctx, repository.ID, path, deps)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if stored {
+			changed = append(changed, file)
+		}
+	}
 
-	// Increment job metrics
 	if s.metrics != nil {
-		s.metrics.ParsingJobs.WithLabelValues("pending").Inc()
-		s.metrics.ParsingJobsTotal.Inc()
+		s.metrics.DBOperations.WithLabelValues("save", "repository_file").Add(float64(len(changed)))
 	}
 
-	return jobID, nil
+	return changed, nil
 }
 
-func (s *ParserServiceImpl) GetParsingJobStatus(ctx context.Context, jobID string) (*domainService.ParsingJobStatus, error) {
-	job, exists := s.jobs[jobID]
-	if !exists {
-		return nil, fmt.Errorf("job not found: %s", jobID)
+// storeFile saves a fetched file unless the stored copy has the same blob SHA; it reports whether the file was saved.
+// A changed file goes to store first, which saves what is parsed from it: the file and its SHA are only saved
+// after that succeeded, so a failed run parses the file again next time.
+func (s *ParserServiceImpl) storeFile(ctx context.Context, repoID int64, file *entity.RepositoryFile, store func(file *entity.RepositoryFile) error) (bool, error) {
+	file.RepositoryID = repoID
+
+	existing, err := s.fileRepo.FindByPath(ctx, repoID, file.Path)
+	if err != nil {
+		return false, err
+	}
+	if existing != nil && existing.SHA == file.SHA {
+		s.logger.Debug("File %s is unchanged, skipping", file.Path)
+		return false, nil
 	}
 
-	return &domainService.ParsingJobStatus{
-		ID:           job.ID,
-		Status:       job.Status,
-		Progress:     job.Progress,
-		ErrorMessage: job.ErrorMessage,
-		CreatedAt:    job.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:    job.UpdatedAt.Format(time.RFC3339),
-	}, nil
-}
+	if store != nil {
+		if err := store(file); err != nil {
+			s.logger.Error("Error storing the contents of file %s: %v", file.Path, err)
+			return false, err
+		}
+	}
 
-func (s *ParserServiceImpl) processParsingJob(ctx context.Context, jobID string) {
-	job, exists := s.jobs[jobID]
-	if !exists {
-		s.logger.Error("Job not found: %s", jobID)
-		return
+	if err := s.fileRepo.Save(ctx, file); err != nil {
+		s.logger.Error("Error saving file %s: %v", file.Path, err)
+		return false, err
 	}
+	itemsSaved(ctx, 1)
 
-	// Update job status
-	job.Status = "in_progress"
-	job.UpdatedAt = time.Now()
+	return true, nil
+}
 
-	// Update metrics
-	if s.metrics != nil {
-		s.metrics.ParsingJobs.WithLabelValues("pending").Dec()
-		s.metrics.ParsingJobs.WithLabelValues("in_progress").Inc()
+// forgetFiles deletes stored files removed upstream, so they are parsed again when they come back unchanged
+func (s *ParserServiceImpl) forgetFiles(ctx context.Context, repoID int64, paths ...string) error {
+	for _, path := range paths {
+		if err := s.fileRepo.Delete(ctx, repoID, path); err != nil {
+			s.logger.Error("Error deleting file %s: %v", path, err)
+			return err
+		}
 	}
+	return nil
+}
 
-	// Create a context with timeout
-	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
-	defer cancel()
+// ParseSecurityAlerts stores Dependabot alerts and repository security advisories of a repository.
+// Sources the token has no access to are skipped.
+func (s *ParserServiceImpl) ParseSecurityAlerts(ctx context.Context, owner, repo string) ([]*entity.SecurityAlert, error) {
+	repository, err := s.lookupRepository(ctx, owner, repo)
+	if err != nil {
+		s.logger.Error("Failed to get repository for security alerts parsing: %v", err)
+		return nil, err
+	}
 
-	// Start with parsing the repository
-	repo, err := s.ParseRepository(timeoutCtx, job.Params.OwnerName, job.Params.RepoName)
+	dependabotAlerts, err := s.githubService.GetDependabotAlerts(ctx, owner, repo)
 	if err != nil {
-		job.Status = "failed"
-		job.ErrorMessage = fmt.Sprintf("failed to parse repository: %v", err)
-		job.UpdatedAt = time.Now()
-		s.logger.Error("Job %s failed: %v", jobID, err)
+		s.logger.Error("Failed to get Dependabot alerts from GitHub API: %v", err)
+		return nil, err
+	}
 
-		// Update metrics
-		if s.metrics != nil {
-			s.metrics.ParsingJobs.WithLabelValues("in_progress").Dec()
-			s.metrics.ParsingJobs.WithLabelValues("failed").Inc()
-			s.metrics.ParsingJobsErrors.Inc()
+	advisories, err := s.githubService.GetSecurityAdvisories(ctx, owner, repo)
+	if err != nil {
+		s.logger.Error("Failed to get security advisories from GitHub API: %v", err)
+		return nil, err
+	}
+
+	alerts := append(dependabotAlerts, advisories...)
+	for _, alert := range alerts {
+		// Stop early when the caller gave up, e.g. a cancelled job
+		if err := ctx.Err(); err != nil {
+			return nil, err
 		}
 
-		return
+		alert.RepositoryID = repository.ID
+		alert.RepositoryFullName = repository.FullName
+
+		if err := s.alertRepo.Save(ctx, alert); err != nil {
+			s.logger.Error("Error saving security alert %s for %s: %v", alert.GHSAID, alert.Package, err)
+			s.itemFailed(ctx, "failed to save security alert %s for %s: %v", alert.GHSAID, alert.Package, err)
+			// Continue even if there's an error saving one alert
+			continue
+		}
+		itemsSaved(ctx, 1)
 	}
 
-	job.Progress = 20
-	job.UpdatedAt = time.Now()
+	// Increment metrics
+	if s.metrics != nil {
+		s.metrics.DBOperations.WithLabelValues("save", "security_alert").Add(float64(len(alerts)))
+	}
 
-	// If we need to parse issues
-	if job.Params.ParseIssues {
-		_, err := s.ParseIssues(timeoutCtx, job.Params.OwnerName, job.Params.RepoName)
-		if err != nil {
-			job.Status = "failed"
-			job.ErrorMessage = fmt.Sprintf("failed to parse issues: %v", err)
-			job.UpdatedAt = time.Now()
-			s.logger.Error("Job %s failed at issues parsing: %v", jobID, err)
+	return alerts, nil
+}
 
-			// Update metrics
-			if s.metrics != nil {
-				s.metrics.ParsingJobs.WithLabelValues("in_progress").Dec()
-				s.metrics.ParsingJobs.WithLabelValues("failed").Inc()
-				s.metrics.ParsingJobsErrors.Inc()
-			}
+// ReconcileRepository compares stored issues and PRs of a repository against GitHub
+// and marks the ones that vanished upstream as deleted or transferred
+func (s *ParserServiceImpl) ReconcileRepository(ctx context.Context, owner, repo string) (*entity.ReconciliationResult, error) {
+	repoInfo, err := s.githubService.GetRepository(ctx, owner, repo)
+	if err != nil {
+		s.logger.Error("Failed to get repository for reconciliation: %v", err)
+		return nil, err
+	}
 
-			return
-		}
+	result := &entity.ReconciliationResult{}
+	now := time.Now()
+
+	// Issues
+	issueNumbers, err := s.githubService.ListIssueNumbers(ctx, owner, repo)
+	if err != nil {
+		s.logger.Error("Failed to list issue numbers from GitHub API: %v", err)
+		return nil, err
+	}
 
-		job.Progress = 50
-		job.UpdatedAt = time.Now()
+	storedIssues, err := s.issueRepo.List(ctx, repository.IssueFilter{RepositoryID: repoInfo.ID})
+	if err != nil {
+		return nil, err
 	}
 
-	// If we need to parse pull requests
-	if job.Params.ParsePRs {
-		_, err := s.ParsePullRequests(timeoutCtx, job.Params.OwnerName, job.Params.RepoName)
+	upstreamIssues := numberSet(issueNumbers)
+	for _, issue := range storedIssues {
+		result.IssuesChecked++
+		if upstreamIssues[issue.Number] {
+			continue
+		}
+
+		location, err := s.githubService.GetIssueLocation(ctx, owner, repo, issue.Number)
 		if err != nil {
-			job.Status = "failed"
-			job.ErrorMessage = fmt.Sprintf("failed to parse pull requests: %v", err)
-			job.UpdatedAt = time.Now()
-			s.logger.Error("Job %s failed at PRs parsing: %v", jobID, err)
+			return nil, err
+		}
 
-			// Update metrics
-			if s.metrics != nil {
-				s.metrics.ParsingJobs.WithLabelValues("in_progress").Dec()
-				s.metrics.ParsingJobs.WithLabelValues("failed").Inc()
-				s.metrics.ParsingJobsErrors.Inc()
+		item := entity.ReconciledItem{Kind: "issue", ID: issue.ID, Number: issue.Number}
+		switch {
+		case location == "":
+			if err := s.issueRepo.MarkDeleted(ctx, issue.ID, now); err != nil {
+				return nil, err
 			}
-
-			return
+			result.Deleted = append(result.Deleted, item)
+		case !strings.EqualFold(location, repoInfo.FullName):
+			item.TransferredTo = location
+			if err := s.issueRepo.MarkTransferred(ctx, issue.ID, location, now); err != nil {
+				return nil, err
+			}
+			result.Transferred = append(result.Transferred, item)
 		}
+	}
 
-		job.Progress = 80
-		job.UpdatedAt = time.Now()
+	// Pull requests cannot be transferred, so a missing PR is deleted
+	prNumbers, err := s.githubService.ListPullRequestNumbers(ctx, owner, repo)
+	if err != nil {
+		s.logger.Error("Failed to list pull request numbers from GitHub API: %v", err)
+		return nil, err
 	}
 
-	// If we need to parse users
-	if job.Params.ParseUsers {
-		// Here we just parse the repository owner
-		// In a real application, you might want to parse other contributors as well
-		_, err := s.ParseUser(timeoutCtx, repo.OwnerLogin)
-		if err != nil {
-			job.Status = "failed"
-			job.ErrorMessage = fmt.Sprintf("failed to parse owner: %v", err)
-			job.UpdatedAt = time.Now()
-			s.logger.Error("Job %s failed at owner parsing: %v", jobID, err)
+	storedPRs, err := s.prRepo.List(ctx, repository.PullRequestFilter{RepositoryID: repoInfo.ID})
+	if err != nil {
+		return nil, err
+	}
 
-			// Update metrics
-			if s.metrics != nil {
-				s.metrics.ParsingJobs.WithLabelValues("in_progress").Dec()
-				s.metrics.ParsingJobs.WithLabelValues("failed").Inc()
-				s.metrics.ParsingJobsErrors.Inc()
-			}
+	upstreamPRs := numberSet(prNumbers)
+	for _, pr := range storedPRs {
+		result.PullRequestsChecked++
+		if upstreamPRs[pr.Number] {
+			continue
+		}
 
-			return
+		if err := s.prRepo.MarkDeleted(ctx, pr.ID, now); err != nil {
+			return nil, err
 		}
+		result.Deleted = append(result.Deleted, entity.ReconciledItem{Kind: "pull_request", ID: pr.ID, Number: pr.Number})
 	}
 
-	// Job completed successfully
-	job.Status = "completed"
-	job.Progress = 100
-	job.UpdatedAt = time.Now()
+	s.logger.Info("Reconciled %s/%s: %d deleted, %d transferred", owner, repo, len(result.Deleted), len(result.Transferred))
 
-	// Update metrics
-	if s.metrics != nil {
-		s.metrics.ParsingJobs.WithLabelValues("in_progress").Dec()
-		s.metrics.ParsingJobs.WithLabelValues("completed").Inc()
-	}
+	return result, nil
+}
 
-	s.logger.Info("Job %s completed successfully", jobID)
+// numberSet builds a lookup set of issue or PR numbers
+func numberSet(numbers []int) map[int]bool {
+	set := make(map[int]bool, len(numbers))
+	for _, number := range numbers {
+		set[number] = true
+	}
+	return set
 }
 
 func (s *ParserServiceImpl) ParseRepositoryWithDetails(ctx context.Context, owner, name string, parseIssues, parsePRs bool) (*entity.Repository, error) {
@@ -374,14 +778,14 @@ func (s *ParserServiceImpl) ParseRepositoryWithDetails(ctx context.Context, owne
 
 		// If we need to parse issues
 		if parseIssues {
-			issues, err := s.githubService.GetIssues(sessCtx, owner, name, 1, 100)
+			issues, _, err := s.githubService.GetIssues(sessCtx, owner, name, 1, domainService.ListOptions{PerPage: 100})
 			if err != nil {
 				return err
 			}
 
 			for _, issue := range issues {
 				issue.RepositoryID = repo.ID
-				if err := s.issueRepo.Save(sessCtx, issue); err != nil {
+				if _, err := s.issueRepo.Save(sessCtx, issue); err != nil {
 					return err
 				}
 			}
@@ -394,14 +798,14 @@ func (s *ParserServiceImpl) ParseRepositoryWithDetails(ctx context.Context, owne
 
 		// Similarly for PRs
 		if parsePRs {
-			prs, err := s.githubService.GetPullRequests(sessCtx, owner, name, 1, 100)
+			prs, _, err := s.githubService.GetPullRequests(sessCtx, owner, name, 1, domainService.ListOptions{PerPage: 100})
 			if err != nil {
 				return err
 			}
 
 			for _, pr := range prs {
 				pr.RepositoryID = repo.ID
-				if err := s.prRepo.Save(sessCtx, pr); err != nil {
+				if _, err := s.prRepo.Save(sessCtx, pr); err != nil {
 					return err
 				}
 			}
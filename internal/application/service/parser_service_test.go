@@ -0,0 +1,451 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	githubClient "github.com/Dhoini/GitHub_Parser/internal/infrastructure/github"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/fakegithub"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/persistence/memory"
+)
+
+// newTestParserService creates a parser service backed by in-memory repositories and a replayed cassette
+func newTestParserService(t *testing.T, cassetteName string) *ParserServiceImpl {
+	t.Helper()
+	return newParserServiceWith(t, newTestGithubService(t, cassetteName))
+}
+
+// newFakeParserService creates a parser service backed by in-memory repositories and the fake GitHub API
+func newFakeParserService(t *testing.T, fake *fakegithub.Server) *ParserServiceImpl {
+	t.Helper()
+
+	api := httptest.NewServer(fake)
+	t.Cleanup(api.Close)
+
+	client := githubClient.NewGithubClient("test-token", nil, testLogger(), githubClient.WithBaseURL(api.URL))
+	return newParserServiceWith(t, NewGithubService(client.GetClient(), testLogger()))
+}
+
+func newParserServiceWith(t *testing.T, githubService domainService.GithubService) *ParserServiceImpl {
+	t.Helper()
+	return newReplica(t, githubService, memory.NewJobRepository(), JobLeaseConfig{})
+}
+
+// newReplica creates a parser service storing its jobs in jobRepo, which other replicas may share
+func newReplica(t *testing.T, githubService domainService.GithubService, jobRepo repository.JobRepository, leases JobLeaseConfig) *ParserServiceImpl {
+	t.Helper()
+
+	s := NewParserService(
+		githubService,
+		memory.NewRepositoryRepository(),
+		memory.NewIssueRepository(),
+		memory.NewPullRequestRepository(),
+		memory.NewUserRepository(),
+		memory.NewCICheckRepository(),
+		memory.NewRepositoryFileRepository(),
+		memory.NewCodeOwnerRepository(),
+		memory.NewDependencyRepository(),
+		memory.NewSecurityAlertRepository(),
+		jobRepo,
+		NewJobQueue(2, 0, nil, testLogger()),
+		0,
+		entity.RetryPolicy{},
+		leases,
+		testCallbacks,
+		nil,
+		nil,
+		testLogger(),
+	)
+	s.StartWorkers(t.Context())
+
+	return s
+}
+
+func TestParsePullRequestsStoresCISummary(t *testing.T) {
+	s := newTestParserService(t, "parse_pull_requests")
+	ctx := context.Background()
+
+	var pages [][]*entity.PullRequest
+	result, err := s.ParsePullRequests(ctx, "octo", "demo", "", func(prs []*entity.PullRequest, _ entity.SaveResult) error {
+		pages = append(pages, prs)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ParsePullRequests: %v", err)
+	}
+	if result.Created != 2 || len(pages) != 1 || len(pages[0]) != 2 {
+		t.Fatalf("got %+v for pull requests in pages %v, want 2 created in one page", result, pages)
+	}
+
+	want := map[int]entity.CISummary{
+		12: {State: entity.CIStateFailed, Passed: 2, Failed: 1, Pending: 1},
+		11: {State: entity.CIStatePassed, Passed: 1},
+	}
+	for number, summary := range want {
+		pr, err := s.prRepo.FindByNumber(ctx, 1001, number)
+		if err != nil || pr == nil {
+			t.Fatalf("PR #%d not stored: %v", number, err)
+		}
+		if pr.CI != summary {
+			t.Errorf("PR #%d CI = %+v, want %+v", number, pr.CI, summary)
+		}
+	}
+
+	checks, err := s.ciCheckRepo.ListByPullRequest(ctx, 4002)
+	if err != nil {
+		t.Fatalf("ListByPullRequest: %v", err)
+	}
+	if len(checks) != 4 {
+		t.Errorf("got %d checks for PR #12, want 4", len(checks))
+	}
+}
+
+func TestParseIssuesStreamsPages(t *testing.T) {
+	fake := fakegithub.New(nil)
+	s := newFakeParserService(t, fake)
+	s.pageSize = 10
+	ctx := context.Background()
+
+	// Pull requests in the issue list take room on the pages
+	var sizes []int
+	result, err := s.ParseIssues(ctx, "octo", "demo", "", func(issues []*entity.Issue, _ entity.SaveResult) error {
+		sizes = append(sizes, len(issues))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ParseIssues: %v", err)
+	}
+	if result.Fetched() != 45 || len(sizes) != 6 {
+		t.Fatalf("got %d issues in pages of %v, want 45 in 6 pages", result.Fetched(), sizes)
+	}
+
+	// A page handler error stops the listing; the next page may have been fetched already but is not saved
+	s = newFakeParserService(t, fake)
+	s.pageSize = 10
+	errStop := errors.New("stop")
+	pages, requests := 0, len(fake.RequestLog())
+	result, err = s.ParseIssues(ctx, "octo", "demo", "", func(issues []*entity.Issue, _ entity.SaveResult) error {
+		if pages++; pages == 2 {
+			return errStop
+		}
+		return nil
+	})
+	count := result.Fetched()
+	if !errors.Is(err, errStop) || count != sizes[0]+sizes[1] {
+		t.Fatalf("ParseIssues stopped with %d issues: %v", count, err)
+	}
+
+	stored, err := s.issueRepo.List(ctx, repository.IssueFilter{RepositoryID: 1001, Limit: 100})
+	if err != nil || len(stored) != count {
+		t.Errorf("stored %d issues, want %d: %v", len(stored), count, err)
+	}
+	fetched := 0
+	for _, request := range fake.RequestLog()[requests:] {
+		if strings.HasPrefix(request, "/repos/octo/demo/issues?") {
+			fetched++
+		}
+	}
+	if fetched > 3 {
+		t.Errorf("fetched %d issue pages after stopping at the second", fetched)
+	}
+}
+
+func TestParseIssuesSaveResult(t *testing.T) {
+	fake := fakegithub.New(nil)
+	s := newFakeParserService(t, fake)
+	s.pageSize = 10
+	flaky := &flakyIssueRepo{IssueRepository: s.issueRepo, failAt: 3}
+	s.issueRepo = flaky
+	ctx := context.Background()
+
+	// Best effort goes on after the third issue fails and reports it with the page
+	var pageFailures int
+	result, err := s.ParseIssues(ctx, "octo", "demo", "", func(_ []*entity.Issue, page entity.SaveResult) error {
+		pageFailures += page.Failed
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ParseIssues: %v", err)
+	}
+	if result.Created != 44 || result.Failed != 1 || pageFailures != 1 || len(result.Failures) != 1 {
+		t.Fatalf("result = %+v, want 44 created and 1 failed", result)
+	}
+	failure := result.Failures[0]
+	stored, err := s.issueRepo.FindByID(ctx, failure.ID)
+	if err != nil || stored != nil || failure.Number == 0 || failure.Reason != "disk full" {
+		t.Errorf("failure = %+v, stored as %v: %v", failure, stored, err)
+	}
+
+	// Parsing again stores the failed issue and leaves the others as they are
+	result, err = s.ParseIssues(ctx, "octo", "demo", entity.SavePolicyBestEffort, nil)
+	if err != nil {
+		t.Fatalf("ParseIssues again: %v", err)
+	}
+	if result.Created != 1 || result.Unchanged != 44 || result.Updated != 0 || result.Failed != 0 {
+		t.Errorf("second result = %+v, want 1 created and 44 unchanged", result)
+	}
+
+	// Fail fast stops at the failed issue
+	s = newFakeParserService(t, fake)
+	s.issueRepo = &flakyIssueRepo{IssueRepository: s.issueRepo, failAt: 3}
+	result, err = s.ParseIssues(ctx, "octo", "demo", entity.SavePolicyFailFast, nil)
+	var saveErr *domainService.ItemSaveError
+	if !errors.As(err, &saveErr) || saveErr.Failure.Number == 0 {
+		t.Fatalf("fail fast: %v, want an item save error", err)
+	}
+	if result.Created != 2 || result.Failed != 1 || result.Failures[0] != saveErr.Failure {
+		t.Errorf("fail fast result = %+v, want 2 created and the failed issue", result)
+	}
+
+	if _, err := s.ParseIssues(ctx, "octo", "demo", "all_or_nothing", nil); !errors.Is(err, domainService.ErrInvalidJobOptions) {
+		t.Errorf("unknown save policy: %v", err)
+	}
+}
+
+func TestReconcileRepository(t *testing.T) {
+	s := newTestParserService(t, "reconcile")
+	ctx := context.Background()
+
+	// #3 still exists, #1 was deleted and #4 was transferred to octo/other
+	for _, issue := range []*entity.Issue{
+		{ID: 3001, Number: 1, RepositoryID: 1001},
+		{ID: 3003, Number: 3, RepositoryID: 1001},
+		{ID: 3004, Number: 4, RepositoryID: 1001},
+	} {
+		if _, err := s.issueRepo.Save(ctx, issue); err != nil {
+			t.Fatalf("save issue: %v", err)
+		}
+	}
+	for _, pr := range []*entity.PullRequest{
+		{ID: 4002, Number: 12, RepositoryID: 1001},
+		{ID: 4003, Number: 13, RepositoryID: 1001},
+	} {
+		if _, err := s.prRepo.Save(ctx, pr); err != nil {
+			t.Fatalf("save pull request: %v", err)
+		}
+	}
+
+	result, err := s.ReconcileRepository(ctx, "octo", "demo")
+	if err != nil {
+		t.Fatalf("ReconcileRepository: %v", err)
+	}
+
+	if result.IssuesChecked != 3 || result.PullRequestsChecked != 2 {
+		t.Errorf("checked %d issues and %d PRs, want 3 and 2", result.IssuesChecked, result.PullRequestsChecked)
+	}
+
+	deleted := make(map[string]int)
+	for _, item := range result.Deleted {
+		deleted[item.Kind] = item.Number
+	}
+	if len(result.Deleted) != 2 || deleted["issue"] != 1 || deleted["pull_request"] != 13 {
+		t.Errorf("deleted = %+v, want issue #1 and PR #13", result.Deleted)
+	}
+
+	if len(result.Transferred) != 1 || result.Transferred[0].Number != 4 || result.Transferred[0].TransferredTo != "octo/other" {
+		t.Errorf("transferred = %+v, want issue #4 to octo/other", result.Transferred)
+	}
+
+	// Tombstoned items are hidden from default listings
+	issues, err := s.issueRepo.List(ctx, repository.IssueFilter{RepositoryID: 1001})
+	if err != nil {
+		t.Fatalf("list issues: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Number != 3 {
+		t.Errorf("visible issues = %+v, want only #3", issues)
+	}
+
+	transferred, err := s.issueRepo.FindByID(ctx, 3004)
+	if err != nil || transferred == nil {
+		t.Fatalf("find transferred issue: %v", err)
+	}
+	if transferred.TransferredTo != "octo/other" || transferred.DeletedAt == nil {
+		t.Errorf("issue #4 = %+v, want tombstone pointing at octo/other", transferred)
+	}
+}
+
+func TestRecoverJobs(t *testing.T) {
+	ctx := context.Background()
+
+	for _, resume := range []bool{false, true} {
+		s := newTestParserService(t, "repository")
+
+		created := time.Now().Add(-time.Hour)
+		for _, job := range []*entity.ParsingJob{
+			{ID: "running", Status: entity.JobStatusInProgress, Attempts: 1, CreatedAt: created},
+			{ID: "queued", Status: entity.JobStatusPending, CreatedAt: created},
+			{ID: "done", Status: entity.JobStatusCompleted, Progress: 100, Attempts: 1, CreatedAt: created},
+		} {
+			job.Params = entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo"}
+			if err := s.jobRepo.Save(ctx, job); err != nil {
+				t.Fatalf("save job: %v", err)
+			}
+		}
+
+		if err := s.RecoverJobs(ctx, resume); err != nil {
+			t.Fatalf("RecoverJobs(%v): %v", resume, err)
+		}
+
+		want := entity.JobStatusInterrupted
+		if resume {
+			want = entity.JobStatusCompleted
+		}
+
+		for _, id := range []string{"running", "queued"} {
+			job := waitForJobStatus(t, s, id)
+			if job.Status != want {
+				t.Errorf("resume=%v: job %s is %s, want %s", resume, id, job.Status, want)
+			}
+			if job.FinishedAt == nil {
+				t.Errorf("resume=%v: job %s has no finish time", resume, id)
+			}
+		}
+
+		done, _ := s.jobRepo.FindByID(ctx, "done")
+		if done.Status != entity.JobStatusCompleted || len(done.Transitions) != 0 {
+			t.Errorf("resume=%v: finished job was touched: %+v", resume, done)
+		}
+
+		if resume {
+			running, _ := s.jobRepo.FindByID(ctx, "running")
+			if running.Attempts != 2 {
+				t.Errorf("resumed job attempts = %d, want 2", running.Attempts)
+			}
+		}
+	}
+}
+
+func TestStartParsingJobConcurrently(t *testing.T) {
+	s := newTestParserService(t, "repository")
+	ctx := context.Background()
+	params := entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo"}
+
+	submit := func(params entity.ParsingJobParams, n int) []string {
+		ids := make([]string, n)
+		var wg sync.WaitGroup
+		for i := range ids {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				id, err := s.StartParsingJob(ctx, params)
+				if err != nil {
+					t.Errorf("StartParsingJob: %v", err)
+				}
+				ids[i] = id
+			}()
+		}
+		wg.Wait()
+		return ids
+	}
+
+	// Jobs submitted at the same moment get their own IDs
+	distinct := make(map[string]bool)
+	for _, id := range submit(params, 20) {
+		distinct[id] = true
+	}
+	if len(distinct) != 20 {
+		t.Errorf("20 submissions created %d jobs", len(distinct))
+	}
+
+	// Submissions sharing an idempotency key create one job
+	keyed := params
+	keyed.IdempotencyKey = "same-key"
+	ids := submit(keyed, 20)
+	for _, id := range ids {
+		if id != ids[0] {
+			t.Fatalf("submissions with one key returned jobs %s and %s", ids[0], id)
+		}
+	}
+
+	jobs, err := s.jobRepo.List(ctx, repository.JobFilter{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(jobs) != 21 {
+		t.Errorf("stored %d jobs, want 21", len(jobs))
+	}
+}
+
+func TestParsingJobResumesFromCheckpoint(t *testing.T) {
+	fake := fakegithub.New(nil)
+	s := newFakeParserService(t, fake)
+	s.pageSize = 10
+	ctx := context.Background()
+
+	// The third page of issues fails once; the retry continues with it instead of starting over
+	fake.InjectFault(fakegithub.Fault{Kind: fakegithub.FaultServerError, PathPrefix: "/repos/octo/demo/issues", Skip: 2, Count: 1})
+
+	id, err := s.StartParsingJob(ctx, entity.ParsingJobParams{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		Retry:       entity.RetryPolicy{MaxAttempts: 2},
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJobStatus(t, s, id)
+	if job.Status != entity.JobStatusCompleted || job.Attempts != 2 || job.Results.Issues != 45 {
+		t.Fatalf("job %s after %d attempts with %d issues: %s", job.Status, job.Attempts, job.Results.Issues, job.ErrorMessage)
+	}
+	if checkpoint := job.Checkpoint(entity.JobStepIssues); checkpoint.NextPage != 0 || checkpoint.Items != 45 {
+		t.Errorf("issues checkpoint = %+v, want finished with 45 items", checkpoint)
+	}
+
+	pages := make(map[string]int)
+	for _, uri := range fake.RequestLog() {
+		path, query, _ := strings.Cut(uri, "?")
+		if path != "/repos/octo/demo/issues" {
+			continue
+		}
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			t.Fatalf("parse query %q: %v", query, err)
+		}
+		pages[values.Get("page")]++
+	}
+
+	// Issue pages include pull requests, so 57 items span 6 pages
+	if len(pages) != 6 {
+		t.Fatalf("requested issue pages = %v, want 6 pages", pages)
+	}
+	for page, requests := range pages {
+		want := 1
+		if page == "3" {
+			want = 2
+		}
+		if requests != want {
+			t.Errorf("page %s requested %d times, want %d", page, requests, want)
+		}
+	}
+}
+
+// waitForJobStatus waits until a stored job reaches a final status
+func waitForJobStatus(t *testing.T, s *ParserServiceImpl, id string) *entity.ParsingJob {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := s.jobRepo.FindByID(context.Background(), id)
+		if err != nil || job == nil {
+			t.Fatalf("find job %s: %v", id, err)
+		}
+		if job.Finished() {
+			return job
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	t.Fatalf("job %s did not finish", id)
+	return nil
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/cron"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/uuid"
+)
+
+// activeJobStatuses are the statuses of jobs that keep a schedule from starting another run
+var activeJobStatuses = []string{entity.JobStatusPending, entity.JobStatusInProgress, entity.JobStatusPaused, entity.JobStatusRetrying}
+
+// Scheduler stores schedules and starts their parsing jobs when they are due
+type Scheduler struct {
+	scheduleRepo  repository.ScheduleRepository
+	parserService domainService.ParserService
+	// tick is how often due schedules are checked
+	tick   time.Duration
+	logger *logger.Logger
+}
+
+func NewScheduler(
+	scheduleRepo repository.ScheduleRepository,
+	parserService domainService.ParserService,
+	tick time.Duration,
+	logger *logger.Logger,
+) *Scheduler {
+	return &Scheduler{
+		scheduleRepo:  scheduleRepo,
+		parserService: parserService,
+		tick:          tick,
+		logger:        logger,
+	}
+}
+
+func (s *Scheduler) CreateSchedule(ctx context.Context, params domainService.ParsingJobParams, cronExpr string, interval time.Duration) (*entity.Schedule, error) {
+	now := time.Now().UTC()
+
+	schedule := &entity.Schedule{
+		ID:        "schedule-" + uuid.New(),
+		Params:    params,
+		Cron:      cronExpr,
+		Interval:  interval,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if cronExpr != "" && interval != 0 {
+		return nil, fmt.Errorf("%w: set either a cron expression or an interval", domainService.ErrInvalidSchedule)
+	}
+	if err := domainService.ValidateJobOptions(params); err != nil {
+		return nil, err
+	}
+	// Checked as every run will be, so a schedule whose runs cannot start is not stored
+	if params.CallbackURL != "" {
+		if err := s.parserService.CheckCallback(params.CallbackURL); err != nil {
+			return nil, err
+		}
+	}
+
+	next, err := nextRun(schedule, now)
+	if err != nil {
+		return nil, err
+	}
+	schedule.NextRunAt = next
+
+	if err := s.scheduleRepo.Save(ctx, schedule); err != nil {
+		s.logger.Error("Failed to save schedule: %v", err)
+		return nil, err
+	}
+
+	s.logger.Info("Created schedule %s for %s/%s, next run at %s", schedule.ID, params.OwnerName, params.RepoName, next.Format(time.RFC3339))
+	return schedule, nil
+}
+
+func (s *Scheduler) ListSchedules(ctx context.Context) ([]*entity.Schedule, error) {
+	return s.scheduleRepo.List(ctx)
+}
+
+func (s *Scheduler) DeleteSchedule(ctx context.Context, id string) error {
+	schedule, err := s.scheduleRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if schedule == nil {
+		return fmt.Errorf("%w: %s", domainService.ErrScheduleNotFound, id)
+	}
+
+	return s.scheduleRepo.Delete(ctx, id)
+}
+
+// Start checks for due schedules every tick until ctx is done
+func (s *Scheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.tick)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.runDue(ctx, time.Now().UTC())
+			}
+		}
+	}()
+
+	s.logger.Info("Started job scheduler, checking every %s", s.tick)
+}
+
+// runDue starts the jobs of all schedules due at now.
+// Runs missed while the service was down are not caught up: a late schedule runs once.
+func (s *Scheduler) runDue(ctx context.Context, now time.Time) {
+	schedules, err := s.scheduleRepo.ListDue(ctx, now)
+	if err != nil {
+		s.logger.Error("Failed to list due schedules: %v", err)
+		return
+	}
+
+	for _, schedule := range schedules {
+		s.run(ctx, schedule, now)
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, schedule *entity.Schedule, now time.Time) {
+	due := schedule.NextRunAt
+	next, nextErr := nextRun(schedule, now)
+
+	// Replicas running the same due schedule race for the run; only the winner starts and records it
+	claimed, err := s.scheduleRepo.ClaimRun(ctx, schedule.ID, due, next)
+	if err != nil {
+		s.logger.Error("Failed to claim run of schedule %s: %v", schedule.ID, err)
+		return
+	}
+	if !claimed {
+		s.logger.Debug("Run of schedule %s at %s was claimed by another replica", schedule.ID, due.Format(time.RFC3339))
+		return
+	}
+
+	params := schedule.Params
+	params.ScheduleID = schedule.ID
+	// Every run has its own key, so a run is never started twice
+	params.IdempotencyKey = fmt.Sprintf("%s@%s", schedule.ID, due.UTC().Format(time.RFC3339))
+
+	schedule.LastRunAt = &now
+	schedule.LastRunMessage = ""
+	schedule.NextRunAt = next
+	schedule.UpdatedAt = now
+
+	if nextErr != nil {
+		// The schedule was valid when created; without a next run it stops, so say why
+		schedule.LastRunResult = entity.ScheduleRunFailed
+		schedule.LastRunMessage = fmt.Sprintf("schedule has no next run: %v", nextErr)
+		s.logger.Error("Schedule %s has no next run: %v", schedule.ID, nextErr)
+		s.saveRun(ctx, schedule)
+		return
+	}
+
+	active, err := s.activeJob(ctx, params)
+	switch {
+	case err != nil:
+		schedule.LastRunResult = entity.ScheduleRunFailed
+		schedule.LastRunMessage = fmt.Sprintf("failed to check active jobs: %v", err)
+	case active != nil:
+		schedule.LastRunResult = entity.ScheduleRunSkipped
+		schedule.LastRunMessage = fmt.Sprintf("job %s is still %s", active.ID, active.Status)
+		s.logger.Info("Skipping schedule %s: job %s for %s/%s is still %s", schedule.ID, active.ID, params.OwnerName, params.RepoName, active.Status)
+	default:
+		jobID, err := s.parserService.StartParsingJob(ctx, params)
+		if err != nil {
+			schedule.LastRunResult = entity.ScheduleRunFailed
+			schedule.LastRunMessage = err.Error()
+			s.logger.Error("Schedule %s failed to start a job: %v", schedule.ID, err)
+			break
+		}
+		schedule.LastRunResult = entity.ScheduleRunStarted
+		schedule.LastJobID = jobID
+		s.logger.Info("Schedule %s started job %s", schedule.ID, jobID)
+	}
+
+	s.saveRun(ctx, schedule)
+}
+
+// saveRun stores the result of a claimed run
+func (s *Scheduler) saveRun(ctx context.Context, schedule *entity.Schedule) {
+	if err := s.scheduleRepo.Save(ctx, schedule); err != nil {
+		s.logger.Error("Failed to save schedule %s: %v", schedule.ID, err)
+	}
+}
+
+// activeJob returns an unfinished job for the same repository and job type, if there is one
+func (s *Scheduler) activeJob(ctx context.Context, params domainService.ParsingJobParams) (*domainService.ParsingJobStatus, error) {
+	jobType := params.JobType
+	if jobType == "" {
+		jobType = entity.JobTypeParse
+	}
+
+	jobs, err := s.parserService.ListParsingJobs(ctx, repository.JobFilter{
+		Statuses:  activeJobStatuses,
+		OwnerName: params.OwnerName,
+		RepoName:  params.RepoName,
+		JobType:   jobType,
+		Limit:     1,
+	})
+	if err != nil || len(jobs) == 0 {
+		return nil, err
+	}
+	return jobs[0], nil
+}
+
+// nextRun returns the first run of a schedule after now
+func nextRun(schedule *entity.Schedule, now time.Time) (time.Time, error) {
+	if schedule.Cron == "" {
+		if schedule.Interval <= 0 {
+			return time.Time{}, fmt.Errorf("%w: a cron expression or a positive interval is required", domainService.ErrInvalidSchedule)
+		}
+		return now.Add(schedule.Interval), nil
+	}
+
+	expr, err := cron.Parse(schedule.Cron)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", domainService.ErrInvalidSchedule, err)
+	}
+
+	next := expr.Next(now.UTC())
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("%w: cron expression %q never matches", domainService.ErrInvalidSchedule, schedule.Cron)
+	}
+	return next, nil
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	domainService "github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/fakegithub"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/persistence/memory"
+)
+
+func TestSchedulerRunsDueSchedules(t *testing.T) {
+	s := newTestParserService(t, "repository")
+	scheduler := NewScheduler(memory.NewScheduleRepository(), s, time.Hour, testLogger())
+	ctx := context.Background()
+
+	params := entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo"}
+	created, err := scheduler.CreateSchedule(ctx, params, "", time.Hour)
+	if err != nil {
+		t.Fatalf("CreateSchedule: %v", err)
+	}
+	start := created.CreatedAt
+
+	// Not due yet
+	scheduler.runDue(ctx, start.Add(30*time.Minute))
+	schedule, _ := scheduler.scheduleRepo.FindByID(ctx, created.ID)
+	if schedule.LastRunAt != nil {
+		t.Fatalf("schedule ran before it was due: %+v", schedule)
+	}
+
+	scheduler.runDue(ctx, start.Add(time.Hour))
+	schedule, _ = scheduler.scheduleRepo.FindByID(ctx, created.ID)
+	if schedule.LastRunResult != entity.ScheduleRunStarted || schedule.LastJobID == "" {
+		t.Fatalf("due schedule = %+v, want a started job", schedule)
+	}
+	if want := start.Add(2 * time.Hour); !schedule.NextRunAt.Equal(want) {
+		t.Errorf("next run = %s, want %s", schedule.NextRunAt, want)
+	}
+
+	job := waitForJobStatus(t, s, schedule.LastJobID)
+	if job.Status != entity.JobStatusCompleted || job.Params.ScheduleID != created.ID {
+		t.Errorf("scheduled job = %s for schedule %q", job.Status, job.Params.ScheduleID)
+	}
+
+	// Another job for the same repository is still running, so the next run is skipped
+	running := &entity.ParsingJob{ID: "running", Params: params, Status: entity.JobStatusInProgress, CreatedAt: time.Now()}
+	if err := s.jobRepo.Save(ctx, running); err != nil {
+		t.Fatalf("save job: %v", err)
+	}
+
+	scheduler.runDue(ctx, start.Add(2*time.Hour))
+	schedule, _ = scheduler.scheduleRepo.FindByID(ctx, created.ID)
+	if schedule.LastRunResult != entity.ScheduleRunSkipped || schedule.LastJobID != job.ID {
+		t.Errorf("schedule with an active job = %+v, want skipped", schedule)
+	}
+	if want := start.Add(3 * time.Hour); !schedule.NextRunAt.Equal(want) {
+		t.Errorf("next run after skip = %s, want %s", schedule.NextRunAt, want)
+	}
+}
+
+func TestCreateScheduleValidation(t *testing.T) {
+	scheduler := NewScheduler(memory.NewScheduleRepository(), nil, time.Hour, testLogger())
+	ctx := context.Background()
+	params := entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo"}
+
+	hourly, err := scheduler.CreateSchedule(ctx, params, "@hourly", 0)
+	if err != nil {
+		t.Fatalf("CreateSchedule(@hourly): %v", err)
+	}
+	if want := hourly.CreatedAt.Truncate(time.Hour).Add(time.Hour); !hourly.NextRunAt.Equal(want) {
+		t.Errorf("next run = %s, want %s", hourly.NextRunAt, want)
+	}
+
+	// Schedules created in the same clock tick get their own IDs
+	again, err := scheduler.CreateSchedule(ctx, params, "@hourly", 0)
+	if err != nil || again.ID == hourly.ID {
+		t.Errorf("second schedule = %v, %v, want a new ID", again, err)
+	}
+
+	for _, tc := range []struct {
+		cron     string
+		interval time.Duration
+	}{
+		{"", 0},
+		{"@hourly", time.Hour},
+		{"* * *", 0},
+		{"0 0 30 2 *", 0},
+		{"", -time.Minute},
+	} {
+		if _, err := scheduler.CreateSchedule(ctx, params, tc.cron, tc.interval); !errors.Is(err, domainService.ErrInvalidSchedule) {
+			t.Errorf("CreateSchedule(%q, %s): got %v, want ErrInvalidSchedule", tc.cron, tc.interval, err)
+		}
+	}
+
+	if err := scheduler.DeleteSchedule(ctx, "missing"); !errors.Is(err, domainService.ErrScheduleNotFound) {
+		t.Errorf("DeleteSchedule(missing): got %v, want ErrScheduleNotFound", err)
+	}
+	if err := scheduler.DeleteSchedule(ctx, hourly.ID); err != nil {
+		t.Errorf("DeleteSchedule: %v", err)
+	}
+}
+
+func TestSchedulerRunIsClaimedOnce(t *testing.T) {
+	s := newTestParserService(t, "repository")
+	scheduleRepo := memory.NewScheduleRepository()
+	winner := NewScheduler(scheduleRepo, s, time.Hour, testLogger())
+	loser := NewScheduler(scheduleRepo, s, time.Hour, testLogger())
+	ctx := context.Background()
+
+	created, err := winner.CreateSchedule(ctx, entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo"}, "", time.Hour)
+	if err != nil {
+		t.Fatalf("CreateSchedule: %v", err)
+	}
+	now := created.NextRunAt
+
+	// Both replicas listed the schedule as due before either ran it
+	first, _ := scheduleRepo.FindByID(ctx, created.ID)
+	second, _ := scheduleRepo.FindByID(ctx, created.ID)
+
+	winner.run(ctx, first, now)
+	loser.run(ctx, second, now)
+
+	schedule, _ := scheduleRepo.FindByID(ctx, created.ID)
+	if schedule.LastRunResult != entity.ScheduleRunStarted || schedule.LastJobID == "" {
+		t.Fatalf("schedule after two replicas ran it = %+v, want the winner's started run", schedule)
+	}
+	if want := now.Add(time.Hour); !schedule.NextRunAt.Equal(want) {
+		t.Errorf("next run = %s, want %s", schedule.NextRunAt, want)
+	}
+	waitForJobStatus(t, s, schedule.LastJobID)
+}
+
+func TestSchedulerReportsScheduleWithoutNextRun(t *testing.T) {
+	scheduleRepo := memory.NewScheduleRepository()
+	scheduler := NewScheduler(scheduleRepo, nil, time.Hour, testLogger())
+	ctx := context.Background()
+
+	// Stored by an older version that accepted a cron expression this one rejects
+	now := time.Now().UTC()
+	broken := &entity.Schedule{ID: "broken", Cron: "* * *", NextRunAt: now, CreatedAt: now}
+	if err := scheduleRepo.Save(ctx, broken); err != nil {
+		t.Fatalf("save schedule: %v", err)
+	}
+
+	scheduler.runDue(ctx, now)
+	schedule, _ := scheduleRepo.FindByID(ctx, "broken")
+	if schedule.LastRunResult != entity.ScheduleRunFailed || schedule.LastRunMessage == "" {
+		t.Errorf("schedule without a next run = %+v, want a failed run with a reason", schedule)
+	}
+	if !schedule.NextRunAt.IsZero() {
+		t.Errorf("next run = %s, want none", schedule.NextRunAt)
+	}
+}
+
+func TestCreateScheduleChecksCallback(t *testing.T) {
+	s := newFakeParserService(t, fakegithub.New(nil))
+	scheduler := NewScheduler(memory.NewScheduleRepository(), s, time.Hour, testLogger())
+	ctx := context.Background()
+	params := entity.ParsingJobParams{OwnerName: "octo", RepoName: "demo", CallbackURL: "https://example.com/hook"}
+
+	if _, err := scheduler.CreateSchedule(ctx, params, "", time.Hour); err != nil {
+		t.Fatalf("CreateSchedule with a callback: %v", err)
+	}
+
+	// Every run of the schedule would fail to start its job
+	s.callbacks.Secret = ""
+	if _, err := scheduler.CreateSchedule(ctx, params, "", time.Hour); !errors.Is(err, domainService.ErrInvalidCallback) {
+		t.Errorf("callback URL with no secret: got %v, want ErrInvalidCallback", err)
+	}
+	if schedules, _ := scheduler.ListSchedules(ctx); len(schedules) != 1 {
+		t.Errorf("got %d schedules, want only the one with a signed callback", len(schedules))
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
 )
 
 type Config struct {
@@ -17,6 +18,45 @@ type Config struct {
 
 	GitHub struct {
 		Token string
+		// BaseURL overrides the API root, empty means api.github.com
+		BaseURL string
+		// CassetteMode ("record" or "replay") and CassettePath enable the record-and-replay transport
+		CassetteMode string
+		CassettePath string
+	}
+
+	Jobs struct {
+		// Workers is the number of jobs processed concurrently
+		Workers int
+		// QueueSize limits the number of waiting jobs, 0 means unlimited
+		QueueSize int
+		// StepParallelism is the number of steps of one job run concurrently
+		StepParallelism int
+		// ResumeInterrupted restarts jobs left unfinished by a previous run instead of marking them interrupted
+		ResumeInterrupted bool
+		// ScheduleTick is how often the scheduler looks for due schedules
+		ScheduleTick time.Duration
+		// MaxAttempts, RetryBackoff and RetryMaxBackoff form the retry policy of jobs submitted without one
+		MaxAttempts     int
+		RetryBackoff    time.Duration
+		RetryMaxBackoff time.Duration
+		// ReplicaID names this replica in job leases, a unique name is generated when empty
+		ReplicaID string
+		// LeaseTTL is how long a replica holds a job without renewing its lease;
+		// LeasePollInterval is how often jobs whose lease expired are taken over
+		LeaseTTL          time.Duration
+		LeasePollInterval time.Duration
+	}
+
+	Callbacks struct {
+		// Secret signs job callbacks; jobs with a callback URL are rejected while it is empty
+		Secret string
+		// MaxAttempts, Backoff and MaxBackoff control the retries of a callback the receiver did not accept
+		MaxAttempts int
+		Backoff     time.Duration
+		MaxBackoff  time.Duration
+		// Timeout bounds one delivery attempt
+		Timeout time.Duration
 	}
 }
 
@@ -36,6 +76,99 @@ func Load() (*Config, error) {
 
 	// GitHub
 	cfg.GitHub.Token = getEnv("GITHUB_TOKEN", "")
+	cfg.GitHub.BaseURL = getEnv("GITHUB_API_URL", "")
+	cfg.GitHub.CassetteMode = getEnv("GITHUB_CASSETTE_MODE", "")
+	cfg.GitHub.CassettePath = getEnv("GITHUB_CASSETTE_PATH", "cassettes/github.json")
+
+	// Jobs
+	workers, err := strconv.Atoi(getEnv("JOBS_WORKERS", "4"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Jobs.Workers = workers
+
+	queueSize, err := strconv.Atoi(getEnv("JOBS_QUEUE_SIZE", "100"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Jobs.QueueSize = queueSize
+
+	stepParallelism, err := strconv.Atoi(getEnv("JOBS_STEP_PARALLELISM", "3"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Jobs.StepParallelism = stepParallelism
+
+	resume, err := strconv.ParseBool(getEnv("JOBS_RESUME_INTERRUPTED", "false"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Jobs.ResumeInterrupted = resume
+
+	scheduleTick, err := time.ParseDuration(getEnv("JOBS_SCHEDULE_TICK", "15s"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Jobs.ScheduleTick = scheduleTick
+
+	maxAttempts, err := strconv.Atoi(getEnv("JOBS_MAX_ATTEMPTS", "3"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Jobs.MaxAttempts = maxAttempts
+
+	retryBackoff, err := time.ParseDuration(getEnv("JOBS_RETRY_BACKOFF", "30s"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Jobs.RetryBackoff = retryBackoff
+
+	retryMaxBackoff, err := time.ParseDuration(getEnv("JOBS_RETRY_MAX_BACKOFF", "10m"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Jobs.RetryMaxBackoff = retryMaxBackoff
+
+	cfg.Jobs.ReplicaID = getEnv("JOBS_REPLICA_ID", "")
+
+	leaseTTL, err := time.ParseDuration(getEnv("JOBS_LEASE_TTL", "30s"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Jobs.LeaseTTL = leaseTTL
+
+	leasePollInterval, err := time.ParseDuration(getEnv("JOBS_LEASE_POLL_INTERVAL", "5s"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Jobs.LeasePollInterval = leasePollInterval
+
+	// Callbacks
+	cfg.Callbacks.Secret = getEnv("CALLBACK_SECRET", "")
+
+	callbackAttempts, err := strconv.Atoi(getEnv("CALLBACK_MAX_ATTEMPTS", "5"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Callbacks.MaxAttempts = callbackAttempts
+
+	callbackBackoff, err := time.ParseDuration(getEnv("CALLBACK_BACKOFF", "1s"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Callbacks.Backoff = callbackBackoff
+
+	callbackMaxBackoff, err := time.ParseDuration(getEnv("CALLBACK_MAX_BACKOFF", "1m"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Callbacks.MaxBackoff = callbackMaxBackoff
+
+	callbackTimeout, err := time.ParseDuration(getEnv("CALLBACK_TIMEOUT", "10s"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Callbacks.Timeout = callbackTimeout
 
 	return cfg, nil
 }
@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// CI check sources
+const (
+	CISourceStatus   = "status"
+	CISourceCheckRun = "check_run"
+)
+
+// Normalized CI states
+const (
+	CIStatePassed  = "passed"
+	CIStateFailed  = "failed"
+	CIStatePending = "pending"
+)
+
+// CICheck is a single commit status or check run reported for a pull request head commit
+type CICheck struct {
+	ID            int64      `bson:"id"`
+	Source        string     `bson:"source"` // "status", "check_run"
+	Name          string     `bson:"name"`
+	State         string     `bson:"state"`    // "passed", "failed", "pending"
+	RawState      string     `bson:"rawState"` // state or conclusion as reported by GitHub
+	URL           string     `bson:"url"`
+	HeadSHA       string     `bson:"headSHA"`
+	PullRequestID int64      `bson:"pullRequestID"`
+	RepositoryID  int64      `bson:"repositoryID"`
+	StartedAt     *time.Time `bson:"startedAt"`
+	CompletedAt   *time.Time `bson:"completedAt"`
+}
+
+// CISummary aggregates the CI checks of a pull request head commit
+type CISummary struct {
+	State   string `bson:"state"` // "passed", "failed", "pending" or empty when no checks were reported
+	Passed  int    `bson:"passed"`
+	Failed  int    `bson:"failed"`
+	Pending int    `bson:"pending"`
+}
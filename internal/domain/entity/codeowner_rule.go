@@ -0,0 +1,10 @@
+package entity
+
+// CodeOwnerRule is a single pattern line of a CODEOWNERS file
+type CodeOwnerRule struct {
+	RepositoryID int64    `bson:"repositoryID"`
+	Path         string   `bson:"path"` // location of the CODEOWNERS file
+	Line         int      `bson:"line"`
+	Pattern      string   `bson:"pattern"`
+	Owners       []string `bson:"owners"`
+}
@@ -0,0 +1,20 @@
+package entity
+
+// Dependency ecosystems
+const (
+	EcosystemGo     = "go"
+	EcosystemNPM    = "npm"
+	EcosystemPyPI   = "pypi"
+	EcosystemDocker = "docker"
+)
+
+// Dependency is a package or image declared in a dependency manifest of a repository
+type Dependency struct {
+	RepositoryID       int64  `bson:"repositoryID"`
+	RepositoryFullName string `bson:"repositoryFullName"`
+	Manifest           string `bson:"manifest"` // path of the manifest file
+	Ecosystem          string `bson:"ecosystem"`
+	Name               string `bson:"name"`
+	Version            string `bson:"version"`
+	Scope              string `bson:"scope"` // "runtime", "dev", "indirect"
+}
@@ -3,14 +3,16 @@ package entity
 import "time"
 
 type Issue struct {
-	ID           int64
-	Number       int
-	Title        string
-	Body         string
-	State        string
-	AuthorLogin  string
-	RepositoryID int64
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	ClosedAt     *time.Time
+	ID            int64      `bson:"id"`
+	Number        int        `bson:"number"`
+	Title         string     `bson:"title"`
+	Body          string     `bson:"body"`
+	State         string     `bson:"state"`
+	AuthorLogin   string     `bson:"authorLogin"`
+	RepositoryID  int64      `bson:"repositoryID"`
+	CreatedAt     time.Time  `bson:"createdAt"`
+	UpdatedAt     time.Time  `bson:"updatedAt"`
+	ClosedAt      *time.Time `bson:"closedAt"`
+	DeletedAt     *time.Time `bson:"deletedAt"`     // set when the issue vanished upstream
+	TransferredTo string     `bson:"transferredTo"` // full name of the destination repository, when known
 }
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// Parsing job event types
+const (
+	// JobEventState carries the full job and is sent first to every watcher
+	JobEventState        = "state"
+	JobEventStatus       = "status"
+	JobEventProgress     = "progress"
+	JobEventStepStarted  = "step_started"
+	JobEventStepFinished = "step_finished"
+	JobEventWarning      = "warning"
+)
+
+// JobEvent is a change of a parsing job pushed to watchers
+type JobEvent struct {
+	Type     string
+	JobID    string
+	Status   string
+	Progress int
+	// Step is set for step events
+	Step *JobStepResult
+	// Message holds the transition message or the warning text
+	Message string
+	At      time.Time
+	// Job is set for state events
+	Job *ParsingJob
+}
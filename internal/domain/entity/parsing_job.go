@@ -0,0 +1,530 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// Parsing job types
+const (
+	JobTypeParse     = "parse"
+	JobTypeReconcile = "reconcile"
+	// JobTypeBatch is a parent job that runs one child job per repository and aggregates them
+	JobTypeBatch = "batch"
+)
+
+// Parsing job statuses
+const (
+	JobStatusPending    = "pending"
+	JobStatusInProgress = "in_progress"
+	JobStatusCompleted  = "completed"
+	JobStatusFailed     = "failed"
+	// JobStatusInterrupted marks a job that was running when the server stopped
+	JobStatusInterrupted = "interrupted"
+	JobStatusCancelled   = "cancelled"
+	// JobStatusPaused marks a job stopped on request that can be resumed from its last completed step
+	JobStatusPaused = "paused"
+	// JobStatusRetrying marks a job waiting for its next attempt after a transient failure
+	JobStatusRetrying = "retrying"
+	// JobStatusDeadLetter marks a job that failed on every attempt its retry policy allowed
+	JobStatusDeadLetter = "dead_letter"
+)
+
+// Events sent to the callback URL of a job
+const (
+	CallbackEventCompleted = "job.completed"
+	CallbackEventFailed    = "job.failed"
+	CallbackEventCancelled = "job.cancelled"
+)
+
+// Delivery statuses of a callback
+const (
+	CallbackStatusPending   = "pending"
+	CallbackStatusDelivered = "delivered"
+	// CallbackStatusFailed means the receiver did not accept the callback on any attempt
+	CallbackStatusFailed = "failed"
+)
+
+// CallbackEvent returns the callback event of a job reaching status, empty for a status without one
+func CallbackEvent(status string) string {
+	switch status {
+	case JobStatusCompleted:
+		return CallbackEventCompleted
+	case JobStatusFailed, JobStatusDeadLetter, JobStatusInterrupted:
+		return CallbackEventFailed
+	case JobStatusCancelled:
+		return CallbackEventCancelled
+	}
+	return ""
+}
+
+// CallbackDelivery records how one event of a job was delivered to its callback URL
+type CallbackDelivery struct {
+	// ID is sent with the request, so receivers can drop repeated deliveries
+	ID       string            `bson:"id"`
+	Event    string            `bson:"event"`
+	URL      string            `bson:"url"`
+	Status   string            `bson:"status"`
+	Attempts []CallbackAttempt `bson:"attempts"`
+	// Error says why a delivery failed without the receiver answering its last attempt
+	Error     string    `bson:"error,omitempty"`
+	CreatedAt time.Time `bson:"createdAt"`
+}
+
+// CallbackAttempt is one POST of a callback
+type CallbackAttempt struct {
+	Number int       `bson:"number"`
+	At     time.Time `bson:"at"`
+	// StatusCode is the response status, 0 when no response arrived
+	StatusCode int           `bson:"statusCode"`
+	Error      string        `bson:"error"`
+	Duration   time.Duration `bson:"duration"`
+}
+
+// LeasedJobStatuses are the statuses in which a job is held by the replica queueing or running it
+var LeasedJobStatuses = []string{JobStatusPending, JobStatusInProgress, JobStatusRetrying}
+
+// Requests to stop a job run by another replica
+const (
+	JobStopCancel = "cancel"
+	JobStopPause  = "pause"
+)
+
+// Parsing job steps
+const (
+	JobStepRepository     = "repository"
+	JobStepIssues         = "issues"
+	JobStepPullRequests   = "pull_requests"
+	JobStepContents       = "contents"
+	JobStepSecurityAlerts = "security_alerts"
+	JobStepUsers          = "users"
+	JobStepReconcile      = "reconcile"
+)
+
+// Statuses of a single job step
+const (
+	StepStatusRunning   = "running"
+	StepStatusCompleted = "completed"
+	StepStatusFailed    = "failed"
+	// StepStatusStopped marks a step interrupted by a cancel or pause request or by a step running beside it failing
+	StepStatusStopped = "stopped"
+)
+
+// ParsingJobParams describes what a parsing job should fetch
+type ParsingJobParams struct {
+	JobType             string `bson:"jobType"` // "parse" (default), "reconcile", "batch"
+	OwnerName           string `bson:"ownerName"`
+	RepoName            string `bson:"repoName"`
+	ParseIssues         bool   `bson:"parseIssues"`
+	ParsePRs            bool   `bson:"parsePRs"`
+	ParseUsers          bool   `bson:"parseUsers"`
+	ParseContents       bool   `bson:"parseContents"`
+	ParseSecurityAlerts bool   `bson:"parseSecurityAlerts"`
+	// Priority orders queued jobs, higher first; jobs of equal priority run in submission order
+	Priority int `bson:"priority"`
+	// Retry is filled with the server defaults when MaxAttempts is 0
+	Retry RetryPolicy `bson:"retry"`
+	// ScheduleID is set for jobs started by a schedule
+	ScheduleID string `bson:"scheduleId,omitempty"`
+	// ParentID is set for the child jobs of a batch
+	ParentID string `bson:"parentId,omitempty"`
+	// IdempotencyKey identifies a submission: submitting the same key again returns the job it created
+	IdempotencyKey string `bson:"idempotencyKey,omitempty"`
+	// Dedupe rejects the job while an unfinished job for the same repository and options exists
+	Dedupe bool `bson:"dedupe,omitempty"`
+	// CallbackURL receives a signed POST with the job report when the job completes, fails or is cancelled
+	CallbackURL string `bson:"callbackUrl,omitempty"`
+	// Since and Until keep the issues and pull requests last updated in [Since, Until); a zero time leaves that end open
+	Since time.Time `bson:"since,omitempty"`
+	Until time.Time `bson:"until,omitempty"`
+	// State keeps the issues and pull requests in a state: "open", "closed" or "all" (default)
+	State string `bson:"state,omitempty"`
+	// MaxItems caps the number of issues and, separately, of pull requests; 0 for no cap
+	MaxItems int `bson:"maxItems,omitempty"`
+	// Timeout bounds one attempt of the job; 0 for the server default
+	Timeout time.Duration `bson:"timeout,omitempty"`
+	// PerPage is the page size of issue and pull request lists; 0 for the server default
+	PerPage int `bson:"perPage,omitempty"`
+	// SavePolicy decides whether an issue or pull request that cannot be stored fails the job:
+	// SavePolicyFailFast or SavePolicyBestEffort (default)
+	SavePolicy string `bson:"savePolicy,omitempty"`
+}
+
+// Item states a job can be limited to
+const (
+	ItemStateOpen   = "open"
+	ItemStateClosed = "closed"
+	ItemStateAll    = "all"
+)
+
+// SameWork reports whether two jobs fetch the same data of the same repository;
+// priority, retry policy, timeout, page size and submission details do not count
+func (p ParsingJobParams) SameWork(other ParsingJobParams) bool {
+	jobType := func(params ParsingJobParams) string {
+		if params.JobType == "" {
+			return JobTypeParse
+		}
+		return params.JobType
+	}
+
+	return jobType(p) == jobType(other) &&
+		strings.EqualFold(p.OwnerName, other.OwnerName) &&
+		strings.EqualFold(p.RepoName, other.RepoName) &&
+		p.ParseIssues == other.ParseIssues &&
+		p.ParsePRs == other.ParsePRs &&
+		p.ParseUsers == other.ParseUsers &&
+		p.ParseContents == other.ParseContents &&
+		p.ParseSecurityAlerts == other.ParseSecurityAlerts &&
+		p.Since.Equal(other.Since) &&
+		p.Until.Equal(other.Until) &&
+		itemState(p) == itemState(other) &&
+		p.MaxItems == other.MaxItems
+}
+
+// itemState returns the item state of a job, all when unset
+func itemState(params ParsingJobParams) string {
+	if params.State == "" {
+		return ItemStateAll
+	}
+	return params.State
+}
+
+// BatchSummary aggregates the child jobs of a batch job
+type BatchSummary struct {
+	// JobType is the type of the child jobs
+	JobType string `bson:"jobType"`
+	// JobIDs lists the child jobs in the order of the submitted targets
+	JobIDs    []string `bson:"jobIds"`
+	Total     int      `bson:"total"`
+	Completed int      `bson:"completed"`
+	// Failed counts failed, dead-lettered and interrupted children
+	Failed    int `bson:"failed"`
+	Cancelled int `bson:"cancelled"`
+	// Running counts children that have not finished yet, queued ones included
+	Running int `bson:"running"`
+}
+
+// RetryPolicy controls automatic retries of a job after transient failures.
+// The wait before attempt n+1 is Backoff doubled n-1 times, at most MaxBackoff.
+type RetryPolicy struct {
+	// MaxAttempts includes the first attempt; 1 disables retries
+	MaxAttempts int           `bson:"maxAttempts"`
+	Backoff     time.Duration `bson:"backoff"`
+	MaxBackoff  time.Duration `bson:"maxBackoff"`
+}
+
+// Delay returns the wait after the given number of failed attempts
+func (p RetryPolicy) Delay(failures int) time.Duration {
+	delay := p.Backoff
+	for i := 1; i < failures; i++ {
+		delay *= 2
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+// JobCheckpoint records how far a paginated step got, so a resumed or retried job continues with the next page
+type JobCheckpoint struct {
+	Step string `bson:"step"`
+	// NextPage is the page to fetch next, 0 once the last page was saved
+	NextPage int `bson:"nextPage"`
+	// Items counts the objects saved from all pages so far
+	Items     int       `bson:"items"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
+
+// JobAttempt records one run of a job by a worker
+type JobAttempt struct {
+	Number     int        `bson:"number"`
+	StartedAt  time.Time  `bson:"startedAt"`
+	FinishedAt *time.Time `bson:"finishedAt"`
+	// Status is the job status the attempt ended with
+	Status string `bson:"status"`
+	// Step is the step that failed or was interrupted
+	Step  string `bson:"step"`
+	Error string `bson:"error"`
+}
+
+// JobTransition is a recorded change of a job status
+type JobTransition struct {
+	Status  string    `bson:"status"`
+	At      time.Time `bson:"at"`
+	Message string    `bson:"message"`
+}
+
+// JobResults counts the items a job fetched
+type JobResults struct {
+	Issues         int `bson:"issues"`
+	PullRequests   int `bson:"pullRequests"`
+	Users          int `bson:"users"`
+	Files          int `bson:"files"`
+	SecurityAlerts int `bson:"securityAlerts"`
+}
+
+// MaxSampleErrors is how many item errors a step keeps as samples
+const MaxSampleErrors = 5
+
+// JobStepResult records one run of a job step
+type JobStepResult struct {
+	Step   string `bson:"step"`
+	Status string `bson:"status"`
+	// Items is the number of objects the step fetched
+	Items int `bson:"items"`
+	// Total is the number of objects the step expects to fetch, 0 while unknown
+	Total      int        `bson:"total"`
+	Error      string     `bson:"error"`
+	StartedAt  time.Time  `bson:"startedAt"`
+	FinishedAt *time.Time `bson:"finishedAt"`
+	StepUsage  `bson:",inline"`
+}
+
+// StepUsage counts what a step stored and how much of the GitHub API it used
+type StepUsage struct {
+	Saved  int `bson:"saved"`
+	Failed int `bson:"failed"`
+	// Created, Updated and Unchanged split the saved issues and pull requests by what saving them changed
+	Created   int `bson:"created"`
+	Updated   int `bson:"updated"`
+	Unchanged int `bson:"unchanged"`
+	// SampleErrors keeps the first MaxSampleErrors errors of failed items,
+	// FailedItems the first MaxSampleErrors issues and pull requests that failed
+	SampleErrors []string      `bson:"sampleErrors"`
+	FailedItems  []ItemFailure `bson:"failedItems,omitempty"`
+	APICalls     int           `bson:"apiCalls"`
+	// RateLimitHits counts calls refused by a rate limit, RateLimitWait the backoff they caused
+	RateLimitHits int           `bson:"rateLimitHits"`
+	RateLimitWait time.Duration `bson:"rateLimitWait"`
+}
+
+// Add adds the counts of other, keeping at most MaxSampleErrors samples
+func (u *StepUsage) Add(other StepUsage) {
+	u.Saved += other.Saved
+	u.Failed += other.Failed
+	u.Created += other.Created
+	u.Updated += other.Updated
+	u.Unchanged += other.Unchanged
+	u.APICalls += other.APICalls
+	u.RateLimitHits += other.RateLimitHits
+	u.RateLimitWait += other.RateLimitWait
+	for _, sample := range other.SampleErrors {
+		u.AddSample(sample)
+	}
+	for _, failure := range other.FailedItems {
+		u.AddFailedItem(failure)
+	}
+}
+
+// AddFailedItem keeps a failed item unless MaxSampleErrors are kept already
+func (u *StepUsage) AddFailedItem(failure ItemFailure) {
+	if len(u.FailedItems) < MaxSampleErrors {
+		u.FailedItems = append(u.FailedItems, failure)
+	}
+}
+
+// AddSample keeps an item error unless MaxSampleErrors are kept already
+func (u *StepUsage) AddSample(message string) {
+	if len(u.SampleErrors) < MaxSampleErrors {
+		u.SampleErrors = append(u.SampleErrors, message)
+	}
+}
+
+// ParsingJob is an asynchronous parsing or reconcile run
+type ParsingJob struct {
+	ID           string           `bson:"id"`
+	Params       ParsingJobParams `bson:"params"`
+	Status       string           `bson:"status"`
+	Progress     int              `bson:"progress"` // 0-100
+	ErrorMessage string           `bson:"errorMessage"`
+	Transitions  []JobTransition  `bson:"transitions"`
+	Results      JobResults       `bson:"results"`
+	// CompletedSteps lists finished steps in order; a resumed job skips them
+	CompletedSteps []string `bson:"completedSteps"`
+	// Steps holds the latest run of every step the job started
+	Steps []JobStepResult `bson:"steps"`
+	// Checkpoints holds the page cursor of every paginated step
+	Checkpoints []JobCheckpoint `bson:"checkpoints"`
+	// Reconciliation holds the diff reported by a reconcile job
+	Reconciliation *ReconciliationResult `bson:"reconciliation"`
+	// Attempts counts how many times the job was started, including resumes after a restart
+	Attempts int `bson:"attempts"`
+	// FailedAttempts counts transient failures since the job was last queued by request, for the retry policy
+	FailedAttempts int          `bson:"failedAttempts"`
+	AttemptHistory []JobAttempt `bson:"attemptHistory"`
+	// NextAttemptAt is set while the job is retrying
+	NextAttemptAt *time.Time `bson:"nextAttemptAt"`
+	// Batch is set for batch jobs
+	Batch *BatchSummary `bson:"batch,omitempty"`
+	// LeaseOwner is the replica holding the job, LeaseExpiresAt is when another replica may take it over.
+	// Saving a job only sets them when it creates the job, afterwards they change through JobRepository.Claim and Release.
+	LeaseOwner     string     `bson:"leaseOwner"`
+	LeaseExpiresAt *time.Time `bson:"leaseExpiresAt"`
+	// StopRequest asks the lease owner to cancel or pause the job
+	StopRequest string `bson:"stopRequest,omitempty"`
+	// Callbacks records the deliveries to Params.CallbackURL; they change through JobRepository.SaveCallback only
+	Callbacks  []CallbackDelivery `bson:"callbacks"`
+	CreatedAt  time.Time          `bson:"createdAt"`
+	UpdatedAt  time.Time          `bson:"updatedAt"`
+	StartedAt  *time.Time         `bson:"startedAt"`
+	FinishedAt *time.Time         `bson:"finishedAt"`
+}
+
+// Finished reports whether the job reached a final status
+func (j *ParsingJob) Finished() bool {
+	return FinalJobStatus(j.Status)
+}
+
+// FinalJobStatus reports whether a job in status will not change anymore
+func FinalJobStatus(status string) bool {
+	switch status {
+	case JobStatusCompleted, JobStatusFailed, JobStatusInterrupted, JobStatusCancelled, JobStatusDeadLetter:
+		return true
+	}
+	return false
+}
+
+// Leased reports whether a replica holds the job at now
+func (j *ParsingJob) Leased(now time.Time) bool {
+	return j.LeaseOwner != "" && j.LeaseExpiresAt != nil && j.LeaseExpiresAt.After(now)
+}
+
+// StepCompleted reports whether the job already finished step
+func (j *ParsingJob) StepCompleted(step string) bool {
+	for _, completed := range j.CompletedSteps {
+		if completed == step {
+			return true
+		}
+	}
+	return false
+}
+
+// StartStep records that step started at the given time, replacing an earlier run of it.
+// A step resuming from its checkpoint goes on with the counts of the earlier run,
+// any other step starts over and only keeps adding up the API usage.
+func (j *ParsingJob) StartStep(step string, at time.Time) {
+	result := JobStepResult{Step: step, Status: StepStatusRunning, StartedAt: at}
+
+	previous := j.StepResult(step)
+	if previous == nil {
+		j.Steps = append(j.Steps, result)
+		return
+	}
+
+	if j.Checkpoint(step).NextPage > 1 {
+		result.Items = previous.Items
+		result.Total = previous.Total
+		result.StepUsage = previous.StepUsage
+	} else {
+		result.APICalls = previous.APICalls
+		result.RateLimitHits = previous.RateLimitHits
+		result.RateLimitWait = previous.RateLimitWait
+	}
+	*previous = result
+}
+
+// StepResult returns the latest run of step, nil when the job did not start it
+func (j *ParsingJob) StepResult(step string) *JobStepResult {
+	for i := range j.Steps {
+		if j.Steps[i].Step == step {
+			return &j.Steps[i]
+		}
+	}
+	return nil
+}
+
+// ComputeProgress estimates the done share of steps, 0-100, from item counts:
+// a step with a known total weighs its number of items, any other step weighs one item done once the step completes
+func (j *ParsingJob) ComputeProgress(steps []string) int {
+	done, total := 0, 0
+	for _, step := range steps {
+		completed := j.StepCompleted(step)
+
+		result := j.StepResult(step)
+		if result == nil || result.Total <= 0 {
+			total++
+			if completed {
+				done++
+			}
+			continue
+		}
+
+		total += result.Total
+		if completed {
+			done += result.Total
+		} else {
+			done += min(result.Items, result.Total)
+		}
+	}
+
+	if total == 0 {
+		return 0
+	}
+	return done * 100 / total
+}
+
+// FinishStep records the outcome of a started step
+func (j *ParsingJob) FinishStep(step, status string, items int, err error, at time.Time) {
+	for i := range j.Steps {
+		if j.Steps[i].Step != step {
+			continue
+		}
+		j.Steps[i].Status = status
+		j.Steps[i].Items = items
+		j.Steps[i].FinishedAt = &at
+		if err != nil {
+			j.Steps[i].Error = err.Error()
+		}
+		return
+	}
+}
+
+// Checkpoint returns the checkpoint of step; a step without one starts at page 1
+func (j *ParsingJob) Checkpoint(step string) JobCheckpoint {
+	for _, checkpoint := range j.Checkpoints {
+		if checkpoint.Step == step {
+			return checkpoint
+		}
+	}
+	return JobCheckpoint{Step: step, NextPage: 1}
+}
+
+// SetCheckpoint records the checkpoint of a step, replacing the previous one
+func (j *ParsingJob) SetCheckpoint(checkpoint JobCheckpoint) {
+	for i := range j.Checkpoints {
+		if j.Checkpoints[i].Step == checkpoint.Step {
+			j.Checkpoints[i] = checkpoint
+			return
+		}
+	}
+	j.Checkpoints = append(j.Checkpoints, checkpoint)
+}
+
+// StartAttempt records the start of a new attempt
+func (j *ParsingJob) StartAttempt(at time.Time) {
+	j.AttemptHistory = append(j.AttemptHistory, JobAttempt{Number: j.Attempts, StartedAt: at})
+}
+
+// FinishAttempt records how the running attempt ended
+func (j *ParsingJob) FinishAttempt(status, message string, at time.Time) {
+	if len(j.AttemptHistory) == 0 {
+		return
+	}
+
+	attempt := &j.AttemptHistory[len(j.AttemptHistory)-1]
+	if attempt.FinishedAt != nil {
+		return
+	}
+	attempt.FinishedAt = &at
+	attempt.Status = status
+	attempt.Error = message
+
+	for _, step := range j.Steps {
+		if step.Status != StepStatusCompleted {
+			attempt.Step = step.Step
+		}
+	}
+}
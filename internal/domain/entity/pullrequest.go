@@ -3,15 +3,18 @@ package entity
 import "time"
 
 type PullRequest struct {
-	ID           int64
-	Number       int
-	Title        string
-	Body         string
-	State        string
-	AuthorLogin  string
-	RepositoryID int64
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	MergedAt     *time.Time
-	ClosedAt     *time.Time
+	ID           int64      `bson:"id"`
+	Number       int        `bson:"number"`
+	Title        string     `bson:"title"`
+	Body         string     `bson:"body"`
+	State        string     `bson:"state"`
+	AuthorLogin  string     `bson:"authorLogin"`
+	RepositoryID int64      `bson:"repositoryID"`
+	HeadSHA      string     `bson:"headSHA"`
+	CI           CISummary  `bson:"ci"`
+	CreatedAt    time.Time  `bson:"createdAt"`
+	UpdatedAt    time.Time  `bson:"updatedAt"`
+	MergedAt     *time.Time `bson:"mergedAt"`
+	ClosedAt     *time.Time `bson:"closedAt"`
+	DeletedAt    *time.Time `bson:"deletedAt"` // set when the pull request vanished upstream
 }
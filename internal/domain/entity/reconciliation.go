@@ -0,0 +1,17 @@
+package entity
+
+// ReconciledItem is a stored issue or pull request that no longer exists in its repository
+type ReconciledItem struct {
+	Kind          string `bson:"kind"` // "issue", "pull_request"
+	ID            int64  `bson:"id"`
+	Number        int    `bson:"number"`
+	TransferredTo string `bson:"transferredTo"`
+}
+
+// ReconciliationResult is the diff between stored issues/PRs and GitHub for one repository
+type ReconciliationResult struct {
+	IssuesChecked       int              `bson:"issuesChecked"`
+	PullRequestsChecked int              `bson:"pullRequestsChecked"`
+	Deleted             []ReconciledItem `bson:"deleted"`
+	Transferred         []ReconciledItem `bson:"transferred"`
+}
@@ -3,16 +3,26 @@ package entity
 import "time"
 
 type Repository struct {
-	ID              int64
-	Name            string
-	FullName        string
-	Description     string
-	IsPrivate       bool
-	OwnerLogin      string
-	Language        string
-	StarsCount      int
-	ForksCount      int
-	OpenIssuesCount int
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	ID              int64              `bson:"id"`
+	Name            string             `bson:"name"`
+	FullName        string             `bson:"fullName"`
+	Description     string             `bson:"description"`
+	IsPrivate       bool               `bson:"isPrivate"`
+	OwnerLogin      string             `bson:"ownerLogin"`
+	Language        string             `bson:"language"`
+	StarsCount      int                `bson:"starsCount"`
+	ForksCount      int                `bson:"forksCount"`
+	OpenIssuesCount int                `bson:"openIssuesCount"`
+	CreatedAt       time.Time          `bson:"createdAt"`
+	UpdatedAt       time.Time          `bson:"updatedAt"`
+	Renames         []RepositoryRename `bson:"renames"`
+	DeletedAt       *time.Time         `bson:"deletedAt"`      // set when GitHub reports the repository as gone
+	DeletionReason  string             `bson:"deletionReason"` // "not_found", "unavailable_for_legal_reasons"
+}
+
+// RepositoryRename records a rename or transfer detected during sync
+type RepositoryRename struct {
+	FromFullName string    `bson:"fromFullName"`
+	ToFullName   string    `bson:"toFullName"`
+	DetectedAt   time.Time `bson:"detectedAt"`
 }
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// RepositoryFile is the raw content of a tracked file fetched through the contents API
+type RepositoryFile struct {
+	RepositoryID int64     `bson:"repositoryID"`
+	Path         string    `bson:"path"`
+	SHA          string    `bson:"sha"` // blob SHA, used to skip unchanged files
+	Size         int       `bson:"size"`
+	Content      string    `bson:"content"`
+	FetchedAt    time.Time `bson:"fetchedAt"`
+}
@@ -0,0 +1,82 @@
+package entity
+
+// SaveOutcome tells what saving an item changed in storage
+type SaveOutcome string
+
+const (
+	SaveCreated   SaveOutcome = "created"
+	SaveUpdated   SaveOutcome = "updated"
+	SaveUnchanged SaveOutcome = "unchanged"
+)
+
+// Policies for items that cannot be stored
+const (
+	// SavePolicyBestEffort records the failure and goes on with the next item (default)
+	SavePolicyBestEffort = "best_effort"
+	// SavePolicyFailFast stops at the first item that cannot be stored
+	SavePolicyFailFast = "fail_fast"
+)
+
+// MaxItemFailures is how many failed items a save result lists; Failed counts all of them
+const MaxItemFailures = 100
+
+// ItemFailure is an issue or pull request that could not be stored
+type ItemFailure struct {
+	ID     int64  `bson:"id"`
+	Number int    `bson:"number"`
+	Reason string `bson:"reason"`
+}
+
+// SaveResult counts what a parse call did with the items it fetched
+type SaveResult struct {
+	Created   int
+	Updated   int
+	Unchanged int
+	Failed    int
+	// Failures lists the first MaxItemFailures items that failed
+	Failures []ItemFailure
+}
+
+// Saved returns the number of items stored, whether or not they changed
+func (r *SaveResult) Saved() int {
+	return r.Created + r.Updated + r.Unchanged
+}
+
+// Fetched returns the number of items handled
+func (r *SaveResult) Fetched() int {
+	return r.Saved() + r.Failed
+}
+
+// Count records the outcome of a stored item
+func (r *SaveResult) Count(outcome SaveOutcome) {
+	switch outcome {
+	case SaveCreated:
+		r.Created++
+	case SaveUpdated:
+		r.Updated++
+	default:
+		r.Unchanged++
+	}
+}
+
+// Fail records an item that could not be stored
+func (r *SaveResult) Fail(failure ItemFailure) {
+	r.Failed++
+	if len(r.Failures) < MaxItemFailures {
+		r.Failures = append(r.Failures, failure)
+	}
+}
+
+// Add adds the counts of other, keeping at most MaxItemFailures failures
+func (r *SaveResult) Add(other SaveResult) {
+	r.Created += other.Created
+	r.Updated += other.Updated
+	r.Unchanged += other.Unchanged
+	r.Failed += other.Failed
+	for _, failure := range other.Failures {
+		if len(r.Failures) >= MaxItemFailures {
+			break
+		}
+		r.Failures = append(r.Failures, failure)
+	}
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// Results of a schedule run
+const (
+	ScheduleRunStarted = "started"
+	// ScheduleRunSkipped means the previous job for the same repository was still active
+	ScheduleRunSkipped = "skipped"
+	ScheduleRunFailed  = "failed"
+)
+
+// Schedule starts a parsing job for a repository on a cron expression or at a fixed interval
+type Schedule struct {
+	ID     string           `bson:"id"`
+	Params ParsingJobParams `bson:"params"`
+	// Cron is a five-field cron expression evaluated in UTC; Interval is used when it is empty
+	Cron     string        `bson:"cron"`
+	Interval time.Duration `bson:"interval"`
+	// LastRunAt, LastJobID and LastRunResult describe the latest due run
+	LastRunAt      *time.Time `bson:"lastRunAt"`
+	LastJobID      string     `bson:"lastJobId"`
+	LastRunResult  string     `bson:"lastRunResult"`
+	LastRunMessage string     `bson:"lastRunMessage"`
+	// NextRunAt is zero when the schedule will not run again
+	NextRunAt time.Time `bson:"nextRunAt"`
+	CreatedAt time.Time `bson:"createdAt"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+// Security alert sources
+const (
+	AlertSourceDependabot = "dependabot"
+	AlertSourceAdvisory   = "advisory"
+)
+
+// SecurityAlert is a Dependabot alert or a repository security advisory affecting one package
+type SecurityAlert struct {
+	RepositoryID       int64      `bson:"repositoryID"`
+	RepositoryFullName string     `bson:"repositoryFullName"`
+	Source             string     `bson:"source"` // "dependabot", "advisory"
+	Number             int        `bson:"number"` // Dependabot alert number, 0 for advisories
+	GHSAID             string     `bson:"ghsaID"`
+	CVEID              string     `bson:"cveID"`
+	Summary            string     `bson:"summary"`
+	Severity           string     `bson:"severity"` // "low", "medium", "high", "critical"
+	Ecosystem          string     `bson:"ecosystem"`
+	Package            string     `bson:"package"`
+	VulnerableRange    string     `bson:"vulnerableRange"`
+	PatchedVersion     string     `bson:"patchedVersion"`
+	State              string     `bson:"state"` // state as reported by GitHub
+	Open               bool       `bson:"open"`  // whether the alert still needs attention
+	URL                string     `bson:"url"`
+	CreatedAt          time.Time  `bson:"createdAt"`
+	UpdatedAt          time.Time  `bson:"updatedAt"`
+	FixedAt            *time.Time `bson:"fixedAt"`
+	DismissedAt        *time.Time `bson:"dismissedAt"`
+}
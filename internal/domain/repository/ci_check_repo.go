@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+)
+
+type CICheckRepository interface {
+	Save(ctx context.Context, check *entity.CICheck) error
+	ListByPullRequest(ctx context.Context, pullRequestID int64) ([]*entity.CICheck, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+)
+
+type CodeOwnerRepository interface {
+	// ReplaceForRepository replaces all stored CODEOWNERS rules of a repository
+	ReplaceForRepository(ctx context.Context, repoID int64, rules []*entity.CodeOwnerRule) error
+	ListByRepository(ctx context.Context, repoID int64) ([]*entity.CodeOwnerRule, error)
+}
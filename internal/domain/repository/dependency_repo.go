@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+)
+
+type DependencyFilter struct {
+	Name         string
+	Version      string
+	Ecosystem    string
+	RepositoryID int64
+	Limit        int
+	Offset       int
+}
+
+type DependencyRepository interface {
+	// ReplaceForManifest replaces all stored dependencies declared in one manifest of a repository
+	ReplaceForManifest(ctx context.Context, repoID int64, manifest string, deps []*entity.Dependency) error
+	List(ctx context.Context, filter DependencyFilter) ([]*entity.Dependency, error)
+}
@@ -2,18 +2,25 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
 )
 
 type IssueFilter struct {
 	RepositoryID int64
 	State        string
-	Limit        int
-	Offset       int
+	// IncludeDeleted also returns items that vanished upstream
+	IncludeDeleted bool
+	Limit          int
+	Offset         int
 }
 
 type IssueRepository interface {
-	Save(ctx context.Context, issue *entity.Issue) error
+	// Save inserts or replaces the issue with the same ID and tells whether that changed anything
+	Save(ctx context.Context, issue *entity.Issue) (entity.SaveOutcome, error)
 	FindByID(ctx context.Context, id int64) (*entity.Issue, error)
 	List(ctx context.Context, filter IssueFilter) ([]*entity.Issue, error)
+	MarkDeleted(ctx context.Context, id int64, deletedAt time.Time) error
+	MarkTransferred(ctx context.Context, id int64, transferredTo string, transferredAt time.Time) error
 }
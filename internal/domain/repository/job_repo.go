@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"time"
+)
+
+// ErrDuplicateJob is returned by Save when another job already has the idempotency key of a new job
+var ErrDuplicateJob = errors.New("duplicate job")
+
+// ErrLeaseLost is returned by Save when the stored job is no longer held by the lease owner of the saved copy
+var ErrLeaseLost = errors.New("job lease lost")
+
+type JobFilter struct {
+	// Statuses matches jobs in any of the given statuses, empty matches all
+	Statuses []string
+	// OwnerName and RepoName match regardless of case, as GitHub names do
+	OwnerName string
+	RepoName  string
+	// ParentID matches the child jobs of a batch
+	ParentID string
+	// JobType "parse" also matches jobs stored without a type
+	JobType string
+	// CreatedAfter and CreatedBefore bound the creation time, zero values leave the range open
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Limit         int
+	Offset        int
+}
+
+type JobRepository interface {
+	// Save stores a job. A stored job is only replaced while it has the lease owner of job, or none when job has none,
+	// so a replica whose lease was taken over cannot overwrite the job.
+	Save(ctx context.Context, job *entity.ParsingJob) error
+	FindByID(ctx context.Context, id string) (*entity.ParsingJob, error)
+	// FindByIdempotencyKey returns the job submitted with key, nil when there is none
+	FindByIdempotencyKey(ctx context.Context, key string) (*entity.ParsingJob, error)
+	// ListByStatus returns jobs in the given statuses, oldest first
+	ListByStatus(ctx context.Context, statuses ...string) ([]*entity.ParsingJob, error)
+	// List returns jobs matching filter, newest first
+	List(ctx context.Context, filter JobFilter) ([]*entity.ParsingJob, error)
+	// ListUnleased returns jobs in the given statuses that no replica holds at now, oldest first
+	ListUnleased(ctx context.Context, now time.Time, statuses ...string) ([]*entity.ParsingJob, error)
+	// Claim leases a job in one of the given statuses to owner until the given time. The job is claimed
+	// when it has no lease, its lease expired before now or owner already holds it, which renews the lease.
+	// It returns the claimed job, or nil when another owner holds it or it is in another status.
+	Claim(ctx context.Context, id, owner string, now, until time.Time, statuses ...string) (*entity.ParsingJob, error)
+	// Release drops the lease of owner on a job together with its stop request
+	Release(ctx context.Context, id, owner string) error
+	// RequestStop asks the owner of a job to stop it, stop is entity.JobStopCancel or entity.JobStopPause
+	RequestStop(ctx context.Context, id, stop string) error
+	// SaveCallback stores a callback delivery of a job, replacing the delivery with the same ID
+	SaveCallback(ctx context.Context, id string, delivery entity.CallbackDelivery) error
+	// ListPendingCallbacks returns jobs with a callback delivery still pending, oldest first
+	ListPendingCallbacks(ctx context.Context) ([]*entity.ParsingJob, error)
+}
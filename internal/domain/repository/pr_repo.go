@@ -2,19 +2,26 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
 )
 
 type PullRequestFilter struct {
 	RepositoryID int64
 	State        string // "open", "closed", "all"
-	Limit        int
-	Offset       int
+	CIState      string // "passed", "failed", "pending"
+	// IncludeDeleted also returns items that vanished upstream
+	IncludeDeleted bool
+	Limit          int
+	Offset         int
 }
 
 type PullRequestRepository interface {
-	Save(ctx context.Context, pr *entity.PullRequest) error
+	// Save inserts or replaces the pull request with the same ID and tells whether that changed anything
+	Save(ctx context.Context, pr *entity.PullRequest) (entity.SaveOutcome, error)
 	FindByID(ctx context.Context, id int64) (*entity.PullRequest, error)
 	FindByNumber(ctx context.Context, repoID int64, number int) (*entity.PullRequest, error)
 	List(ctx context.Context, filter PullRequestFilter) ([]*entity.PullRequest, error)
+	MarkDeleted(ctx context.Context, id int64, deletedAt time.Time) error
 }
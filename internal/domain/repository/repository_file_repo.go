@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+)
+
+type RepositoryFileRepository interface {
+	Save(ctx context.Context, file *entity.RepositoryFile) error
+	FindByPath(ctx context.Context, repoID int64, path string) (*entity.RepositoryFile, error)
+	// Delete removes the stored file at path; a file that is not stored is no error
+	Delete(ctx context.Context, repoID int64, path string) error
+}
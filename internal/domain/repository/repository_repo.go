@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
 )
 
@@ -9,13 +11,17 @@ type RepositoryFilter struct {
 	OwnerLogin string
 	Language   string
 	MinStars   int
-	Limit      int
-	Offset     int
+	// IncludeDeleted also returns tombstoned repositories
+	IncludeDeleted bool
+	Limit          int
+	Offset         int
 }
 
 type RepositoryRepository interface {
 	Save(ctx context.Context, repo *entity.Repository) error
 	FindByID(ctx context.Context, id int64) (*entity.Repository, error)
+	// FindByOwnerAndName also resolves names the repository had before a rename or transfer
 	FindByOwnerAndName(ctx context.Context, owner, name string) (*entity.Repository, error)
 	List(ctx context.Context, filter RepositoryFilter) ([]*entity.Repository, error)
+	MarkDeleted(ctx context.Context, id int64, deletedAt time.Time, reason string) error
 }
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"time"
+)
+
+type ScheduleRepository interface {
+	Save(ctx context.Context, schedule *entity.Schedule) error
+	FindByID(ctx context.Context, id string) (*entity.Schedule, error)
+	// List returns all schedules, oldest first
+	List(ctx context.Context) ([]*entity.Schedule, error)
+	// ListDue returns schedules whose next run is set and at or before now, earliest first
+	ListDue(ctx context.Context, now time.Time) ([]*entity.Schedule, error)
+	// ClaimRun moves the next run of a schedule from due to next and reports whether it did;
+	// it does nothing when another caller already claimed the run at due
+	ClaimRun(ctx context.Context, id string, due, next time.Time) (bool, error)
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+)
+
+type SecurityAlertFilter struct {
+	RepositoryID int64
+	Source       string
+	Severity     string
+	State        string
+	Limit        int
+	Offset       int
+}
+
+type SecurityAlertRepository interface {
+	Save(ctx context.Context, alert *entity.SecurityAlert) error
+	List(ctx context.Context, filter SecurityAlertFilter) ([]*entity.SecurityAlert, error)
+	// CountOpenByRepository returns the number of open alerts per repository ID
+	CountOpenByRepository(ctx context.Context) (map[int64]int, error)
+}
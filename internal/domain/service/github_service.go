@@ -2,12 +2,90 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"time"
+
 	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
 )
 
+// RepositoryGoneError is returned when GitHub reports a repository as deleted (404) or blocked (451)
+type RepositoryGoneError struct {
+	FullName string
+	Reason   string // "not_found", "unavailable_for_legal_reasons"
+	Err      error
+}
+
+func (e *RepositoryGoneError) Error() string {
+	return fmt.Sprintf("repository %s is gone (%s): %v", e.FullName, e.Reason, e.Err)
+}
+
+func (e *RepositoryGoneError) Unwrap() error {
+	return e.Err
+}
+
+// Page places a fetched page in a paginated list
+type Page struct {
+	// Next is the number of the next page, 0 after the last one
+	Next int
+	// Last is the number of the last page, 0 when GitHub does not report it, as on the last page itself
+	Last int
+}
+
+// ListOptions narrows the lists of issues and pull requests
+type ListOptions struct {
+	PerPage int
+	// State is "open", "closed" or "all"; empty means all
+	State string
+	// Since and Until keep the items last updated in [Since, Until); a zero time leaves that end open.
+	// With either set, lists run from the most recently updated item instead of the newest one.
+	Since time.Time
+	Until time.Time
+}
+
+// Windowed reports whether the options set a time window
+func (o ListOptions) Windowed() bool {
+	return !o.Since.IsZero() || !o.Until.IsZero()
+}
+
+// InWindow reports whether an item last updated at updatedAt falls in the time window
+func (o ListOptions) InWindow(updatedAt time.Time) bool {
+	return (o.Since.IsZero() || !updatedAt.Before(o.Since)) && (o.Until.IsZero() || updatedAt.Before(o.Until))
+}
+
+// RateLimit is the state of the primary rate limit of the token
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
 type GithubService interface {
+	// GetRepository follows rename and transfer redirects and returns *RepositoryGoneError for 404 and 451 responses
 	GetRepository(ctx context.Context, owner, name string) (*entity.Repository, error)
-	GetIssues(ctx context.Context, owner, repo string, page, perPage int) ([]*entity.Issue, error)
-	GetPullRequests(ctx context.Context, owner, repo string, page, perPage int) ([]*entity.PullRequest, error)
+	// GetIssues and GetPullRequests return one page and where it sits in the list;
+	// items outside the time window of opts are left out, so a page may hold fewer than opts.PerPage
+	// The items carry no RepositoryID: callers look the repository up once and set it
+	GetIssues(ctx context.Context, owner, repo string, page int, opts ListOptions) ([]*entity.Issue, Page, error)
+	GetPullRequests(ctx context.Context, owner, repo string, page int, opts ListOptions) ([]*entity.PullRequest, Page, error)
+	// CountIssues returns the number of issues in the state of opts, pull requests included as GetIssues lists them;
+	// CountPullRequests returns the number of pull requests. Both cost one request. GitHub filters issues
+	// by Since only and pull requests by neither, so the counts of a time window are upper bounds.
+	CountIssues(ctx context.Context, owner, repo string, opts ListOptions) (int, error)
+	CountPullRequests(ctx context.Context, owner, repo string, opts ListOptions) (int, error)
+	// GetRateLimit does not count against the rate limit
+	GetRateLimit(ctx context.Context) (*RateLimit, error)
 	GetUser(ctx context.Context, username string) (*entity.User, error)
+	// ListIssueNumbers and ListPullRequestNumbers return numbers of all issues or PRs in any state
+	ListIssueNumbers(ctx context.Context, owner, repo string) ([]int, error)
+	ListPullRequestNumbers(ctx context.Context, owner, repo string) ([]int, error)
+	// GetIssueLocation returns the full name of the repository the issue lives in now,
+	// or an empty string when the issue was deleted
+	GetIssueLocation(ctx context.Context, owner, repo string, number int) (string, error)
+	GetCIChecks(ctx context.Context, owner, repo, ref string) ([]*entity.CICheck, error)
+	// GetFileContent and GetReadme return nil without an error when the file does not exist
+	GetFileContent(ctx context.Context, owner, repo, path string) (*entity.RepositoryFile, error)
+	GetReadme(ctx context.Context, owner, repo string) (*entity.RepositoryFile, error)
+	// GetDependabotAlerts and GetSecurityAdvisories return nil without an error when the token has no access
+	GetDependabotAlerts(ctx context.Context, owner, repo string) ([]*entity.SecurityAlert, error)
+	GetSecurityAdvisories(ctx context.Context, owner, repo string) ([]*entity.SecurityAlert, error)
 }
@@ -2,32 +2,322 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
 	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+)
+
+// Job types
+const (
+	JobTypeParse     = entity.JobTypeParse
+	JobTypeReconcile = entity.JobTypeReconcile
+	JobTypeBatch     = entity.JobTypeBatch
 )
 
-type ParsingJobParams struct {
-	OwnerName   string
-	RepoName    string
-	ParseIssues bool
-	ParsePRs    bool
-	ParseUsers  bool
+var (
+	// ErrJobQueueFull is returned by StartParsingJob when no more jobs can be queued
+	ErrJobQueueFull = errors.New("parsing job queue is full")
+	ErrJobNotFound  = errors.New("job not found")
+	// ErrJobState is returned when a job cannot be cancelled, paused or resumed in its current status
+	ErrJobState = errors.New("invalid job state")
+	// ErrInvalidBatch is returned by StartBatchParsingJob for an empty, oversized or malformed target list
+	ErrInvalidBatch = errors.New("invalid batch")
+	// ErrIdempotencyConflict is returned by StartParsingJob when an idempotency key was used for a job doing other work
+	ErrIdempotencyConflict = errors.New("idempotency key conflict")
+	// ErrInvalidCallback is returned for a callback URL that is malformed or cannot be signed
+	ErrInvalidCallback = errors.New("invalid callback")
+	// ErrInvalidJobOptions is returned for a job with a malformed time window, state, cap, timeout, page size
+	// or save policy
+	ErrInvalidJobOptions = errors.New("invalid job options")
+)
+
+// MaxPerPage is the largest page size the GitHub API serves
+const MaxPerPage = 100
+
+// ValidateJobOptions checks the options narrowing what a job fetches
+func ValidateJobOptions(params ParsingJobParams) error {
+	switch {
+	case !params.Since.IsZero() && !params.Until.IsZero() && !params.Since.Before(params.Until):
+		return fmt.Errorf("%w: since must be before until", ErrInvalidJobOptions)
+	case params.State != "" && params.State != entity.ItemStateOpen && params.State != entity.ItemStateClosed && params.State != entity.ItemStateAll:
+		return fmt.Errorf("%w: unknown state %q", ErrInvalidJobOptions, params.State)
+	case params.MaxItems < 0:
+		return fmt.Errorf("%w: max items must not be negative", ErrInvalidJobOptions)
+	case params.Timeout < 0:
+		return fmt.Errorf("%w: timeout must not be negative", ErrInvalidJobOptions)
+	case params.PerPage < 0 || params.PerPage > MaxPerPage:
+		return fmt.Errorf("%w: page size must be between 1 and %d", ErrInvalidJobOptions, MaxPerPage)
+	}
+	return ValidateSavePolicy(params.SavePolicy)
+}
+
+// ValidateSavePolicy accepts the save policies and an empty policy for the default
+func ValidateSavePolicy(policy string) error {
+	switch policy {
+	case "", entity.SavePolicyBestEffort, entity.SavePolicyFailFast:
+		return nil
+	}
+	return fmt.Errorf("%w: unknown save policy %q", ErrInvalidJobOptions, policy)
+}
+
+// ValidateCallbackURL accepts absolute http and https URLs
+func ValidateCallbackURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCallback, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: %q is not an absolute http or https URL", ErrInvalidCallback, raw)
+	}
+	return nil
+}
+
+// ItemSaveError stops a parse with the fail-fast save policy at the first item that could not be stored
+type ItemSaveError struct {
+	Kind    string // "issue" or "PR"
+	Failure entity.ItemFailure
+	Err     error
 }
 
+func (e *ItemSaveError) Error() string {
+	return fmt.Sprintf("failed to save %s #%d: %v", e.Kind, e.Failure.Number, e.Err)
+}
+
+func (e *ItemSaveError) Unwrap() error {
+	return e.Err
+}
+
+// DuplicateJobError is returned by StartParsingJob with Dedupe while an unfinished job does the same work
+type DuplicateJobError struct {
+	JobID  string
+	Status string
+}
+
+func (e *DuplicateJobError) Error() string {
+	return fmt.Sprintf("job %s for the same repository and options is %s", e.JobID, e.Status)
+}
+
+// ParsingJobParams is stored with the job, so it lives in the entity package
+type ParsingJobParams = entity.ParsingJobParams
+
 type ParsingJobStatus struct {
 	ID           string
-	Status       string // "pending", "in_progress", "completed", "failed"
+	Params       ParsingJobParams
+	Status       string // "pending", "in_progress", "completed", "failed", "interrupted", "cancelled", "paused", "retrying", "dead_letter"
 	Progress     int    // 0-100
 	ErrorMessage string
 	CreatedAt    string
 	UpdatedAt    string
+	StartedAt    string
+	FinishedAt   string
+	Attempts     int
+	Results      entity.JobResults
+	// CompletedSteps shows how far the job got
+	CompletedSteps []string
+	// Steps holds the timing and item count of every step the job started
+	Steps []entity.JobStepResult
+	// Checkpoints shows the next page of every paginated step
+	Checkpoints []entity.JobCheckpoint
+	// Reconciliation is set for completed reconcile jobs
+	Reconciliation *entity.ReconciliationResult
+	// FailedAttempts and NextAttemptAt describe the retry state, AttemptHistory every run of the job
+	FailedAttempts int
+	NextAttemptAt  string
+	AttemptHistory []entity.JobAttempt
+	// Batch aggregates the children of a batch job; Children is only filled for a single batch job
+	Batch    *entity.BatchSummary
+	Children []BatchChild
+	// LeaseOwner is the replica holding the job until LeaseExpiresAt
+	LeaseOwner     string
+	LeaseExpiresAt string
+	// Callbacks shows the deliveries to the callback URL of the job
+	Callbacks []entity.CallbackDelivery
+}
+
+// BatchChild is the result of one repository of a batch job
+type BatchChild struct {
+	JobID        string
+	OwnerName    string
+	RepoName     string
+	Status       string
+	Progress     int
+	ErrorMessage string
+	Results      entity.JobResults
+}
+
+// ParsingJobReport tells what a job did: timing, item counts and API usage of every step it started
+type ParsingJobReport struct {
+	JobID        string
+	JobType      string
+	Status       string
+	Progress     int
+	ErrorMessage string
+	Attempts     int
+	StartedAt    string
+	FinishedAt   string
+	// Duration runs from the first start to the finish, or to now while the job is unfinished
+	Duration time.Duration
+	Steps    []entity.JobStepResult
+	// Items and Usage add up all steps, or all child jobs of a batch
+	Items int
+	Usage entity.StepUsage
+}
+
+// ParsingEstimate is the expected API cost of a job, worked out without running it
+type ParsingEstimate struct {
+	OwnerName string
+	RepoName  string
+	JobType   string
+	Steps     []StepEstimate
+	// Requests adds up the requests of all steps
+	Requests int
+	// RateLimit is the state of the primary rate limit when the estimate was made
+	RateLimit RateLimit
+	// Duration is the expected run time at the measured request latency,
+	// RateLimitWait the part of it spent waiting for the rate limit to reset
+	Duration      time.Duration
+	RateLimitWait time.Duration
+	// EstimateRequests is the number of requests the estimate itself made
+	EstimateRequests int
+}
+
+// StepEstimate is the expected size of one job step; Items and Pages are 0 where GitHub gives no count up front.
+// With a time window the issue and pull request counts are upper bounds, see GithubService.CountIssues.
+type StepEstimate struct {
+	Step     string
+	Items    int
+	Pages    int
+	Requests int
+}
+
+// EstimateDuration returns how long requests take at perRequest each when only rate.Remaining of them
+// can be made before rate.Reset, followed by rate.Limit per hour, and how much of that is spent waiting
+func EstimateDuration(requests int, perRequest time.Duration, rate RateLimit, now time.Time) (duration, wait time.Duration) {
+	duration = time.Duration(requests) * perRequest
+	if rate.Limit <= 0 || requests <= rate.Remaining {
+		return duration, 0
+	}
+
+	overflow := requests - rate.Remaining
+	if reset := rate.Reset.Sub(now); reset > 0 {
+		wait = reset
+	}
+	wait += time.Duration((overflow-1)/rate.Limit) * time.Hour
+	return duration + wait, wait
+}
+
+// NewParsingJobReport sums up the steps of a stored job; now ends the duration of an unfinished job
+func NewParsingJobReport(job *entity.ParsingJob, now time.Time) *ParsingJobReport {
+	report := &ParsingJobReport{
+		JobID:        job.ID,
+		JobType:      job.Params.JobType,
+		Status:       job.Status,
+		Progress:     job.Progress,
+		ErrorMessage: job.ErrorMessage,
+		Attempts:     job.Attempts,
+		Steps:        job.Steps,
+	}
+	if report.JobType == "" {
+		report.JobType = JobTypeParse
+	}
+
+	if job.StartedAt != nil {
+		report.StartedAt = job.StartedAt.Format(time.RFC3339)
+		end := now
+		if job.FinishedAt != nil {
+			end = *job.FinishedAt
+		}
+		report.Duration = end.Sub(*job.StartedAt)
+	}
+
+	if job.FinishedAt != nil {
+		report.FinishedAt = job.FinishedAt.Format(time.RFC3339)
+	}
+
+	for _, step := range job.Steps {
+		report.Items += step.Items
+		report.Usage.Add(step.StepUsage)
+	}
+
+	return report
+}
+
+// NewParsingJobStatus converts a stored job to the status reported to clients
+func NewParsingJobStatus(job *entity.ParsingJob) *ParsingJobStatus {
+	jobStatus := &ParsingJobStatus{
+		ID:             job.ID,
+		Params:         job.Params,
+		Status:         job.Status,
+		Progress:       job.Progress,
+		ErrorMessage:   job.ErrorMessage,
+		CreatedAt:      job.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      job.UpdatedAt.Format(time.RFC3339),
+		Attempts:       job.Attempts,
+		Results:        job.Results,
+		CompletedSteps: job.CompletedSteps,
+		Steps:          job.Steps,
+		Checkpoints:    job.Checkpoints,
+		Reconciliation: job.Reconciliation,
+		FailedAttempts: job.FailedAttempts,
+		AttemptHistory: job.AttemptHistory,
+		Batch:          job.Batch,
+		LeaseOwner:     job.LeaseOwner,
+		Callbacks:      job.Callbacks,
+	}
+
+	if job.StartedAt != nil {
+		jobStatus.StartedAt = job.StartedAt.Format(time.RFC3339)
+	}
+
+	if job.FinishedAt != nil {
+		jobStatus.FinishedAt = job.FinishedAt.Format(time.RFC3339)
+	}
+
+	if job.NextAttemptAt != nil {
+		jobStatus.NextAttemptAt = job.NextAttemptAt.Format(time.RFC3339)
+	}
+
+	if job.LeaseExpiresAt != nil {
+		jobStatus.LeaseExpiresAt = job.LeaseExpiresAt.Format(time.RFC3339)
+	}
+
+	return jobStatus
 }
 
 type ParserService interface {
 	ParseRepository(ctx context.Context, owner, name string) (*entity.Repository, error)
-	ParseIssues(ctx context.Context, owner, repo string) ([]*entity.Issue, error)
-	ParsePullRequests(ctx context.Context, owner, repo string) ([]*entity.PullRequest, error)
+	// ParseIssues and ParsePullRequests save the items of a repository page by page as they arrive and return
+	// what they stored. A non-nil onPage gets the stored items and the result of every page and stops the listing
+	// with an error. With the fail-fast save policy the first item that cannot be stored stops them
+	// with *ItemSaveError; the result so far is returned with any error.
+	ParseIssues(ctx context.Context, owner, repo, savePolicy string, onPage func([]*entity.Issue, entity.SaveResult) error) (*entity.SaveResult, error)
+	ParsePullRequests(ctx context.Context, owner, repo, savePolicy string, onPage func([]*entity.PullRequest, entity.SaveResult) error) (*entity.SaveResult, error)
 	ParseUser(ctx context.Context, username string) (*entity.User, error)
+	ParseContents(ctx context.Context, owner, repo string) ([]*entity.RepositoryFile, error)
+	ParseSecurityAlerts(ctx context.Context, owner, repo string) ([]*entity.SecurityAlert, error)
+	ReconcileRepository(ctx context.Context, owner, repo string) (*entity.ReconciliationResult, error)
 
+	// StartParsingJob queues a job and returns its ID; a submission repeating an idempotency key returns the job it created
 	StartParsingJob(ctx context.Context, params ParsingJobParams) (string, error)
+	// CheckCallback returns ErrInvalidCallback for a callback URL jobs cannot be delivered to,
+	// including any URL while no signing secret is configured; an empty URL is accepted
+	CheckCallback(callbackURL string) error
+	// StartBatchParsingJob starts a parent job with one child job per "owner/repo" target, all sharing params
+	StartBatchParsingJob(ctx context.Context, params ParsingJobParams, targets []string) (*ParsingJobStatus, error)
 	GetParsingJobStatus(ctx context.Context, jobID string) (*ParsingJobStatus, error)
+	// GetParsingJobReport returns the per step timing, item counts and API usage of a job
+	GetParsingJobReport(ctx context.Context, jobID string) (*ParsingJobReport, error)
+	// EstimateParsingJob works out the API cost of a job with params without running or storing it
+	EstimateParsingJob(ctx context.Context, params ParsingJobParams) (*ParsingEstimate, error)
+	ListParsingJobs(ctx context.Context, filter repository.JobFilter) ([]*ParsingJobStatus, error)
+	// WatchParsingJob streams job events until the job finishes or ctx is done
+	WatchParsingJob(ctx context.Context, jobID string) (<-chan entity.JobEvent, error)
+	CancelParsingJob(ctx context.Context, jobID string) (*ParsingJobStatus, error)
+	PauseParsingJob(ctx context.Context, jobID string) (*ParsingJobStatus, error)
+	ResumeParsingJob(ctx context.Context, jobID string) (*ParsingJobStatus, error)
+	RetryParsingJob(ctx context.Context, jobID string) (*ParsingJobStatus, error)
 }
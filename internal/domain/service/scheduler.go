@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+)
+
+var (
+	ErrScheduleNotFound = errors.New("schedule not found")
+	// ErrInvalidSchedule is returned for schedules without a usable cron expression or interval
+	ErrInvalidSchedule = errors.New("invalid schedule")
+)
+
+type SchedulerService interface {
+	// CreateSchedule stores a schedule running params on cron, or every interval when cron is empty
+	CreateSchedule(ctx context.Context, params ParsingJobParams, cron string, interval time.Duration) (*entity.Schedule, error)
+	ListSchedules(ctx context.Context) ([]*entity.Schedule, error)
+	DeleteSchedule(ctx context.Context, id string) error
+}
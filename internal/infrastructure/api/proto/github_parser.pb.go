@@ -119,14 +119,15 @@ func (x *ParseRepositoryResponse) GetRepository() *Repository {
 }
 
 type ListRepositoriesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OwnerLogin    string                 `protobuf:"bytes,1,opt,name=owner_login,json=ownerLogin,proto3" json:"owner_login,omitempty"`
-	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
-	MinStars      int32                  `protobuf:"varint,3,opt,name=min_stars,json=minStars,proto3" json:"min_stars,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OwnerLogin     string                 `protobuf:"bytes,1,opt,name=owner_login,json=ownerLogin,proto3" json:"owner_login,omitempty"`
+	Language       string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	MinStars       int32                  `protobuf:"varint,3,opt,name=min_stars,json=minStars,proto3" json:"min_stars,omitempty"`
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListRepositoriesRequest) Reset() {
@@ -194,6 +195,13 @@ func (x *ListRepositoriesRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListRepositoriesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type ListRepositoriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Repositories  []*Repository          `protobuf:"bytes,1,rep,name=repositories,proto3" json:"repositories,omitempty"`
@@ -247,21 +255,24 @@ func (x *ListRepositoriesResponse) GetTotalCount() int32 {
 }
 
 type Repository struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	FullName        string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	IsPrivate       bool                   `protobuf:"varint,5,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
-	OwnerLogin      string                 `protobuf:"bytes,6,opt,name=owner_login,json=ownerLogin,proto3" json:"owner_login,omitempty"`
-	Language        string                 `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
-	StarsCount      int32                  `protobuf:"varint,8,opt,name=stars_count,json=starsCount,proto3" json:"stars_count,omitempty"`
-	ForksCount      int32                  `protobuf:"varint,9,opt,name=forks_count,json=forksCount,proto3" json:"forks_count,omitempty"`
-	OpenIssuesCount int32                  `protobuf:"varint,10,opt,name=open_issues_count,json=openIssuesCount,proto3" json:"open_issues_count,omitempty"`
-	CreatedAt       string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FullName          string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IsPrivate         bool                   `protobuf:"varint,5,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	OwnerLogin        string                 `protobuf:"bytes,6,opt,name=owner_login,json=ownerLogin,proto3" json:"owner_login,omitempty"`
+	Language          string                 `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
+	StarsCount        int32                  `protobuf:"varint,8,opt,name=stars_count,json=starsCount,proto3" json:"stars_count,omitempty"`
+	ForksCount        int32                  `protobuf:"varint,9,opt,name=forks_count,json=forksCount,proto3" json:"forks_count,omitempty"`
+	OpenIssuesCount   int32                  `protobuf:"varint,10,opt,name=open_issues_count,json=openIssuesCount,proto3" json:"open_issues_count,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PreviousFullNames []string               `protobuf:"bytes,13,rep,name=previous_full_names,json=previousFullNames,proto3" json:"previous_full_names,omitempty"`
+	DeletedAt         string                 `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletionReason    string                 `protobuf:"bytes,15,opt,name=deletion_reason,json=deletionReason,proto3" json:"deletion_reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Repository) Reset() {
@@ -378,11 +389,33 @@ func (x *Repository) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Repository) GetPreviousFullNames() []string {
+	if x != nil {
+		return x.PreviousFullNames
+	}
+	return nil
+}
+
+func (x *Repository) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Repository) GetDeletionReason() string {
+	if x != nil {
+		return x.DeletionReason
+	}
+	return ""
+}
+
 // Запросы и ответы для работы с issues
 type ParseIssuesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	SavePolicy    string                 `protobuf:"bytes,3,opt,name=save_policy,json=savePolicy,proto3" json:"save_policy,omitempty"` // "best_effort" (по умолчанию) или "fail_fast": остановиться на первом несохранённом объекте
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -431,9 +464,18 @@ func (x *ParseIssuesRequest) GetRepo() string {
 	return ""
 }
 
+func (x *ParseIssuesRequest) GetSavePolicy() string {
+	if x != nil {
+		return x.SavePolicy
+	}
+	return ""
+}
+
+// Issues не возвращаются целиком: большой репозиторий не уместился бы в одном ответе
 type ParseIssuesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Result        *SaveResult            `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -468,26 +510,233 @@ func (*ParseIssuesResponse) Descriptor() ([]byte, []int) {
 	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ParseIssuesResponse) GetIssues() []*Issue {
+func (x *ParseIssuesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ParseIssuesResponse) GetResult() *SaveResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Страница сохранённых issues; count считает issues с начала парсинга, result — только эту страницу
+type IssuePage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Result        *SaveResult            `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssuePage) Reset() {
+	*x = IssuePage{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssuePage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuePage) ProtoMessage() {}
+
+func (x *IssuePage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuePage.ProtoReflect.Descriptor instead.
+func (*IssuePage) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IssuePage) GetIssues() []*Issue {
 	if x != nil {
 		return x.Issues
 	}
 	return nil
 }
 
-type ListIssuesRequest struct {
+func (x *IssuePage) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *IssuePage) GetResult() *SaveResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Что стало с полученными объектами: created, updated и unchanged сохранены, failed — нет.
+// failures перечисляет первые 100 несохранённых объектов
+type SaveResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RepositoryId  int64                  `protobuf:"varint,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
-	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged     int32                  `protobuf:"varint,3,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Failed        int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Failures      []*ItemFailure         `protobuf:"bytes,5,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveResult) Reset() {
+	*x = SaveResult{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveResult) ProtoMessage() {}
+
+func (x *SaveResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveResult.ProtoReflect.Descriptor instead.
+func (*SaveResult) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SaveResult) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *SaveResult) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *SaveResult) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *SaveResult) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *SaveResult) GetFailures() []*ItemFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type ItemFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Number        int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ItemFailure) Reset() {
+	*x = ItemFailure{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemFailure) ProtoMessage() {}
+
+func (x *ItemFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemFailure.ProtoReflect.Descriptor instead.
+func (*ItemFailure) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ItemFailure) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ItemFailure) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *ItemFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListIssuesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RepositoryId   int64                  `protobuf:"varint,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+	State          string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *ListIssuesRequest) Reset() {
 	*x = ListIssuesRequest{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[7]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +748,7 @@ func (x *ListIssuesRequest) String() string {
 func (*ListIssuesRequest) ProtoMessage() {}
 
 func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[7]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +761,7 @@ func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIssuesRequest.ProtoReflect.Descriptor instead.
 func (*ListIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{7}
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListIssuesRequest) GetRepositoryId() int64 {
@@ -543,6 +792,13 @@ func (x *ListIssuesRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListIssuesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type ListIssuesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
@@ -553,7 +809,7 @@ type ListIssuesResponse struct {
 
 func (x *ListIssuesResponse) Reset() {
 	*x = ListIssuesResponse{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[8]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -565,7 +821,7 @@ func (x *ListIssuesResponse) String() string {
 func (*ListIssuesResponse) ProtoMessage() {}
 
 func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[8]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,7 +834,7 @@ func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIssuesResponse.ProtoReflect.Descriptor instead.
 func (*ListIssuesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{8}
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListIssuesResponse) GetIssues() []*Issue {
@@ -607,13 +863,15 @@ type Issue struct {
 	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	ClosedAt      string                 `protobuf:"bytes,10,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	TransferredTo string                 `protobuf:"bytes,12,opt,name=transferred_to,json=transferredTo,proto3" json:"transferred_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Issue) Reset() {
 	*x = Issue{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[9]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -625,7 +883,7 @@ func (x *Issue) String() string {
 func (*Issue) ProtoMessage() {}
 
 func (x *Issue) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[9]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,7 +896,7 @@ func (x *Issue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Issue.ProtoReflect.Descriptor instead.
 func (*Issue) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{9}
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Issue) GetId() int64 {
@@ -711,18 +969,33 @@ func (x *Issue) GetClosedAt() string {
 	return ""
 }
 
+func (x *Issue) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetTransferredTo() string {
+	if x != nil {
+		return x.TransferredTo
+	}
+	return ""
+}
+
 // Запросы и ответы для работы с pull requests
 type ParsePullRequestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	Repo          string                 `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	SavePolicy    string                 `protobuf:"bytes,3,opt,name=save_policy,json=savePolicy,proto3" json:"save_policy,omitempty"` // "best_effort" (по умолчанию) или "fail_fast": остановиться на первом несохранённом объекте
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ParsePullRequestsRequest) Reset() {
 	*x = ParsePullRequestsRequest{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[10]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -734,7 +1007,7 @@ func (x *ParsePullRequestsRequest) String() string {
 func (*ParsePullRequestsRequest) ProtoMessage() {}
 
 func (x *ParsePullRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[10]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -747,7 +1020,7 @@ func (x *ParsePullRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParsePullRequestsRequest.ProtoReflect.Descriptor instead.
 func (*ParsePullRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{10}
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ParsePullRequestsRequest) GetOwner() string {
@@ -764,16 +1037,24 @@ func (x *ParsePullRequestsRequest) GetRepo() string {
 	return ""
 }
 
+func (x *ParsePullRequestsRequest) GetSavePolicy() string {
+	if x != nil {
+		return x.SavePolicy
+	}
+	return ""
+}
+
 type ParsePullRequestsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PullRequests  []*PullRequest         `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Result        *SaveResult            `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ParsePullRequestsResponse) Reset() {
 	*x = ParsePullRequestsResponse{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[11]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -785,7 +1066,7 @@ func (x *ParsePullRequestsResponse) String() string {
 func (*ParsePullRequestsResponse) ProtoMessage() {}
 
 func (x *ParsePullRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[11]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,41 +1079,48 @@ func (x *ParsePullRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParsePullRequestsResponse.ProtoReflect.Descriptor instead.
 func (*ParsePullRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{11}
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ParsePullRequestsResponse) GetPullRequests() []*PullRequest {
+func (x *ParsePullRequestsResponse) GetCount() int32 {
 	if x != nil {
-		return x.PullRequests
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ParsePullRequestsResponse) GetResult() *SaveResult {
+	if x != nil {
+		return x.Result
 	}
 	return nil
 }
 
-type ListPullRequestsRequest struct {
+// Страница сохранённых pull requests; count считает pull requests с начала парсинга, result — только эту страницу
+type PullRequestPage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RepositoryId  int64                  `protobuf:"varint,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
-	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	PullRequests  []*PullRequest         `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Result        *SaveResult            `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPullRequestsRequest) Reset() {
-	*x = ListPullRequestsRequest{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[12]
+func (x *PullRequestPage) Reset() {
+	*x = PullRequestPage{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPullRequestsRequest) String() string {
+func (x *PullRequestPage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPullRequestsRequest) ProtoMessage() {}
+func (*PullRequestPage) ProtoMessage() {}
 
-func (x *ListPullRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[12]
+func (x *PullRequestPage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,9 +1131,72 @@ func (x *ListPullRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPullRequestsRequest.ProtoReflect.Descriptor instead.
-func (*ListPullRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use PullRequestPage.ProtoReflect.Descriptor instead.
+func (*PullRequestPage) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PullRequestPage) GetPullRequests() []*PullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *PullRequestPage) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PullRequestPage) GetResult() *SaveResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListPullRequestsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RepositoryId   int64                  `protobuf:"varint,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+	State          string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	CiState        string                 `protobuf:"bytes,5,opt,name=ci_state,json=ciState,proto3" json:"ci_state,omitempty"` // "passed", "failed", "pending"
+	IncludeDeleted bool                   `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsRequest) Reset() {
+	*x = ListPullRequestsRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsRequest) ProtoMessage() {}
+
+func (x *ListPullRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListPullRequestsRequest) GetRepositoryId() int64 {
@@ -876,6 +1227,20 @@ func (x *ListPullRequestsRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListPullRequestsRequest) GetCiState() string {
+	if x != nil {
+		return x.CiState
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type ListPullRequestsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PullRequests  []*PullRequest         `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
@@ -886,7 +1251,7 @@ type ListPullRequestsResponse struct {
 
 func (x *ListPullRequestsResponse) Reset() {
 	*x = ListPullRequestsResponse{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[13]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -898,7 +1263,7 @@ func (x *ListPullRequestsResponse) String() string {
 func (*ListPullRequestsResponse) ProtoMessage() {}
 
 func (x *ListPullRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[13]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -911,7 +1276,7 @@ func (x *ListPullRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPullRequestsResponse.ProtoReflect.Descriptor instead.
 func (*ListPullRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{13}
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListPullRequestsResponse) GetPullRequests() []*PullRequest {
@@ -941,13 +1306,16 @@ type PullRequest struct {
 	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	MergedAt      string                 `protobuf:"bytes,10,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
 	ClosedAt      string                 `protobuf:"bytes,11,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	HeadSha       string                 `protobuf:"bytes,12,opt,name=head_sha,json=headSha,proto3" json:"head_sha,omitempty"`
+	Ci            *CISummary             `protobuf:"bytes,13,opt,name=ci,proto3" json:"ci,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PullRequest) Reset() {
 	*x = PullRequest{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[14]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -959,7 +1327,7 @@ func (x *PullRequest) String() string {
 func (*PullRequest) ProtoMessage() {}
 
 func (x *PullRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[14]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,7 +1340,7 @@ func (x *PullRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
 func (*PullRequest) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{14}
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PullRequest) GetId() int64 {
@@ -1052,6 +1420,96 @@ func (x *PullRequest) GetClosedAt() string {
 	return ""
 }
 
+func (x *PullRequest) GetHeadSha() string {
+	if x != nil {
+		return x.HeadSha
+	}
+	return ""
+}
+
+func (x *PullRequest) GetCi() *CISummary {
+	if x != nil {
+		return x.Ci
+	}
+	return nil
+}
+
+func (x *PullRequest) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+// Сводка CI для head-коммита pull request
+type CISummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"` // "passed", "failed", "pending" или пусто, если проверок нет
+	Passed        int32                  `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Pending       int32                  `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CISummary) Reset() {
+	*x = CISummary{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CISummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CISummary) ProtoMessage() {}
+
+func (x *CISummary) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CISummary.ProtoReflect.Descriptor instead.
+func (*CISummary) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CISummary) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CISummary) GetPassed() int32 {
+	if x != nil {
+		return x.Passed
+	}
+	return 0
+}
+
+func (x *CISummary) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *CISummary) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
 // Запросы и ответы для работы с пользователями
 type ParseUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1062,7 +1520,7 @@ type ParseUserRequest struct {
 
 func (x *ParseUserRequest) Reset() {
 	*x = ParseUserRequest{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[15]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1074,7 +1532,7 @@ func (x *ParseUserRequest) String() string {
 func (*ParseUserRequest) ProtoMessage() {}
 
 func (x *ParseUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[15]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1087,7 +1545,7 @@ func (x *ParseUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseUserRequest.ProtoReflect.Descriptor instead.
 func (*ParseUserRequest) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{15}
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ParseUserRequest) GetUsername() string {
@@ -1106,7 +1564,7 @@ type ParseUserResponse struct {
 
 func (x *ParseUserResponse) Reset() {
 	*x = ParseUserResponse{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[16]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1118,7 +1576,7 @@ func (x *ParseUserResponse) String() string {
 func (*ParseUserResponse) ProtoMessage() {}
 
 func (x *ParseUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[16]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1589,7 @@ func (x *ParseUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseUserResponse.ProtoReflect.Descriptor instead.
 func (*ParseUserResponse) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{16}
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ParseUserResponse) GetUser() *User {
@@ -1152,7 +1610,7 @@ type ListUsersRequest struct {
 
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[17]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1164,7 +1622,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[17]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1177,7 +1635,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{17}
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListUsersRequest) GetLogin() string {
@@ -1211,7 +1669,7 @@ type ListUsersResponse struct {
 
 func (x *ListUsersResponse) Reset() {
 	*x = ListUsersResponse{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[18]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1223,7 +1681,7 @@ func (x *ListUsersResponse) String() string {
 func (*ListUsersResponse) ProtoMessage() {}
 
 func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[18]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1694,7 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{18}
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListUsersResponse) GetUsers() []*User {
@@ -1271,7 +1729,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[19]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1283,7 +1741,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[19]
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,7 +1754,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{19}
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *User) GetId() int64 {
@@ -1369,33 +1827,34 @@ func (x *User) GetUpdatedAt() string {
 	return ""
 }
 
-// Запросы и ответы для работы с задачами парсинга
-type StartParsingJobRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	OwnerName         string                 `protobuf:"bytes,1,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
-	RepoName          string                 `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
-	ParseIssues       bool                   `protobuf:"varint,3,opt,name=parse_issues,json=parseIssues,proto3" json:"parse_issues,omitempty"`
-	ParsePullRequests bool                   `protobuf:"varint,4,opt,name=parse_pull_requests,json=parsePullRequests,proto3" json:"parse_pull_requests,omitempty"`
-	ParseUsers        bool                   `protobuf:"varint,5,opt,name=parse_users,json=parseUsers,proto3" json:"parse_users,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// Запросы и ответы для работы с зависимостями
+type ListDependenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Ecosystem     string                 `protobuf:"bytes,3,opt,name=ecosystem,proto3" json:"ecosystem,omitempty"` // "go", "npm", "pypi", "docker"
+	RepositoryId  int64                  `protobuf:"varint,4,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartParsingJobRequest) Reset() {
-	*x = StartParsingJobRequest{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[20]
+func (x *ListDependenciesRequest) Reset() {
+	*x = ListDependenciesRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartParsingJobRequest) String() string {
+func (x *ListDependenciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartParsingJobRequest) ProtoMessage() {}
+func (*ListDependenciesRequest) ProtoMessage() {}
 
-func (x *StartParsingJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[20]
+func (x *ListDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1406,68 +1865,76 @@ func (x *StartParsingJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartParsingJobRequest.ProtoReflect.Descriptor instead.
-func (*StartParsingJobRequest) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*ListDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *StartParsingJobRequest) GetOwnerName() string {
+func (x *ListDependenciesRequest) GetName() string {
 	if x != nil {
-		return x.OwnerName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *StartParsingJobRequest) GetRepoName() string {
+func (x *ListDependenciesRequest) GetVersion() string {
 	if x != nil {
-		return x.RepoName
+		return x.Version
 	}
 	return ""
 }
 
-func (x *StartParsingJobRequest) GetParseIssues() bool {
+func (x *ListDependenciesRequest) GetEcosystem() string {
 	if x != nil {
-		return x.ParseIssues
+		return x.Ecosystem
 	}
-	return false
+	return ""
 }
 
-func (x *StartParsingJobRequest) GetParsePullRequests() bool {
+func (x *ListDependenciesRequest) GetRepositoryId() int64 {
 	if x != nil {
-		return x.ParsePullRequests
+		return x.RepositoryId
 	}
-	return false
+	return 0
 }
 
-func (x *StartParsingJobRequest) GetParseUsers() bool {
+func (x *ListDependenciesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.ParseUsers
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-type StartParsingJobResponse struct {
+func (x *ListDependenciesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListDependenciesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Dependencies  []*Dependency          `protobuf:"bytes,1,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartParsingJobResponse) Reset() {
-	*x = StartParsingJobResponse{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[21]
+func (x *ListDependenciesResponse) Reset() {
+	*x = ListDependenciesResponse{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartParsingJobResponse) String() string {
+func (x *ListDependenciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartParsingJobResponse) ProtoMessage() {}
+func (*ListDependenciesResponse) ProtoMessage() {}
 
-func (x *StartParsingJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[21]
+func (x *ListDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1478,40 +1945,53 @@ func (x *StartParsingJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartParsingJobResponse.ProtoReflect.Descriptor instead.
-func (*StartParsingJobResponse) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*ListDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *StartParsingJobResponse) GetJobId() string {
+func (x *ListDependenciesResponse) GetDependencies() []*Dependency {
 	if x != nil {
-		return x.JobId
+		return x.Dependencies
 	}
-	return ""
+	return nil
 }
 
-type GetParsingJobStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListDependenciesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
 }
 
-func (x *GetParsingJobStatusRequest) Reset() {
-	*x = GetParsingJobStatusRequest{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[22]
+type Dependency struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RepositoryId       int64                  `protobuf:"varint,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+	RepositoryFullName string                 `protobuf:"bytes,2,opt,name=repository_full_name,json=repositoryFullName,proto3" json:"repository_full_name,omitempty"`
+	Manifest           string                 `protobuf:"bytes,3,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Ecosystem          string                 `protobuf:"bytes,4,opt,name=ecosystem,proto3" json:"ecosystem,omitempty"`
+	Name               string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Version            string                 `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	Scope              string                 `protobuf:"bytes,7,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Dependency) Reset() {
+	*x = Dependency{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetParsingJobStatusRequest) String() string {
+func (x *Dependency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetParsingJobStatusRequest) ProtoMessage() {}
+func (*Dependency) ProtoMessage() {}
 
-func (x *GetParsingJobStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[22]
+func (x *Dependency) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,45 +2002,3253 @@ func (x *GetParsingJobStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetParsingJobStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetParsingJobStatusRequest) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Dependency.ProtoReflect.Descriptor instead.
+func (*Dependency) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetParsingJobStatusRequest) GetJobId() string {
+func (x *Dependency) GetRepositoryId() int64 {
 	if x != nil {
-		return x.JobId
+		return x.RepositoryId
+	}
+	return 0
+}
+
+func (x *Dependency) GetRepositoryFullName() string {
+	if x != nil {
+		return x.RepositoryFullName
 	}
 	return ""
 }
 
-type GetParsingJobStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Progress      int32                  `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
+func (x *Dependency) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *Dependency) GetEcosystem() string {
+	if x != nil {
+		return x.Ecosystem
+	}
+	return ""
+}
+
+func (x *Dependency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Dependency) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Dependency) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// Запросы и ответы для работы с уязвимостями
+type ListSecurityAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepositoryId  int64                  `protobuf:"varint,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`     // "dependabot", "advisory"
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"` // "low", "medium", "high", "critical"
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecurityAlertsRequest) Reset() {
+	*x = ListSecurityAlertsRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecurityAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecurityAlertsRequest) ProtoMessage() {}
+
+func (x *ListSecurityAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecurityAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecurityAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListSecurityAlertsRequest) GetRepositoryId() int64 {
+	if x != nil {
+		return x.RepositoryId
+	}
+	return 0
+}
+
+func (x *ListSecurityAlertsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ListSecurityAlertsRequest) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ListSecurityAlertsRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListSecurityAlertsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSecurityAlertsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListSecurityAlertsResponse struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Alerts          []*SecurityAlert        `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	TotalCount      int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	OpenAlertCounts []*RepositoryAlertCount `protobuf:"bytes,3,rep,name=open_alert_counts,json=openAlertCounts,proto3" json:"open_alert_counts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListSecurityAlertsResponse) Reset() {
+	*x = ListSecurityAlertsResponse{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecurityAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecurityAlertsResponse) ProtoMessage() {}
+
+func (x *ListSecurityAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecurityAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecurityAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListSecurityAlertsResponse) GetAlerts() []*SecurityAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *ListSecurityAlertsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListSecurityAlertsResponse) GetOpenAlertCounts() []*RepositoryAlertCount {
+	if x != nil {
+		return x.OpenAlertCounts
+	}
+	return nil
+}
+
+type SecurityAlert struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RepositoryId       int64                  `protobuf:"varint,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+	RepositoryFullName string                 `protobuf:"bytes,2,opt,name=repository_full_name,json=repositoryFullName,proto3" json:"repository_full_name,omitempty"`
+	Source             string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Number             int32                  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	GhsaId             string                 `protobuf:"bytes,5,opt,name=ghsa_id,json=ghsaId,proto3" json:"ghsa_id,omitempty"`
+	CveId              string                 `protobuf:"bytes,6,opt,name=cve_id,json=cveId,proto3" json:"cve_id,omitempty"`
+	Summary            string                 `protobuf:"bytes,7,opt,name=summary,proto3" json:"summary,omitempty"`
+	Severity           string                 `protobuf:"bytes,8,opt,name=severity,proto3" json:"severity,omitempty"`
+	Ecosystem          string                 `protobuf:"bytes,9,opt,name=ecosystem,proto3" json:"ecosystem,omitempty"`
+	Package            string                 `protobuf:"bytes,10,opt,name=package,proto3" json:"package,omitempty"`
+	VulnerableRange    string                 `protobuf:"bytes,11,opt,name=vulnerable_range,json=vulnerableRange,proto3" json:"vulnerable_range,omitempty"`
+	PatchedVersion     string                 `protobuf:"bytes,12,opt,name=patched_version,json=patchedVersion,proto3" json:"patched_version,omitempty"`
+	State              string                 `protobuf:"bytes,13,opt,name=state,proto3" json:"state,omitempty"`
+	Url                string                 `protobuf:"bytes,14,opt,name=url,proto3" json:"url,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FixedAt            string                 `protobuf:"bytes,16,opt,name=fixed_at,json=fixedAt,proto3" json:"fixed_at,omitempty"`
+	DismissedAt        string                 `protobuf:"bytes,17,opt,name=dismissed_at,json=dismissedAt,proto3" json:"dismissed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SecurityAlert) Reset() {
+	*x = SecurityAlert{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityAlert) ProtoMessage() {}
+
+func (x *SecurityAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityAlert.ProtoReflect.Descriptor instead.
+func (*SecurityAlert) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SecurityAlert) GetRepositoryId() int64 {
+	if x != nil {
+		return x.RepositoryId
+	}
+	return 0
+}
+
+func (x *SecurityAlert) GetRepositoryFullName() string {
+	if x != nil {
+		return x.RepositoryFullName
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *SecurityAlert) GetGhsaId() string {
+	if x != nil {
+		return x.GhsaId
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetCveId() string {
+	if x != nil {
+		return x.CveId
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetEcosystem() string {
+	if x != nil {
+		return x.Ecosystem
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetVulnerableRange() string {
+	if x != nil {
+		return x.VulnerableRange
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetPatchedVersion() string {
+	if x != nil {
+		return x.PatchedVersion
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetFixedAt() string {
+	if x != nil {
+		return x.FixedAt
+	}
+	return ""
+}
+
+func (x *SecurityAlert) GetDismissedAt() string {
+	if x != nil {
+		return x.DismissedAt
+	}
+	return ""
+}
+
+type RepositoryAlertCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepositoryId  int64                  `protobuf:"varint,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+	OpenCount     int32                  `protobuf:"varint,2,opt,name=open_count,json=openCount,proto3" json:"open_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepositoryAlertCount) Reset() {
+	*x = RepositoryAlertCount{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepositoryAlertCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepositoryAlertCount) ProtoMessage() {}
+
+func (x *RepositoryAlertCount) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepositoryAlertCount.ProtoReflect.Descriptor instead.
+func (*RepositoryAlertCount) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RepositoryAlertCount) GetRepositoryId() int64 {
+	if x != nil {
+		return x.RepositoryId
+	}
+	return 0
+}
+
+func (x *RepositoryAlertCount) GetOpenCount() int32 {
+	if x != nil {
+		return x.OpenCount
+	}
+	return 0
+}
+
+// Запросы и ответы для работы с задачами парсинга
+type StartParsingJobRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	OwnerName           string                 `protobuf:"bytes,1,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	RepoName            string                 `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	ParseIssues         bool                   `protobuf:"varint,3,opt,name=parse_issues,json=parseIssues,proto3" json:"parse_issues,omitempty"`
+	ParsePullRequests   bool                   `protobuf:"varint,4,opt,name=parse_pull_requests,json=parsePullRequests,proto3" json:"parse_pull_requests,omitempty"`
+	ParseUsers          bool                   `protobuf:"varint,5,opt,name=parse_users,json=parseUsers,proto3" json:"parse_users,omitempty"`
+	ParseContents       bool                   `protobuf:"varint,6,opt,name=parse_contents,json=parseContents,proto3" json:"parse_contents,omitempty"`
+	ParseSecurityAlerts bool                   `protobuf:"varint,7,opt,name=parse_security_alerts,json=parseSecurityAlerts,proto3" json:"parse_security_alerts,omitempty"`
+	JobType             string                 `protobuf:"bytes,8,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"` // "parse" (по умолчанию), "reconcile"
+	Priority            int32                  `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`             // задачи с большим приоритетом выполняются раньше
+	Retry               *RetryPolicy           `protobuf:"bytes,10,opt,name=retry,proto3" json:"retry,omitempty"`                   // если не задана, используется политика сервера
+	// Ключ идемпотентности: повторный запрос с тем же ключом возвращает уже созданную задачу
+	// вместо новой; тот же ключ с другими параметрами — ошибка INVALID_ARGUMENT
+	IdempotencyKey string `protobuf:"bytes,11,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Отклонить задачу (ALREADY_EXISTS), если незавершённая задача для того же репозитория
+	// с теми же параметрами уже есть
+	Dedupe bool `protobuf:"varint,12,opt,name=dedupe,proto3" json:"dedupe,omitempty"`
+	// URL, на который после завершения, ошибки или отмены задачи уходит POST с JSON-отчётом.
+	// Запрос подписан: заголовок X-Parser-Signature-256 равен "sha256=" и hex HMAC-SHA256
+	// строки "<X-Parser-Timestamp>.<тело>" на секрете сервера (CALLBACK_SECRET).
+	// Без настроенного секрета задачи с callback_url отклоняются (INVALID_ARGUMENT)
+	CallbackUrl string `protobuf:"bytes,13,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	// Только оценить стоимость задачи в запросах к API и время выполнения при текущем
+	// лимите запросов: задача не создаётся, в базу ничего не пишется
+	DryRun        bool        `protobuf:"varint,14,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Options       *JobOptions `protobuf:"bytes,15,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartParsingJobRequest) Reset() {
+	*x = StartParsingJobRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartParsingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartParsingJobRequest) ProtoMessage() {}
+
+func (x *StartParsingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartParsingJobRequest.ProtoReflect.Descriptor instead.
+func (*StartParsingJobRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StartParsingJobRequest) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *StartParsingJobRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *StartParsingJobRequest) GetParseIssues() bool {
+	if x != nil {
+		return x.ParseIssues
+	}
+	return false
+}
+
+func (x *StartParsingJobRequest) GetParsePullRequests() bool {
+	if x != nil {
+		return x.ParsePullRequests
+	}
+	return false
+}
+
+func (x *StartParsingJobRequest) GetParseUsers() bool {
+	if x != nil {
+		return x.ParseUsers
+	}
+	return false
+}
+
+func (x *StartParsingJobRequest) GetParseContents() bool {
+	if x != nil {
+		return x.ParseContents
+	}
+	return false
+}
+
+func (x *StartParsingJobRequest) GetParseSecurityAlerts() bool {
+	if x != nil {
+		return x.ParseSecurityAlerts
+	}
+	return false
+}
+
+func (x *StartParsingJobRequest) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *StartParsingJobRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *StartParsingJobRequest) GetRetry() *RetryPolicy {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *StartParsingJobRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *StartParsingJobRequest) GetDedupe() bool {
+	if x != nil {
+		return x.Dedupe
+	}
+	return false
+}
+
+func (x *StartParsingJobRequest) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+func (x *StartParsingJobRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *StartParsingJobRequest) GetOptions() *JobOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// Что именно выбирать из задач и pull request'ов и как долго может идти одна попытка задачи
+type JobOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Окно по времени последнего обновления, RFC 3339: since включительно, until не включительно.
+	// С окном списки обходятся от последних обновлённых. Задача продолжается с сохранённого номера
+	// страницы, поэтому возобновлённая или повторённая задача с окном может пропустить объекты,
+	// обновлённые за время перерыва: их подберёт следующий запуск
+	Since          string `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Until          string `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	State          string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`                                          // "open", "closed", "all" (по умолчанию)
+	MaxItems       int32  `protobuf:"varint,4,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`                   // не больше стольких задач и стольких pull request'ов; 0 — без ограничения
+	TimeoutSeconds int32  `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // 0 — таймаут сервера (10 минут)
+	PerPage        int32  `protobuf:"varint,6,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`                      // размер страницы, 1–100; 0 — 100
+	SavePolicy     string `protobuf:"bytes,7,opt,name=save_policy,json=savePolicy,proto3" json:"save_policy,omitempty"`              // "best_effort" (по умолчанию) или "fail_fast": несохранённый объект прерывает шаг
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JobOptions) Reset() {
+	*x = JobOptions{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobOptions) ProtoMessage() {}
+
+func (x *JobOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobOptions.ProtoReflect.Descriptor instead.
+func (*JobOptions) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *JobOptions) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *JobOptions) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *JobOptions) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *JobOptions) GetMaxItems() int32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+func (x *JobOptions) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *JobOptions) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *JobOptions) GetSavePolicy() string {
+	if x != nil {
+		return x.SavePolicy
+	}
+	return ""
+}
+
+// Повтор задачи после временной ошибки (лимиты, ошибки 5xx, таймауты)
+type RetryPolicy struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaxAttempts       int32                  `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`          // 1 отключает повторы
+	BackoffSeconds    int32                  `protobuf:"varint,2,opt,name=backoff_seconds,json=backoffSeconds,proto3" json:"backoff_seconds,omitempty"` // задержка перед первым повтором, затем удваивается
+	MaxBackoffSeconds int32                  `protobuf:"varint,3,opt,name=max_backoff_seconds,json=maxBackoffSeconds,proto3" json:"max_backoff_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoffSeconds() int32 {
+	if x != nil {
+		return x.BackoffSeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxBackoffSeconds() int32 {
+	if x != nil {
+		return x.MaxBackoffSeconds
+	}
+	return 0
+}
+
+type StartParsingJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // пусто при dry_run
+	Estimate      *ParsingEstimate       `protobuf:"bytes,2,opt,name=estimate,proto3" json:"estimate,omitempty"`        // задан только при dry_run
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartParsingJobResponse) Reset() {
+	*x = StartParsingJobResponse{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartParsingJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartParsingJobResponse) ProtoMessage() {}
+
+func (x *StartParsingJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartParsingJobResponse.ProtoReflect.Descriptor instead.
+func (*StartParsingJobResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StartParsingJobResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *StartParsingJobResponse) GetEstimate() *ParsingEstimate {
+	if x != nil {
+		return x.Estimate
+	}
+	return nil
+}
+
+// Оценка стоимости задачи. Размеры списков берутся из заголовков Link при per_page=1,
+// поэтому сама оценка стоит несколько запросов (estimate_requests)
+type ParsingEstimate struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OwnerName          string                 `protobuf:"bytes,1,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	RepoName           string                 `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	JobType            string                 `protobuf:"bytes,3,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Steps              []*StepEstimate        `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	Requests           int32                  `protobuf:"varint,5,opt,name=requests,proto3" json:"requests,omitempty"` // всего запросов по всем шагам
+	RateLimit          int32                  `protobuf:"varint,6,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	RateLimitRemaining int32                  `protobuf:"varint,7,opt,name=rate_limit_remaining,json=rateLimitRemaining,proto3" json:"rate_limit_remaining,omitempty"`
+	RateLimitReset     string                 `protobuf:"bytes,8,opt,name=rate_limit_reset,json=rateLimitReset,proto3" json:"rate_limit_reset,omitempty"`
+	// Ожидаемое время выполнения по задержке запросов самой оценки,
+	// включая ожидание сброса лимита (rate_limit_wait_ms)
+	DurationMs       int64 `protobuf:"varint,9,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	RateLimitWaitMs  int64 `protobuf:"varint,10,opt,name=rate_limit_wait_ms,json=rateLimitWaitMs,proto3" json:"rate_limit_wait_ms,omitempty"`
+	EstimateRequests int32 `protobuf:"varint,11,opt,name=estimate_requests,json=estimateRequests,proto3" json:"estimate_requests,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ParsingEstimate) Reset() {
+	*x = ParsingEstimate{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParsingEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsingEstimate) ProtoMessage() {}
+
+func (x *ParsingEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsingEstimate.ProtoReflect.Descriptor instead.
+func (*ParsingEstimate) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ParsingEstimate) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *ParsingEstimate) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *ParsingEstimate) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *ParsingEstimate) GetSteps() []*StepEstimate {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *ParsingEstimate) GetRequests() int32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *ParsingEstimate) GetRateLimit() int32 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+func (x *ParsingEstimate) GetRateLimitRemaining() int32 {
+	if x != nil {
+		return x.RateLimitRemaining
+	}
+	return 0
+}
+
+func (x *ParsingEstimate) GetRateLimitReset() string {
+	if x != nil {
+		return x.RateLimitReset
+	}
+	return ""
+}
+
+func (x *ParsingEstimate) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ParsingEstimate) GetRateLimitWaitMs() int64 {
+	if x != nil {
+		return x.RateLimitWaitMs
+	}
+	return 0
+}
+
+func (x *ParsingEstimate) GetEstimateRequests() int32 {
+	if x != nil {
+		return x.EstimateRequests
+	}
+	return 0
+}
+
+// Оценка одного шага; items и pages равны 0, если GitHub не сообщает размер заранее.
+// С окном по времени числа задач и pull request'ов — оценки сверху
+type StepEstimate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          string                 `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	Items         int32                  `protobuf:"varint,2,opt,name=items,proto3" json:"items,omitempty"`
+	Pages         int32                  `protobuf:"varint,3,opt,name=pages,proto3" json:"pages,omitempty"`
+	Requests      int32                  `protobuf:"varint,4,opt,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepEstimate) Reset() {
+	*x = StepEstimate{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepEstimate) ProtoMessage() {}
+
+func (x *StepEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepEstimate.ProtoReflect.Descriptor instead.
+func (*StepEstimate) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StepEstimate) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *StepEstimate) GetItems() int32 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *StepEstimate) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *StepEstimate) GetRequests() int32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+// Пакетная задача: родительская задача и по дочерней задаче на каждый репозиторий
+// с общими параметрами. Репозитории задаются списком "owner/repo" и/или файлом,
+// где они разделены переводами строк, пробелами или запятыми; строки с "#" — комментарии.
+type StartBatchParsingJobRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Targets             []string               `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	TargetsFile         []byte                 `protobuf:"bytes,2,opt,name=targets_file,json=targetsFile,proto3" json:"targets_file,omitempty"`
+	ParseIssues         bool                   `protobuf:"varint,3,opt,name=parse_issues,json=parseIssues,proto3" json:"parse_issues,omitempty"`
+	ParsePullRequests   bool                   `protobuf:"varint,4,opt,name=parse_pull_requests,json=parsePullRequests,proto3" json:"parse_pull_requests,omitempty"`
+	ParseUsers          bool                   `protobuf:"varint,5,opt,name=parse_users,json=parseUsers,proto3" json:"parse_users,omitempty"`
+	ParseContents       bool                   `protobuf:"varint,6,opt,name=parse_contents,json=parseContents,proto3" json:"parse_contents,omitempty"`
+	ParseSecurityAlerts bool                   `protobuf:"varint,7,opt,name=parse_security_alerts,json=parseSecurityAlerts,proto3" json:"parse_security_alerts,omitempty"`
+	JobType             string                 `protobuf:"bytes,8,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"` // тип дочерних задач: "parse" (по умолчанию), "reconcile"
+	Priority            int32                  `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	Retry               *RetryPolicy           `protobuf:"bytes,10,opt,name=retry,proto3" json:"retry,omitempty"`
+	Options             *JobOptions            `protobuf:"bytes,11,opt,name=options,proto3" json:"options,omitempty"` // для всех дочерних задач
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *StartBatchParsingJobRequest) Reset() {
+	*x = StartBatchParsingJobRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartBatchParsingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBatchParsingJobRequest) ProtoMessage() {}
+
+func (x *StartBatchParsingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBatchParsingJobRequest.ProtoReflect.Descriptor instead.
+func (*StartBatchParsingJobRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StartBatchParsingJobRequest) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *StartBatchParsingJobRequest) GetTargetsFile() []byte {
+	if x != nil {
+		return x.TargetsFile
+	}
+	return nil
+}
+
+func (x *StartBatchParsingJobRequest) GetParseIssues() bool {
+	if x != nil {
+		return x.ParseIssues
+	}
+	return false
+}
+
+func (x *StartBatchParsingJobRequest) GetParsePullRequests() bool {
+	if x != nil {
+		return x.ParsePullRequests
+	}
+	return false
+}
+
+func (x *StartBatchParsingJobRequest) GetParseUsers() bool {
+	if x != nil {
+		return x.ParseUsers
+	}
+	return false
+}
+
+func (x *StartBatchParsingJobRequest) GetParseContents() bool {
+	if x != nil {
+		return x.ParseContents
+	}
+	return false
+}
+
+func (x *StartBatchParsingJobRequest) GetParseSecurityAlerts() bool {
+	if x != nil {
+		return x.ParseSecurityAlerts
+	}
+	return false
+}
+
+func (x *StartBatchParsingJobRequest) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *StartBatchParsingJobRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *StartBatchParsingJobRequest) GetRetry() *RetryPolicy {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *StartBatchParsingJobRequest) GetOptions() *JobOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type StartBatchParsingJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	ChildJobIds   []string               `protobuf:"bytes,2,rep,name=child_job_ids,json=childJobIds,proto3" json:"child_job_ids,omitempty"` // в порядке репозиториев запроса, без повторов
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartBatchParsingJobResponse) Reset() {
+	*x = StartBatchParsingJobResponse{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartBatchParsingJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBatchParsingJobResponse) ProtoMessage() {}
+
+func (x *StartBatchParsingJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBatchParsingJobResponse.ProtoReflect.Descriptor instead.
+func (*StartBatchParsingJobResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StartBatchParsingJobResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *StartBatchParsingJobResponse) GetChildJobIds() []string {
+	if x != nil {
+		return x.ChildJobIds
+	}
+	return nil
+}
+
+type GetParsingJobStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetParsingJobStatusRequest) Reset() {
+	*x = GetParsingJobStatusRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetParsingJobStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParsingJobStatusRequest) ProtoMessage() {}
+
+func (x *GetParsingJobStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParsingJobStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetParsingJobStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetParsingJobStatusRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetParsingJobStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Progress       int32                  `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	ErrorMessage   string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Reconciliation *ReconciliationResult  `protobuf:"bytes,7,opt,name=reconciliation,proto3" json:"reconciliation,omitempty"`
+	StartedAt      string                 `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt     string                 `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// Сколько раз задача запускалась, включая возобновления после перезапуска
+	Attempts int32       `protobuf:"varint,10,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Results  *JobResults `protobuf:"bytes,11,opt,name=results,proto3" json:"results,omitempty"`
+	// Завершённые шаги задачи; при возобновлении они пропускаются
+	CompletedSteps []string          `protobuf:"bytes,12,rep,name=completed_steps,json=completedSteps,proto3" json:"completed_steps,omitempty"`
+	Params         *ParsingJobParams `protobuf:"bytes,13,opt,name=params,proto3" json:"params,omitempty"`
+	Steps          []*JobStepResult  `protobuf:"bytes,14,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Для задач в статусе "retrying": время следующей попытки
+	NextAttemptAt  string        `protobuf:"bytes,15,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	AttemptHistory []*JobAttempt `protobuf:"bytes,16,rep,name=attempt_history,json=attemptHistory,proto3" json:"attempt_history,omitempty"`
+	// Неудачные попытки с момента последнего запуска задачи
+	FailedAttempts int32 `protobuf:"varint,17,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	// Для пакетных задач: сводка по дочерним задачам
+	Batch *BatchSummary `protobuf:"bytes,18,opt,name=batch,proto3" json:"batch,omitempty"`
+	// Результаты дочерних задач; заполняются только в GetParsingJobStatus
+	Children []*BatchChild `protobuf:"bytes,19,rep,name=children,proto3" json:"children,omitempty"`
+	// Курсоры постраничных шагов; после перезапуска, повтора или возобновления
+	// задача продолжает со следующей страницы
+	Checkpoints []*JobCheckpoint `protobuf:"bytes,20,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	// Реплика, которая держит задачу (в очереди, в работе или в ожидании повтора),
+	// и время, после которого задачу может забрать другая реплика
+	LeaseOwner     string `protobuf:"bytes,21,opt,name=lease_owner,json=leaseOwner,proto3" json:"lease_owner,omitempty"`
+	LeaseExpiresAt string `protobuf:"bytes,22,opt,name=lease_expires_at,json=leaseExpiresAt,proto3" json:"lease_expires_at,omitempty"`
+	// Доставки уведомлений на callback_url с попытками
+	Callbacks     []*CallbackDelivery `protobuf:"bytes,23,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetParsingJobStatusResponse) Reset() {
+	*x = GetParsingJobStatusResponse{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetParsingJobStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParsingJobStatusResponse) ProtoMessage() {}
+
+func (x *GetParsingJobStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParsingJobStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetParsingJobStatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetParsingJobStatusResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetParsingJobStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetParsingJobStatusResponse) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *GetParsingJobStatusResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *GetParsingJobStatusResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetParsingJobStatusResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *GetParsingJobStatusResponse) GetReconciliation() *ReconciliationResult {
+	if x != nil {
+		return x.Reconciliation
+	}
+	return nil
+}
+
+func (x *GetParsingJobStatusResponse) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *GetParsingJobStatusResponse) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *GetParsingJobStatusResponse) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *GetParsingJobStatusResponse) GetResults() *JobResults {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GetParsingJobStatusResponse) GetCompletedSteps() []string {
+	if x != nil {
+		return x.CompletedSteps
+	}
+	return nil
+}
+
+func (x *GetParsingJobStatusResponse) GetParams() *ParsingJobParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GetParsingJobStatusResponse) GetSteps() []*JobStepResult {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *GetParsingJobStatusResponse) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *GetParsingJobStatusResponse) GetAttemptHistory() []*JobAttempt {
+	if x != nil {
+		return x.AttemptHistory
+	}
+	return nil
+}
+
+func (x *GetParsingJobStatusResponse) GetFailedAttempts() int32 {
+	if x != nil {
+		return x.FailedAttempts
+	}
+	return 0
+}
+
+func (x *GetParsingJobStatusResponse) GetBatch() *BatchSummary {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *GetParsingJobStatusResponse) GetChildren() []*BatchChild {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *GetParsingJobStatusResponse) GetCheckpoints() []*JobCheckpoint {
+	if x != nil {
+		return x.Checkpoints
+	}
+	return nil
+}
+
+func (x *GetParsingJobStatusResponse) GetLeaseOwner() string {
+	if x != nil {
+		return x.LeaseOwner
+	}
+	return ""
+}
+
+func (x *GetParsingJobStatusResponse) GetLeaseExpiresAt() string {
+	if x != nil {
+		return x.LeaseExpiresAt
+	}
+	return ""
+}
+
+func (x *GetParsingJobStatusResponse) GetCallbacks() []*CallbackDelivery {
+	if x != nil {
+		return x.Callbacks
+	}
+	return nil
+}
+
+// Доставка уведомления о завершении задачи
+type CallbackDelivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`       // передаётся в заголовке X-Parser-Delivery
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"` // "job.completed", "job.failed", "job.cancelled"
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "pending", "delivered", "failed"
+	Attempts      []*CallbackAttempt     `protobuf:"bytes,5,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"` // причина неудачи, если получатель не ответил на последнюю попытку
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallbackDelivery) Reset() {
+	*x = CallbackDelivery{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallbackDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallbackDelivery) ProtoMessage() {}
+
+func (x *CallbackDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallbackDelivery.ProtoReflect.Descriptor instead.
+func (*CallbackDelivery) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CallbackDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CallbackDelivery) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *CallbackDelivery) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CallbackDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CallbackDelivery) GetAttempts() []*CallbackAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *CallbackDelivery) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CallbackDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Одна попытка доставки уведомления
+type CallbackAttempt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	At            string                 `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // 0, если ответ не получен
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallbackAttempt) Reset() {
+	*x = CallbackAttempt{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallbackAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallbackAttempt) ProtoMessage() {}
+
+func (x *CallbackAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallbackAttempt.ProtoReflect.Descriptor instead.
+func (*CallbackAttempt) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CallbackAttempt) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *CallbackAttempt) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *CallbackAttempt) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CallbackAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CallbackAttempt) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type JobCheckpoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          string                 `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	NextPage      int32                  `protobuf:"varint,2,opt,name=next_page,json=nextPage,proto3" json:"next_page,omitempty"` // 0, если последняя страница уже сохранена
+	Items         int32                  `protobuf:"varint,3,opt,name=items,proto3" json:"items,omitempty"`                       // сохранено объектов со всех страниц
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobCheckpoint) Reset() {
+	*x = JobCheckpoint{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobCheckpoint) ProtoMessage() {}
+
+func (x *JobCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobCheckpoint.ProtoReflect.Descriptor instead.
+func (*JobCheckpoint) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *JobCheckpoint) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *JobCheckpoint) GetNextPage() int32 {
+	if x != nil {
+		return x.NextPage
+	}
+	return 0
+}
+
+func (x *JobCheckpoint) GetItems() int32 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *JobCheckpoint) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type BatchSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobType       string                 `protobuf:"bytes,1,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Completed     int32                  `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed        int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"` // включая "dead_letter" и "interrupted"
+	Cancelled     int32                  `protobuf:"varint,5,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Running       int32                  `protobuf:"varint,6,opt,name=running,proto3" json:"running,omitempty"` // ещё не завершённые, включая ожидающие в очереди
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSummary) Reset() {
+	*x = BatchSummary{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSummary) ProtoMessage() {}
+
+func (x *BatchSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSummary.ProtoReflect.Descriptor instead.
+func (*BatchSummary) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BatchSummary) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *BatchSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BatchSummary) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *BatchSummary) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchSummary) GetCancelled() int32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *BatchSummary) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+type BatchChild struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	OwnerName     string                 `protobuf:"bytes,2,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	RepoName      string                 `protobuf:"bytes,3,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Progress      int32                  `protobuf:"varint,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Results       *JobResults            `protobuf:"bytes,7,opt,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchChild) Reset() {
+	*x = BatchChild{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchChild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchChild) ProtoMessage() {}
+
+func (x *BatchChild) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchChild.ProtoReflect.Descriptor instead.
+func (*BatchChild) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BatchChild) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *BatchChild) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *BatchChild) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *BatchChild) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BatchChild) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *BatchChild) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *BatchChild) GetResults() *JobResults {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Один запуск задачи обработчиком
+type JobAttempt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    string                 `protobuf:"bytes,3,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // статус задачи после попытки
+	Step          string                 `protobuf:"bytes,5,opt,name=step,proto3" json:"step,omitempty"`     // шаг, на котором попытка завершилась ошибкой
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobAttempt) Reset() {
+	*x = JobAttempt{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobAttempt) ProtoMessage() {}
+
+func (x *JobAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobAttempt.ProtoReflect.Descriptor instead.
+func (*JobAttempt) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *JobAttempt) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *JobAttempt) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *JobAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Параметры, с которыми была запущена задача
+type ParsingJobParams struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	JobType             string                 `protobuf:"bytes,1,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	OwnerName           string                 `protobuf:"bytes,2,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	RepoName            string                 `protobuf:"bytes,3,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	ParseIssues         bool                   `protobuf:"varint,4,opt,name=parse_issues,json=parseIssues,proto3" json:"parse_issues,omitempty"`
+	ParsePullRequests   bool                   `protobuf:"varint,5,opt,name=parse_pull_requests,json=parsePullRequests,proto3" json:"parse_pull_requests,omitempty"`
+	ParseUsers          bool                   `protobuf:"varint,6,opt,name=parse_users,json=parseUsers,proto3" json:"parse_users,omitempty"`
+	ParseContents       bool                   `protobuf:"varint,7,opt,name=parse_contents,json=parseContents,proto3" json:"parse_contents,omitempty"`
+	ParseSecurityAlerts bool                   `protobuf:"varint,8,opt,name=parse_security_alerts,json=parseSecurityAlerts,proto3" json:"parse_security_alerts,omitempty"`
+	Priority            int32                  `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	ScheduleId          string                 `protobuf:"bytes,10,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"` // задан для задач, запущенных по расписанию
+	Retry               *RetryPolicy           `protobuf:"bytes,11,opt,name=retry,proto3" json:"retry,omitempty"`
+	ParentId            string                 `protobuf:"bytes,12,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // задан для дочерних задач пакетной задачи
+	IdempotencyKey      string                 `protobuf:"bytes,13,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	Dedupe              bool                   `protobuf:"varint,14,opt,name=dedupe,proto3" json:"dedupe,omitempty"`
+	CallbackUrl         string                 `protobuf:"bytes,15,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	Options             *JobOptions            `protobuf:"bytes,16,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ParsingJobParams) Reset() {
+	*x = ParsingJobParams{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParsingJobParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsingJobParams) ProtoMessage() {}
+
+func (x *ParsingJobParams) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsingJobParams.ProtoReflect.Descriptor instead.
+func (*ParsingJobParams) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ParsingJobParams) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *ParsingJobParams) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *ParsingJobParams) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *ParsingJobParams) GetParseIssues() bool {
+	if x != nil {
+		return x.ParseIssues
+	}
+	return false
+}
+
+func (x *ParsingJobParams) GetParsePullRequests() bool {
+	if x != nil {
+		return x.ParsePullRequests
+	}
+	return false
+}
+
+func (x *ParsingJobParams) GetParseUsers() bool {
+	if x != nil {
+		return x.ParseUsers
+	}
+	return false
+}
+
+func (x *ParsingJobParams) GetParseContents() bool {
+	if x != nil {
+		return x.ParseContents
+	}
+	return false
+}
+
+func (x *ParsingJobParams) GetParseSecurityAlerts() bool {
+	if x != nil {
+		return x.ParseSecurityAlerts
+	}
+	return false
+}
+
+func (x *ParsingJobParams) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ParsingJobParams) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ParsingJobParams) GetRetry() *RetryPolicy {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *ParsingJobParams) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ParsingJobParams) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *ParsingJobParams) GetDedupe() bool {
+	if x != nil {
+		return x.Dedupe
+	}
+	return false
+}
+
+func (x *ParsingJobParams) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+func (x *ParsingJobParams) GetOptions() *JobOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// Последний запуск шага задачи
+type JobStepResult struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Step       string                 `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	Status     string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "running", "completed", "failed", "stopped"
+	Items      int32                  `protobuf:"varint,3,opt,name=items,proto3" json:"items,omitempty"`  // получено объектов
+	Error      string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt  string                 `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt string                 `protobuf:"bytes,6,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// Сколько объектов шаг ожидает получить; 0, пока неизвестно
+	Total         int32      `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	Usage         *StepUsage `protobuf:"bytes,8,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobStepResult) Reset() {
+	*x = JobStepResult{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobStepResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobStepResult) ProtoMessage() {}
+
+func (x *JobStepResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobStepResult.ProtoReflect.Descriptor instead.
+func (*JobStepResult) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *JobStepResult) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *JobStepResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobStepResult) GetItems() int32 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *JobStepResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JobStepResult) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *JobStepResult) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *JobStepResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *JobStepResult) GetUsage() *StepUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// Что шаг сохранил и сколько запросов к GitHub API потратил.
+// Шаг, продолжающий со своего курсора, досчитывает к прошлому запуску;
+// запросы и ожидания лимита суммируются по всем запускам шага
+type StepUsage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Saved           int32                  `protobuf:"varint,1,opt,name=saved,proto3" json:"saved,omitempty"`
+	Failed          int32                  `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	SampleErrors    []string               `protobuf:"bytes,3,rep,name=sample_errors,json=sampleErrors,proto3" json:"sample_errors,omitempty"` // первые ошибки несохранённых объектов
+	ApiCalls        int32                  `protobuf:"varint,4,opt,name=api_calls,json=apiCalls,proto3" json:"api_calls,omitempty"`
+	RateLimitHits   int32                  `protobuf:"varint,5,opt,name=rate_limit_hits,json=rateLimitHits,proto3" json:"rate_limit_hits,omitempty"`         // запросы, отклонённые из-за лимита
+	RateLimitWaitMs int64                  `protobuf:"varint,6,opt,name=rate_limit_wait_ms,json=rateLimitWaitMs,proto3" json:"rate_limit_wait_ms,omitempty"` // ожидание перед повтором после превышения лимита
+	// Сохранённые задачи и pull requests: новые, изменённые и те, что не изменились
+	Created       int32          `protobuf:"varint,7,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32          `protobuf:"varint,8,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged     int32          `protobuf:"varint,9,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	FailedItems   []*ItemFailure `protobuf:"bytes,10,rep,name=failed_items,json=failedItems,proto3" json:"failed_items,omitempty"` // первые несохранённые задачи и pull requests
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepUsage) Reset() {
+	*x = StepUsage{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepUsage) ProtoMessage() {}
+
+func (x *StepUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepUsage.ProtoReflect.Descriptor instead.
+func (*StepUsage) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *StepUsage) GetSaved() int32 {
+	if x != nil {
+		return x.Saved
+	}
+	return 0
+}
+
+func (x *StepUsage) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *StepUsage) GetSampleErrors() []string {
+	if x != nil {
+		return x.SampleErrors
+	}
+	return nil
+}
+
+func (x *StepUsage) GetApiCalls() int32 {
+	if x != nil {
+		return x.ApiCalls
+	}
+	return 0
+}
+
+func (x *StepUsage) GetRateLimitHits() int32 {
+	if x != nil {
+		return x.RateLimitHits
+	}
+	return 0
+}
+
+func (x *StepUsage) GetRateLimitWaitMs() int64 {
+	if x != nil {
+		return x.RateLimitWaitMs
+	}
+	return 0
+}
+
+func (x *StepUsage) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *StepUsage) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *StepUsage) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *StepUsage) GetFailedItems() []*ItemFailure {
+	if x != nil {
+		return x.FailedItems
+	}
+	return nil
+}
+
+type GetParsingJobReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetParsingJobReportRequest) Reset() {
+	*x = GetParsingJobReportRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetParsingJobReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParsingJobReportRequest) ProtoMessage() {}
+
+func (x *GetParsingJobReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParsingJobReportRequest.ProtoReflect.Descriptor instead.
+func (*GetParsingJobReportRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetParsingJobReportRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// Отчёт о выполнении задачи по шагам
+type ParsingJobReport struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	JobId        string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	JobType      string                 `protobuf:"bytes,2,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Status       string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Progress     int32                  `protobuf:"varint,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	ErrorMessage string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Attempts     int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	StartedAt    string                 `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt   string                 `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// От первого запуска до завершения; для незавершённой задачи — до текущего момента
+	DurationMs int64            `protobuf:"varint,9,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Steps      []*JobStepResult `protobuf:"bytes,10,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Итоги по всем шагам; для пакетной задачи — по всем дочерним задачам
+	Items         int32      `protobuf:"varint,11,opt,name=items,proto3" json:"items,omitempty"`
+	Usage         *StepUsage `protobuf:"bytes,12,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParsingJobReport) Reset() {
+	*x = ParsingJobReport{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParsingJobReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsingJobReport) ProtoMessage() {}
+
+func (x *ParsingJobReport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsingJobReport.ProtoReflect.Descriptor instead.
+func (*ParsingJobReport) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ParsingJobReport) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ParsingJobReport) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *ParsingJobReport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ParsingJobReport) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *ParsingJobReport) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ParsingJobReport) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ParsingJobReport) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ParsingJobReport) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *ParsingJobReport) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ParsingJobReport) GetSteps() []*JobStepResult {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *ParsingJobReport) GetItems() int32 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *ParsingJobReport) GetUsage() *StepUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// История задач; сначала новые
+type ListParsingJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []string               `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	OwnerName     string                 `protobuf:"bytes,2,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	RepoName      string                 `protobuf:"bytes,3,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	JobType       string                 `protobuf:"bytes,4,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	CreatedAfter  string                 `protobuf:"bytes,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`    // RFC 3339, включительно
+	CreatedBefore string                 `protobuf:"bytes,6,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"` // RFC 3339, не включительно
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	ParentId      string                 `protobuf:"bytes,9,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // дочерние задачи пакетной задачи
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListParsingJobsRequest) Reset() {
+	*x = ListParsingJobsRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListParsingJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListParsingJobsRequest) ProtoMessage() {}
+
+func (x *ListParsingJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListParsingJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListParsingJobsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListParsingJobsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListParsingJobsRequest) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+func (x *ListParsingJobsRequest) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *ListParsingJobsRequest) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *ListParsingJobsRequest) GetCreatedAfter() string {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *ListParsingJobsRequest) GetCreatedBefore() string {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return ""
+}
+
+func (x *ListParsingJobsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListParsingJobsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListParsingJobsRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type ListParsingJobsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Jobs          []*GetParsingJobStatusResponse `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	TotalCount    int32                          `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListParsingJobsResponse) Reset() {
+	*x = ListParsingJobsResponse{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListParsingJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListParsingJobsResponse) ProtoMessage() {}
+
+func (x *ListParsingJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListParsingJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListParsingJobsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListParsingJobsResponse) GetJobs() []*GetParsingJobStatusResponse {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListParsingJobsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Подписка на события задачи; поток завершается, когда задача завершена
+type WatchParsingJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchParsingJobRequest) Reset() {
+	*x = WatchParsingJobRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchParsingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchParsingJobRequest) ProtoMessage() {}
+
+func (x *WatchParsingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchParsingJobRequest.ProtoReflect.Descriptor instead.
+func (*WatchParsingJobRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *WatchParsingJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// Событие задачи. Первым приходит "state" с текущим состоянием задачи,
+// затем "status", "progress", "step_started", "step_finished" и "warning"
+type JobEvent struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Type          string                       `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	JobId         string                       `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status        string                       `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Progress      int32                        `protobuf:"varint,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	Step          *JobStepResult               `protobuf:"bytes,5,opt,name=step,proto3" json:"step,omitempty"`       // для событий шагов
+	Message       string                       `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"` // сообщение перехода или текст предупреждения
+	At            string                       `protobuf:"bytes,7,opt,name=at,proto3" json:"at,omitempty"`
+	Job           *GetParsingJobStatusResponse `protobuf:"bytes,8,opt,name=job,proto3" json:"job,omitempty"` // для события "state"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobEvent) Reset() {
+	*x = JobEvent{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobEvent) ProtoMessage() {}
+
+func (x *JobEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobEvent.ProtoReflect.Descriptor instead.
+func (*JobEvent) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *JobEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *JobEvent) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobEvent) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *JobEvent) GetStep() *JobStepResult {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *JobEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JobEvent) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *JobEvent) GetJob() *GetParsingJobStatusResponse {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// Отмена задачи: ожидающая или приостановленная задача отменяется сразу,
+// выполняющаяся останавливается после текущего запроса к GitHub
+type CancelParsingJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelParsingJobRequest) Reset() {
+	*x = CancelParsingJobRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelParsingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelParsingJobRequest) ProtoMessage() {}
+
+func (x *CancelParsingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelParsingJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelParsingJobRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CancelParsingJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// Приостановка задачи; завершённые шаги сохраняются
+type PauseParsingJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseParsingJobRequest) Reset() {
+	*x = PauseParsingJobRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseParsingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseParsingJobRequest) ProtoMessage() {}
+
+func (x *PauseParsingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseParsingJobRequest.ProtoReflect.Descriptor instead.
+func (*PauseParsingJobRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PauseParsingJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// Возобновление приостановленной задачи с первого незавершённого шага
+type ResumeParsingJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeParsingJobRequest) Reset() {
+	*x = ResumeParsingJobRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeParsingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeParsingJobRequest) ProtoMessage() {}
+
+func (x *ResumeParsingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeParsingJobRequest.ProtoReflect.Descriptor instead.
+func (*ResumeParsingJobRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ResumeParsingJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// Повторный запуск задачи в статусе "failed", "dead_letter" или "interrupted";
+// счётчик неудачных попыток сбрасывается, завершённые шаги не повторяются
+type RetryParsingJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryParsingJobRequest) Reset() {
+	*x = RetryParsingJobRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryParsingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryParsingJobRequest) ProtoMessage() {}
+
+func (x *RetryParsingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryParsingJobRequest.ProtoReflect.Descriptor instead.
+func (*RetryParsingJobRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RetryParsingJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// Количество объектов, полученных задачей
+type JobResults struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Issues         int32                  `protobuf:"varint,1,opt,name=issues,proto3" json:"issues,omitempty"`
+	PullRequests   int32                  `protobuf:"varint,2,opt,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	Users          int32                  `protobuf:"varint,3,opt,name=users,proto3" json:"users,omitempty"`
+	Files          int32                  `protobuf:"varint,4,opt,name=files,proto3" json:"files,omitempty"`
+	SecurityAlerts int32                  `protobuf:"varint,5,opt,name=security_alerts,json=securityAlerts,proto3" json:"security_alerts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JobResults) Reset() {
+	*x = JobResults{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobResults) ProtoMessage() {}
+
+func (x *JobResults) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobResults.ProtoReflect.Descriptor instead.
+func (*JobResults) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *JobResults) GetIssues() int32 {
+	if x != nil {
+		return x.Issues
+	}
+	return 0
+}
+
+func (x *JobResults) GetPullRequests() int32 {
+	if x != nil {
+		return x.PullRequests
+	}
+	return 0
+}
+
+func (x *JobResults) GetUsers() int32 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+func (x *JobResults) GetFiles() int32 {
+	if x != nil {
+		return x.Files
+	}
+	return 0
+}
+
+func (x *JobResults) GetSecurityAlerts() int32 {
+	if x != nil {
+		return x.SecurityAlerts
+	}
+	return 0
+}
+
+// Результат сверки сохранённых issues и pull requests с GitHub
+type ReconciliationResult struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	IssuesChecked       int32                  `protobuf:"varint,1,opt,name=issues_checked,json=issuesChecked,proto3" json:"issues_checked,omitempty"`
+	PullRequestsChecked int32                  `protobuf:"varint,2,opt,name=pull_requests_checked,json=pullRequestsChecked,proto3" json:"pull_requests_checked,omitempty"`
+	Deleted             []*ReconciledItem      `protobuf:"bytes,3,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Transferred         []*ReconciledItem      `protobuf:"bytes,4,rep,name=transferred,proto3" json:"transferred,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReconciliationResult) Reset() {
+	*x = ReconciliationResult{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationResult) ProtoMessage() {}
+
+func (x *ReconciliationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationResult.ProtoReflect.Descriptor instead.
+func (*ReconciliationResult) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ReconciliationResult) GetIssuesChecked() int32 {
+	if x != nil {
+		return x.IssuesChecked
+	}
+	return 0
+}
+
+func (x *ReconciliationResult) GetPullRequestsChecked() int32 {
+	if x != nil {
+		return x.PullRequestsChecked
+	}
+	return 0
+}
+
+func (x *ReconciliationResult) GetDeleted() []*ReconciledItem {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *ReconciliationResult) GetTransferred() []*ReconciledItem {
+	if x != nil {
+		return x.Transferred
+	}
+	return nil
+}
+
+type ReconciledItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // "issue", "pull_request"
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Number        int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	TransferredTo string                 `protobuf:"bytes,4,opt,name=transferred_to,json=transferredTo,proto3" json:"transferred_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetParsingJobStatusResponse) Reset() {
-	*x = GetParsingJobStatusResponse{}
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[23]
+func (x *ReconciledItem) Reset() {
+	*x = ReconciledItem{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciledItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciledItem) ProtoMessage() {}
+
+func (x *ReconciledItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciledItem.ProtoReflect.Descriptor instead.
+func (*ReconciledItem) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ReconciledItem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReconciledItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReconciledItem) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *ReconciledItem) GetTransferredTo() string {
+	if x != nil {
+		return x.TransferredTo
+	}
+	return ""
+}
+
+// Запросы и ответы для работы с расписаниями.
+// Задано либо cron-выражение (5 полей, UTC, например "0 * * * *" или "@hourly"), либо интервал.
+type CreateScheduleRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Job             *StartParsingJobRequest `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Cron            string                  `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	IntervalSeconds int64                   `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateScheduleRequest) Reset() {
+	*x = CreateScheduleRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetParsingJobStatusResponse) String() string {
+func (x *CreateScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetParsingJobStatusResponse) ProtoMessage() {}
+func (*CreateScheduleRequest) ProtoMessage() {}
 
-func (x *GetParsingJobStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[23]
+func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1571,53 +5259,308 @@ func (x *GetParsingJobStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetParsingJobStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetParsingJobStatusResponse) Descriptor() ([]byte, []int) {
-	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetParsingJobStatusResponse) GetId() string {
+func (x *CreateScheduleRequest) GetJob() *StartParsingJobRequest {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *CreateScheduleRequest) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type Schedule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Params          *ParsingJobParams      `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	Cron            string                 `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	IntervalSeconds int64                  `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	LastRunAt       string                 `protobuf:"bytes,5,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastJobId       string                 `protobuf:"bytes,6,opt,name=last_job_id,json=lastJobId,proto3" json:"last_job_id,omitempty"`
+	LastRunResult   string                 `protobuf:"bytes,7,opt,name=last_run_result,json=lastRunResult,proto3" json:"last_run_result,omitempty"` // "started", "skipped" (предыдущая задача ещё активна), "failed"
+	LastRunMessage  string                 `protobuf:"bytes,8,opt,name=last_run_message,json=lastRunMessage,proto3" json:"last_run_message,omitempty"`
+	NextRunAt       string                 `protobuf:"bytes,9,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Schedule) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetParsingJobStatusResponse) GetStatus() string {
+func (x *Schedule) GetParams() *ParsingJobParams {
 	if x != nil {
-		return x.Status
+		return x.Params
+	}
+	return nil
+}
+
+func (x *Schedule) GetCron() string {
+	if x != nil {
+		return x.Cron
 	}
 	return ""
 }
 
-func (x *GetParsingJobStatusResponse) GetProgress() int32 {
+func (x *Schedule) GetIntervalSeconds() int64 {
 	if x != nil {
-		return x.Progress
+		return x.IntervalSeconds
 	}
 	return 0
 }
 
-func (x *GetParsingJobStatusResponse) GetErrorMessage() string {
+func (x *Schedule) GetLastRunAt() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.LastRunAt
 	}
 	return ""
 }
 
-func (x *GetParsingJobStatusResponse) GetCreatedAt() string {
+func (x *Schedule) GetLastJobId() string {
+	if x != nil {
+		return x.LastJobId
+	}
+	return ""
+}
+
+func (x *Schedule) GetLastRunResult() string {
+	if x != nil {
+		return x.LastRunResult
+	}
+	return ""
+}
+
+func (x *Schedule) GetLastRunMessage() string {
+	if x != nil {
+		return x.LastRunMessage
+	}
+	return ""
+}
+
+func (x *Schedule) GetNextRunAt() string {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return ""
+}
+
+func (x *Schedule) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *GetParsingJobStatusResponse) GetUpdatedAt() string {
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[66]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{66}
+}
+
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type DeleteScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
+type DeleteScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleResponse) Reset() {
+	*x = DeleteScheduleResponse{}
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleResponse) ProtoMessage() {}
+
+func (x *DeleteScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_infrastructure_api_proto_github_parser_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP(), []int{69}
+}
+
 var File_internal_infrastructure_api_proto_github_parser_proto protoreflect.FileDescriptor
 
 const file_internal_infrastructure_api_proto_github_parser_proto_rawDesc = "" +
@@ -1629,18 +5572,19 @@ const file_internal_infrastructure_api_proto_github_parser_proto_rawDesc = "" +
 	"\x17ParseRepositoryResponse\x129\n" +
 	"\n" +
 	"repository\x18\x01 \x01(\v2\x19.github.parser.RepositoryR\n" +
-	"repository\"\xa1\x01\n" +
+	"repository\"\xca\x01\n" +
 	"\x17ListRepositoriesRequest\x12\x1f\n" +
 	"\vowner_login\x18\x01 \x01(\tR\n" +
 	"ownerLogin\x12\x1a\n" +
 	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x1b\n" +
 	"\tmin_stars\x18\x03 \x01(\x05R\bminStars\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x05R\x06offset\"z\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12'\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bR\x0eincludeDeleted\"z\n" +
 	"\x18ListRepositoriesResponse\x12=\n" +
 	"\frepositories\x18\x01 \x03(\v2\x19.github.parser.RepositoryR\frepositories\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\xf7\x02\n" +
+	"totalCount\"\xef\x03\n" +
 	"\n" +
 	"Repository\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
@@ -1661,21 +5605,44 @@ const file_internal_infrastructure_api_proto_github_parser_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\tR\tupdatedAt\">\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\x12.\n" +
+	"\x13previous_full_names\x18\r \x03(\tR\x11previousFullNames\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x0e \x01(\tR\tdeletedAt\x12'\n" +
+	"\x0fdeletion_reason\x18\x0f \x01(\tR\x0edeletionReason\"_\n" +
 	"\x12ParseIssuesRequest\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
-	"\x04repo\x18\x02 \x01(\tR\x04repo\"C\n" +
-	"\x13ParseIssuesResponse\x12,\n" +
-	"\x06issues\x18\x01 \x03(\v2\x14.github.parser.IssueR\x06issues\"|\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x1f\n" +
+	"\vsave_policy\x18\x03 \x01(\tR\n" +
+	"savePolicy\"l\n" +
+	"\x13ParseIssuesResponse\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x121\n" +
+	"\x06result\x18\x03 \x01(\v2\x19.github.parser.SaveResultR\x06resultJ\x04\b\x01\x10\x02R\x06issues\"\x82\x01\n" +
+	"\tIssuePage\x12,\n" +
+	"\x06issues\x18\x01 \x03(\v2\x14.github.parser.IssueR\x06issues\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x121\n" +
+	"\x06result\x18\x03 \x01(\v2\x19.github.parser.SaveResultR\x06result\"\xae\x01\n" +
+	"\n" +
+	"SaveResult\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x03 \x01(\x05R\tunchanged\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x126\n" +
+	"\bfailures\x18\x05 \x03(\v2\x1a.github.parser.ItemFailureR\bfailures\"M\n" +
+	"\vItemFailure\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xa5\x01\n" +
 	"\x11ListIssuesRequest\x12#\n" +
 	"\rrepository_id\x18\x01 \x01(\x03R\frepositoryId\x12\x14\n" +
 	"\x05state\x18\x02 \x01(\tR\x05state\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"c\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12'\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bR\x0eincludeDeleted\"c\n" +
 	"\x12ListIssuesResponse\x12,\n" +
 	"\x06issues\x18\x01 \x03(\v2\x14.github.parser.IssueR\x06issues\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\x92\x02\n" +
+	"totalCount\"\xd8\x02\n" +
 	"\x05Issue\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
 	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x14\n" +
@@ -1689,21 +5656,33 @@ const file_internal_infrastructure_api_proto_github_parser_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\t \x01(\tR\tupdatedAt\x12\x1b\n" +
 	"\tclosed_at\x18\n" +
-	" \x01(\tR\bclosedAt\"D\n" +
+	" \x01(\tR\bclosedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\tR\tdeletedAt\x12%\n" +
+	"\x0etransferred_to\x18\f \x01(\tR\rtransferredTo\"e\n" +
 	"\x18ParsePullRequestsRequest\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
-	"\x04repo\x18\x02 \x01(\tR\x04repo\"\\\n" +
-	"\x19ParsePullRequestsResponse\x12?\n" +
-	"\rpull_requests\x18\x01 \x03(\v2\x1a.github.parser.PullRequestR\fpullRequests\"\x82\x01\n" +
+	"\x04repo\x18\x02 \x01(\tR\x04repo\x12\x1f\n" +
+	"\vsave_policy\x18\x03 \x01(\tR\n" +
+	"savePolicy\"y\n" +
+	"\x19ParsePullRequestsResponse\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x121\n" +
+	"\x06result\x18\x03 \x01(\v2\x19.github.parser.SaveResultR\x06resultJ\x04\b\x01\x10\x02R\rpull_requests\"\x9b\x01\n" +
+	"\x0fPullRequestPage\x12?\n" +
+	"\rpull_requests\x18\x01 \x03(\v2\x1a.github.parser.PullRequestR\fpullRequests\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x121\n" +
+	"\x06result\x18\x03 \x01(\v2\x19.github.parser.SaveResultR\x06result\"\xc6\x01\n" +
 	"\x17ListPullRequestsRequest\x12#\n" +
 	"\rrepository_id\x18\x01 \x01(\x03R\frepositoryId\x12\x14\n" +
 	"\x05state\x18\x02 \x01(\tR\x05state\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"|\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x19\n" +
+	"\bci_state\x18\x05 \x01(\tR\aciState\x12'\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bR\x0eincludeDeleted\"|\n" +
 	"\x18ListPullRequestsResponse\x12?\n" +
 	"\rpull_requests\x18\x01 \x03(\v2\x1a.github.parser.PullRequestR\fpullRequests\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\xb5\x02\n" +
+	"totalCount\"\x99\x03\n" +
 	"\vPullRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
 	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x14\n" +
@@ -1718,7 +5697,16 @@ const file_internal_infrastructure_api_proto_github_parser_proto_rawDesc = "" +
 	"updated_at\x18\t \x01(\tR\tupdatedAt\x12\x1b\n" +
 	"\tmerged_at\x18\n" +
 	" \x01(\tR\bmergedAt\x12\x1b\n" +
-	"\tclosed_at\x18\v \x01(\tR\bclosedAt\".\n" +
+	"\tclosed_at\x18\v \x01(\tR\bclosedAt\x12\x19\n" +
+	"\bhead_sha\x18\f \x01(\tR\aheadSha\x12(\n" +
+	"\x02ci\x18\r \x01(\v2\x18.github.parser.CISummaryR\x02ci\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x0e \x01(\tR\tdeletedAt\"k\n" +
+	"\tCISummary\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\x05R\x06passed\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x18\n" +
+	"\apending\x18\x04 \x01(\x05R\apending\".\n" +
 	"\x10ParseUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\"<\n" +
 	"\x11ParseUserResponse\x12'\n" +
@@ -1745,7 +5733,63 @@ const file_internal_infrastructure_api_proto_github_parser_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt\"\xc8\x01\n" +
+	" \x01(\tR\tupdatedAt\"\xb8\x01\n" +
+	"\x17ListDependenciesRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
+	"\tecosystem\x18\x03 \x01(\tR\tecosystem\x12#\n" +
+	"\rrepository_id\x18\x04 \x01(\x03R\frepositoryId\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\"z\n" +
+	"\x18ListDependenciesResponse\x12=\n" +
+	"\fdependencies\x18\x01 \x03(\v2\x19.github.parser.DependencyR\fdependencies\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\xe1\x01\n" +
+	"\n" +
+	"Dependency\x12#\n" +
+	"\rrepository_id\x18\x01 \x01(\x03R\frepositoryId\x120\n" +
+	"\x14repository_full_name\x18\x02 \x01(\tR\x12repositoryFullName\x12\x1a\n" +
+	"\bmanifest\x18\x03 \x01(\tR\bmanifest\x12\x1c\n" +
+	"\tecosystem\x18\x04 \x01(\tR\tecosystem\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\tR\aversion\x12\x14\n" +
+	"\x05scope\x18\a \x01(\tR\x05scope\"\xb8\x01\n" +
+	"\x19ListSecurityAlertsRequest\x12#\n" +
+	"\rrepository_id\x18\x01 \x01(\x03R\frepositoryId\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\"\xc4\x01\n" +
+	"\x1aListSecurityAlertsResponse\x124\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x1c.github.parser.SecurityAlertR\x06alerts\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12O\n" +
+	"\x11open_alert_counts\x18\x03 \x03(\v2#.github.parser.RepositoryAlertCountR\x0fopenAlertCounts\"\x8d\x04\n" +
+	"\rSecurityAlert\x12#\n" +
+	"\rrepository_id\x18\x01 \x01(\x03R\frepositoryId\x120\n" +
+	"\x14repository_full_name\x18\x02 \x01(\tR\x12repositoryFullName\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\x05R\x06number\x12\x17\n" +
+	"\aghsa_id\x18\x05 \x01(\tR\x06ghsaId\x12\x15\n" +
+	"\x06cve_id\x18\x06 \x01(\tR\x05cveId\x12\x18\n" +
+	"\asummary\x18\a \x01(\tR\asummary\x12\x1a\n" +
+	"\bseverity\x18\b \x01(\tR\bseverity\x12\x1c\n" +
+	"\tecosystem\x18\t \x01(\tR\tecosystem\x12\x18\n" +
+	"\apackage\x18\n" +
+	" \x01(\tR\apackage\x12)\n" +
+	"\x10vulnerable_range\x18\v \x01(\tR\x0fvulnerableRange\x12'\n" +
+	"\x0fpatched_version\x18\f \x01(\tR\x0epatchedVersion\x12\x14\n" +
+	"\x05state\x18\r \x01(\tR\x05state\x12\x10\n" +
+	"\x03url\x18\x0e \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAt\x12\x19\n" +
+	"\bfixed_at\x18\x10 \x01(\tR\afixedAt\x12!\n" +
+	"\fdismissed_at\x18\x11 \x01(\tR\vdismissedAt\"Z\n" +
+	"\x14RepositoryAlertCount\x12#\n" +
+	"\rrepository_id\x18\x01 \x01(\x03R\frepositoryId\x12\x1d\n" +
+	"\n" +
+	"open_count\x18\x02 \x01(\x05R\topenCount\"\xbe\x04\n" +
 	"\x16StartParsingJobRequest\x12\x1d\n" +
 	"\n" +
 	"owner_name\x18\x01 \x01(\tR\townerName\x12\x1b\n" +
@@ -1753,11 +5797,75 @@ const file_internal_infrastructure_api_proto_github_parser_proto_rawDesc = "" +
 	"\fparse_issues\x18\x03 \x01(\bR\vparseIssues\x12.\n" +
 	"\x13parse_pull_requests\x18\x04 \x01(\bR\x11parsePullRequests\x12\x1f\n" +
 	"\vparse_users\x18\x05 \x01(\bR\n" +
-	"parseUsers\"0\n" +
+	"parseUsers\x12%\n" +
+	"\x0eparse_contents\x18\x06 \x01(\bR\rparseContents\x122\n" +
+	"\x15parse_security_alerts\x18\a \x01(\bR\x13parseSecurityAlerts\x12\x19\n" +
+	"\bjob_type\x18\b \x01(\tR\ajobType\x12\x1a\n" +
+	"\bpriority\x18\t \x01(\x05R\bpriority\x120\n" +
+	"\x05retry\x18\n" +
+	" \x01(\v2\x1a.github.parser.RetryPolicyR\x05retry\x12'\n" +
+	"\x0fidempotency_key\x18\v \x01(\tR\x0eidempotencyKey\x12\x16\n" +
+	"\x06dedupe\x18\f \x01(\bR\x06dedupe\x12!\n" +
+	"\fcallback_url\x18\r \x01(\tR\vcallbackUrl\x12\x17\n" +
+	"\adry_run\x18\x0e \x01(\bR\x06dryRun\x123\n" +
+	"\aoptions\x18\x0f \x01(\v2\x19.github.parser.JobOptionsR\aoptions\"\xd0\x01\n" +
+	"\n" +
+	"JobOptions\x12\x14\n" +
+	"\x05since\x18\x01 \x01(\tR\x05since\x12\x14\n" +
+	"\x05until\x18\x02 \x01(\tR\x05until\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x1b\n" +
+	"\tmax_items\x18\x04 \x01(\x05R\bmaxItems\x12'\n" +
+	"\x0ftimeout_seconds\x18\x05 \x01(\x05R\x0etimeoutSeconds\x12\x19\n" +
+	"\bper_page\x18\x06 \x01(\x05R\aperPage\x12\x1f\n" +
+	"\vsave_policy\x18\a \x01(\tR\n" +
+	"savePolicy\"\x89\x01\n" +
+	"\vRetryPolicy\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x12'\n" +
+	"\x0fbackoff_seconds\x18\x02 \x01(\x05R\x0ebackoffSeconds\x12.\n" +
+	"\x13max_backoff_seconds\x18\x03 \x01(\x05R\x11maxBackoffSeconds\"l\n" +
 	"\x17StartParsingJobResponse\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"3\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12:\n" +
+	"\bestimate\x18\x02 \x01(\v2\x1e.github.parser.ParsingEstimateR\bestimate\"\xad\x03\n" +
+	"\x0fParsingEstimate\x12\x1d\n" +
+	"\n" +
+	"owner_name\x18\x01 \x01(\tR\townerName\x12\x1b\n" +
+	"\trepo_name\x18\x02 \x01(\tR\brepoName\x12\x19\n" +
+	"\bjob_type\x18\x03 \x01(\tR\ajobType\x121\n" +
+	"\x05steps\x18\x04 \x03(\v2\x1b.github.parser.StepEstimateR\x05steps\x12\x1a\n" +
+	"\brequests\x18\x05 \x01(\x05R\brequests\x12\x1d\n" +
+	"\n" +
+	"rate_limit\x18\x06 \x01(\x05R\trateLimit\x120\n" +
+	"\x14rate_limit_remaining\x18\a \x01(\x05R\x12rateLimitRemaining\x12(\n" +
+	"\x10rate_limit_reset\x18\b \x01(\tR\x0erateLimitReset\x12\x1f\n" +
+	"\vduration_ms\x18\t \x01(\x03R\n" +
+	"durationMs\x12+\n" +
+	"\x12rate_limit_wait_ms\x18\n" +
+	" \x01(\x03R\x0frateLimitWaitMs\x12+\n" +
+	"\x11estimate_requests\x18\v \x01(\x05R\x10estimateRequests\"j\n" +
+	"\fStepEstimate\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\tR\x04step\x12\x14\n" +
+	"\x05items\x18\x02 \x01(\x05R\x05items\x12\x14\n" +
+	"\x05pages\x18\x03 \x01(\x05R\x05pages\x12\x1a\n" +
+	"\brequests\x18\x04 \x01(\x05R\brequests\"\xc7\x03\n" +
+	"\x1bStartBatchParsingJobRequest\x12\x18\n" +
+	"\atargets\x18\x01 \x03(\tR\atargets\x12!\n" +
+	"\ftargets_file\x18\x02 \x01(\fR\vtargetsFile\x12!\n" +
+	"\fparse_issues\x18\x03 \x01(\bR\vparseIssues\x12.\n" +
+	"\x13parse_pull_requests\x18\x04 \x01(\bR\x11parsePullRequests\x12\x1f\n" +
+	"\vparse_users\x18\x05 \x01(\bR\n" +
+	"parseUsers\x12%\n" +
+	"\x0eparse_contents\x18\x06 \x01(\bR\rparseContents\x122\n" +
+	"\x15parse_security_alerts\x18\a \x01(\bR\x13parseSecurityAlerts\x12\x19\n" +
+	"\bjob_type\x18\b \x01(\tR\ajobType\x12\x1a\n" +
+	"\bpriority\x18\t \x01(\x05R\bpriority\x120\n" +
+	"\x05retry\x18\n" +
+	" \x01(\v2\x1a.github.parser.RetryPolicyR\x05retry\x123\n" +
+	"\aoptions\x18\v \x01(\v2\x19.github.parser.JobOptionsR\aoptions\"Y\n" +
+	"\x1cStartBatchParsingJobResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\"\n" +
+	"\rchild_job_ids\x18\x02 \x03(\tR\vchildJobIds\"3\n" +
 	"\x1aGetParsingJobStatusRequest\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xc4\x01\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x81\b\n" +
 	"\x1bGetParsingJobStatusResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
@@ -1766,19 +5874,242 @@ const file_internal_infrastructure_api_proto_github_parser_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\tR\tupdatedAt2\xc2\a\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\x12K\n" +
+	"\x0ereconciliation\x18\a \x01(\v2#.github.parser.ReconciliationResultR\x0ereconciliation\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\b \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\t \x01(\tR\n" +
+	"finishedAt\x12\x1a\n" +
+	"\battempts\x18\n" +
+	" \x01(\x05R\battempts\x123\n" +
+	"\aresults\x18\v \x01(\v2\x19.github.parser.JobResultsR\aresults\x12'\n" +
+	"\x0fcompleted_steps\x18\f \x03(\tR\x0ecompletedSteps\x127\n" +
+	"\x06params\x18\r \x01(\v2\x1f.github.parser.ParsingJobParamsR\x06params\x122\n" +
+	"\x05steps\x18\x0e \x03(\v2\x1c.github.parser.JobStepResultR\x05steps\x12&\n" +
+	"\x0fnext_attempt_at\x18\x0f \x01(\tR\rnextAttemptAt\x12B\n" +
+	"\x0fattempt_history\x18\x10 \x03(\v2\x19.github.parser.JobAttemptR\x0eattemptHistory\x12'\n" +
+	"\x0ffailed_attempts\x18\x11 \x01(\x05R\x0efailedAttempts\x121\n" +
+	"\x05batch\x18\x12 \x01(\v2\x1b.github.parser.BatchSummaryR\x05batch\x125\n" +
+	"\bchildren\x18\x13 \x03(\v2\x19.github.parser.BatchChildR\bchildren\x12>\n" +
+	"\vcheckpoints\x18\x14 \x03(\v2\x1c.github.parser.JobCheckpointR\vcheckpoints\x12\x1f\n" +
+	"\vlease_owner\x18\x15 \x01(\tR\n" +
+	"leaseOwner\x12(\n" +
+	"\x10lease_expires_at\x18\x16 \x01(\tR\x0eleaseExpiresAt\x12=\n" +
+	"\tcallbacks\x18\x17 \x03(\v2\x1f.github.parser.CallbackDeliveryR\tcallbacks\"\xd3\x01\n" +
+	"\x10CallbackDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12:\n" +
+	"\battempts\x18\x05 \x03(\v2\x1e.github.parser.CallbackAttemptR\battempts\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\x91\x01\n" +
+	"\x0fCallbackAttempt\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x0e\n" +
+	"\x02at\x18\x02 \x01(\tR\x02at\x12\x1f\n" +
+	"\vstatus_code\x18\x03 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\"u\n" +
+	"\rJobCheckpoint\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\tR\x04step\x12\x1b\n" +
+	"\tnext_page\x18\x02 \x01(\x05R\bnextPage\x12\x14\n" +
+	"\x05items\x18\x03 \x01(\x05R\x05items\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"\xad\x01\n" +
+	"\fBatchSummary\x12\x19\n" +
+	"\bjob_type\x18\x01 \x01(\tR\ajobType\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\x05R\tcompleted\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12\x1c\n" +
+	"\tcancelled\x18\x05 \x01(\x05R\tcancelled\x12\x18\n" +
+	"\arunning\x18\x06 \x01(\x05R\arunning\"\xed\x01\n" +
+	"\n" +
+	"BatchChild\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1d\n" +
+	"\n" +
+	"owner_name\x18\x02 \x01(\tR\townerName\x12\x1b\n" +
+	"\trepo_name\x18\x03 \x01(\tR\brepoName\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\bprogress\x18\x05 \x01(\x05R\bprogress\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x123\n" +
+	"\aresults\x18\a \x01(\v2\x19.github.parser.JobResultsR\aresults\"\xa6\x01\n" +
+	"\n" +
+	"JobAttempt\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x03 \x01(\tR\n" +
+	"finishedAt\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x12\n" +
+	"\x04step\x18\x05 \x01(\tR\x04step\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xdd\x04\n" +
+	"\x10ParsingJobParams\x12\x19\n" +
+	"\bjob_type\x18\x01 \x01(\tR\ajobType\x12\x1d\n" +
+	"\n" +
+	"owner_name\x18\x02 \x01(\tR\townerName\x12\x1b\n" +
+	"\trepo_name\x18\x03 \x01(\tR\brepoName\x12!\n" +
+	"\fparse_issues\x18\x04 \x01(\bR\vparseIssues\x12.\n" +
+	"\x13parse_pull_requests\x18\x05 \x01(\bR\x11parsePullRequests\x12\x1f\n" +
+	"\vparse_users\x18\x06 \x01(\bR\n" +
+	"parseUsers\x12%\n" +
+	"\x0eparse_contents\x18\a \x01(\bR\rparseContents\x122\n" +
+	"\x15parse_security_alerts\x18\b \x01(\bR\x13parseSecurityAlerts\x12\x1a\n" +
+	"\bpriority\x18\t \x01(\x05R\bpriority\x12\x1f\n" +
+	"\vschedule_id\x18\n" +
+	" \x01(\tR\n" +
+	"scheduleId\x120\n" +
+	"\x05retry\x18\v \x01(\v2\x1a.github.parser.RetryPolicyR\x05retry\x12\x1b\n" +
+	"\tparent_id\x18\f \x01(\tR\bparentId\x12'\n" +
+	"\x0fidempotency_key\x18\r \x01(\tR\x0eidempotencyKey\x12\x16\n" +
+	"\x06dedupe\x18\x0e \x01(\bR\x06dedupe\x12!\n" +
+	"\fcallback_url\x18\x0f \x01(\tR\vcallbackUrl\x123\n" +
+	"\aoptions\x18\x10 \x01(\v2\x19.github.parser.JobOptionsR\aoptions\"\xed\x01\n" +
+	"\rJobStepResult\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\tR\x04step\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05items\x18\x03 \x01(\x05R\x05items\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x06 \x01(\tR\n" +
+	"finishedAt\x12\x14\n" +
+	"\x05total\x18\a \x01(\x05R\x05total\x12.\n" +
+	"\x05usage\x18\b \x01(\v2\x18.github.parser.StepUsageR\x05usage\"\xe1\x02\n" +
+	"\tStepUsage\x12\x14\n" +
+	"\x05saved\x18\x01 \x01(\x05R\x05saved\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x05R\x06failed\x12#\n" +
+	"\rsample_errors\x18\x03 \x03(\tR\fsampleErrors\x12\x1b\n" +
+	"\tapi_calls\x18\x04 \x01(\x05R\bapiCalls\x12&\n" +
+	"\x0frate_limit_hits\x18\x05 \x01(\x05R\rrateLimitHits\x12+\n" +
+	"\x12rate_limit_wait_ms\x18\x06 \x01(\x03R\x0frateLimitWaitMs\x12\x18\n" +
+	"\acreated\x18\a \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\b \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\t \x01(\x05R\tunchanged\x12=\n" +
+	"\ffailed_items\x18\n" +
+	" \x03(\v2\x1a.github.parser.ItemFailureR\vfailedItems\"3\n" +
+	"\x1aGetParsingJobReportRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x94\x03\n" +
+	"\x10ParsingJobReport\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x19\n" +
+	"\bjob_type\x18\x02 \x01(\tR\ajobType\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\bprogress\x18\x04 \x01(\x05R\bprogress\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\a \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\b \x01(\tR\n" +
+	"finishedAt\x12\x1f\n" +
+	"\vduration_ms\x18\t \x01(\x03R\n" +
+	"durationMs\x122\n" +
+	"\x05steps\x18\n" +
+	" \x03(\v2\x1c.github.parser.JobStepResultR\x05steps\x12\x14\n" +
+	"\x05items\x18\v \x01(\x05R\x05items\x12.\n" +
+	"\x05usage\x18\f \x01(\v2\x18.github.parser.StepUsageR\x05usage\"\xa2\x02\n" +
+	"\x16ListParsingJobsRequest\x12\x1a\n" +
+	"\bstatuses\x18\x01 \x03(\tR\bstatuses\x12\x1d\n" +
+	"\n" +
+	"owner_name\x18\x02 \x01(\tR\townerName\x12\x1b\n" +
+	"\trepo_name\x18\x03 \x01(\tR\brepoName\x12\x19\n" +
+	"\bjob_type\x18\x04 \x01(\tR\ajobType\x12#\n" +
+	"\rcreated_after\x18\x05 \x01(\tR\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\x06 \x01(\tR\rcreatedBefore\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\b \x01(\x05R\x06offset\x12\x1b\n" +
+	"\tparent_id\x18\t \x01(\tR\bparentId\"z\n" +
+	"\x17ListParsingJobsResponse\x12>\n" +
+	"\x04jobs\x18\x01 \x03(\v2*.github.parser.GetParsingJobStatusResponseR\x04jobs\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"/\n" +
+	"\x16WatchParsingJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x83\x02\n" +
+	"\bJobEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\bprogress\x18\x04 \x01(\x05R\bprogress\x120\n" +
+	"\x04step\x18\x05 \x01(\v2\x1c.github.parser.JobStepResultR\x04step\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12\x0e\n" +
+	"\x02at\x18\a \x01(\tR\x02at\x12<\n" +
+	"\x03job\x18\b \x01(\v2*.github.parser.GetParsingJobStatusResponseR\x03job\"0\n" +
+	"\x17CancelParsingJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"/\n" +
+	"\x16PauseParsingJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"0\n" +
+	"\x17ResumeParsingJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"/\n" +
+	"\x16RetryParsingJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x9e\x01\n" +
+	"\n" +
+	"JobResults\x12\x16\n" +
+	"\x06issues\x18\x01 \x01(\x05R\x06issues\x12#\n" +
+	"\rpull_requests\x18\x02 \x01(\x05R\fpullRequests\x12\x14\n" +
+	"\x05users\x18\x03 \x01(\x05R\x05users\x12\x14\n" +
+	"\x05files\x18\x04 \x01(\x05R\x05files\x12'\n" +
+	"\x0fsecurity_alerts\x18\x05 \x01(\x05R\x0esecurityAlerts\"\xeb\x01\n" +
+	"\x14ReconciliationResult\x12%\n" +
+	"\x0eissues_checked\x18\x01 \x01(\x05R\rissuesChecked\x122\n" +
+	"\x15pull_requests_checked\x18\x02 \x01(\x05R\x13pullRequestsChecked\x127\n" +
+	"\adeleted\x18\x03 \x03(\v2\x1d.github.parser.ReconciledItemR\adeleted\x12?\n" +
+	"\vtransferred\x18\x04 \x03(\v2\x1d.github.parser.ReconciledItemR\vtransferred\"s\n" +
+	"\x0eReconciledItem\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06number\x18\x03 \x01(\x05R\x06number\x12%\n" +
+	"\x0etransferred_to\x18\x04 \x01(\tR\rtransferredTo\"\x8f\x01\n" +
+	"\x15CreateScheduleRequest\x127\n" +
+	"\x03job\x18\x01 \x01(\v2%.github.parser.StartParsingJobRequestR\x03job\x12\x12\n" +
+	"\x04cron\x18\x02 \x01(\tR\x04cron\x12)\n" +
+	"\x10interval_seconds\x18\x03 \x01(\x03R\x0fintervalSeconds\"\xe3\x02\n" +
+	"\bSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\x06params\x18\x02 \x01(\v2\x1f.github.parser.ParsingJobParamsR\x06params\x12\x12\n" +
+	"\x04cron\x18\x03 \x01(\tR\x04cron\x12)\n" +
+	"\x10interval_seconds\x18\x04 \x01(\x03R\x0fintervalSeconds\x12\x1e\n" +
+	"\vlast_run_at\x18\x05 \x01(\tR\tlastRunAt\x12\x1e\n" +
+	"\vlast_job_id\x18\x06 \x01(\tR\tlastJobId\x12&\n" +
+	"\x0flast_run_result\x18\a \x01(\tR\rlastRunResult\x12(\n" +
+	"\x10last_run_message\x18\b \x01(\tR\x0elastRunMessage\x12\x1e\n" +
+	"\vnext_run_at\x18\t \x01(\tR\tnextRunAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"\x16\n" +
+	"\x14ListSchedulesRequest\"N\n" +
+	"\x15ListSchedulesResponse\x125\n" +
+	"\tschedules\x18\x01 \x03(\v2\x17.github.parser.ScheduleR\tschedules\"'\n" +
+	"\x15DeleteScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteScheduleResponse2\xff\x12\n" +
 	"\x13GithubParserService\x12`\n" +
 	"\x0fParseRepository\x12%.github.parser.ParseRepositoryRequest\x1a&.github.parser.ParseRepositoryResponse\x12c\n" +
 	"\x10ListRepositories\x12&.github.parser.ListRepositoriesRequest\x1a'.github.parser.ListRepositoriesResponse\x12T\n" +
-	"\vParseIssues\x12!.github.parser.ParseIssuesRequest\x1a\".github.parser.ParseIssuesResponse\x12Q\n" +
+	"\vParseIssues\x12!.github.parser.ParseIssuesRequest\x1a\".github.parser.ParseIssuesResponse\x12R\n" +
+	"\x11ParseIssuesStream\x12!.github.parser.ParseIssuesRequest\x1a\x18.github.parser.IssuePage0\x01\x12Q\n" +
 	"\n" +
 	"ListIssues\x12 .github.parser.ListIssuesRequest\x1a!.github.parser.ListIssuesResponse\x12f\n" +
-	"\x11ParsePullRequests\x12'.github.parser.ParsePullRequestsRequest\x1a(.github.parser.ParsePullRequestsResponse\x12c\n" +
+	"\x11ParsePullRequests\x12'.github.parser.ParsePullRequestsRequest\x1a(.github.parser.ParsePullRequestsResponse\x12d\n" +
+	"\x17ParsePullRequestsStream\x12'.github.parser.ParsePullRequestsRequest\x1a\x1e.github.parser.PullRequestPage0\x01\x12c\n" +
 	"\x10ListPullRequests\x12&.github.parser.ListPullRequestsRequest\x1a'.github.parser.ListPullRequestsResponse\x12N\n" +
 	"\tParseUser\x12\x1f.github.parser.ParseUserRequest\x1a .github.parser.ParseUserResponse\x12N\n" +
-	"\tListUsers\x12\x1f.github.parser.ListUsersRequest\x1a .github.parser.ListUsersResponse\x12`\n" +
-	"\x0fStartParsingJob\x12%.github.parser.StartParsingJobRequest\x1a&.github.parser.StartParsingJobResponse\x12l\n" +
-	"\x13GetParsingJobStatus\x12).github.parser.GetParsingJobStatusRequest\x1a*.github.parser.GetParsingJobStatusResponseBCZAgithub.com/Dhoini/GitHub_Parser/internal/infrastructure/api/protob\x06proto3"
+	"\tListUsers\x12\x1f.github.parser.ListUsersRequest\x1a .github.parser.ListUsersResponse\x12c\n" +
+	"\x10ListDependencies\x12&.github.parser.ListDependenciesRequest\x1a'.github.parser.ListDependenciesResponse\x12i\n" +
+	"\x12ListSecurityAlerts\x12(.github.parser.ListSecurityAlertsRequest\x1a).github.parser.ListSecurityAlertsResponse\x12`\n" +
+	"\x0fStartParsingJob\x12%.github.parser.StartParsingJobRequest\x1a&.github.parser.StartParsingJobResponse\x12o\n" +
+	"\x14StartBatchParsingJob\x12*.github.parser.StartBatchParsingJobRequest\x1a+.github.parser.StartBatchParsingJobResponse\x12l\n" +
+	"\x13GetParsingJobStatus\x12).github.parser.GetParsingJobStatusRequest\x1a*.github.parser.GetParsingJobStatusResponse\x12a\n" +
+	"\x13GetParsingJobReport\x12).github.parser.GetParsingJobReportRequest\x1a\x1f.github.parser.ParsingJobReport\x12`\n" +
+	"\x0fListParsingJobs\x12%.github.parser.ListParsingJobsRequest\x1a&.github.parser.ListParsingJobsResponse\x12S\n" +
+	"\x0fWatchParsingJob\x12%.github.parser.WatchParsingJobRequest\x1a\x17.github.parser.JobEvent0\x01\x12f\n" +
+	"\x10CancelParsingJob\x12&.github.parser.CancelParsingJobRequest\x1a*.github.parser.GetParsingJobStatusResponse\x12d\n" +
+	"\x0fPauseParsingJob\x12%.github.parser.PauseParsingJobRequest\x1a*.github.parser.GetParsingJobStatusResponse\x12f\n" +
+	"\x10ResumeParsingJob\x12&.github.parser.ResumeParsingJobRequest\x1a*.github.parser.GetParsingJobStatusResponse\x12d\n" +
+	"\x0fRetryParsingJob\x12%.github.parser.RetryParsingJobRequest\x1a*.github.parser.GetParsingJobStatusResponse\x12O\n" +
+	"\x0eCreateSchedule\x12$.github.parser.CreateScheduleRequest\x1a\x17.github.parser.Schedule\x12Z\n" +
+	"\rListSchedules\x12#.github.parser.ListSchedulesRequest\x1a$.github.parser.ListSchedulesResponse\x12]\n" +
+	"\x0eDeleteSchedule\x12$.github.parser.DeleteScheduleRequest\x1a%.github.parser.DeleteScheduleResponseBCZAgithub.com/Dhoini/GitHub_Parser/internal/infrastructure/api/protob\x06proto3"
 
 var (
 	file_internal_infrastructure_api_proto_github_parser_proto_rawDescOnce sync.Once
@@ -1792,67 +6123,183 @@ func file_internal_infrastructure_api_proto_github_parser_proto_rawDescGZIP() []
 	return file_internal_infrastructure_api_proto_github_parser_proto_rawDescData
 }
 
-var file_internal_infrastructure_api_proto_github_parser_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_internal_infrastructure_api_proto_github_parser_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
 var file_internal_infrastructure_api_proto_github_parser_proto_goTypes = []any{
-	(*ParseRepositoryRequest)(nil),      // 0: github.parser.ParseRepositoryRequest
-	(*ParseRepositoryResponse)(nil),     // 1: github.parser.ParseRepositoryResponse
-	(*ListRepositoriesRequest)(nil),     // 2: github.parser.ListRepositoriesRequest
-	(*ListRepositoriesResponse)(nil),    // 3: github.parser.ListRepositoriesResponse
-	(*Repository)(nil),                  // 4: github.parser.Repository
-	(*ParseIssuesRequest)(nil),          // 5: github.parser.ParseIssuesRequest
-	(*ParseIssuesResponse)(nil),         // 6: github.parser.ParseIssuesResponse
-	(*ListIssuesRequest)(nil),           // 7: github.parser.ListIssuesRequest
-	(*ListIssuesResponse)(nil),          // 8: github.parser.ListIssuesResponse
-	(*Issue)(nil),                       // 9: github.parser.Issue
-	(*ParsePullRequestsRequest)(nil),    // 10: github.parser.ParsePullRequestsRequest
-	(*ParsePullRequestsResponse)(nil),   // 11: github.parser.ParsePullRequestsResponse
-	(*ListPullRequestsRequest)(nil),     // 12: github.parser.ListPullRequestsRequest
-	(*ListPullRequestsResponse)(nil),    // 13: github.parser.ListPullRequestsResponse
-	(*PullRequest)(nil),                 // 14: github.parser.PullRequest
-	(*ParseUserRequest)(nil),            // 15: github.parser.ParseUserRequest
-	(*ParseUserResponse)(nil),           // 16: github.parser.ParseUserResponse
-	(*ListUsersRequest)(nil),            // 17: github.parser.ListUsersRequest
-	(*ListUsersResponse)(nil),           // 18: github.parser.ListUsersResponse
-	(*User)(nil),                        // 19: github.parser.User
-	(*StartParsingJobRequest)(nil),      // 20: github.parser.StartParsingJobRequest
-	(*StartParsingJobResponse)(nil),     // 21: github.parser.StartParsingJobResponse
-	(*GetParsingJobStatusRequest)(nil),  // 22: github.parser.GetParsingJobStatusRequest
-	(*GetParsingJobStatusResponse)(nil), // 23: github.parser.GetParsingJobStatusResponse
+	(*ParseRepositoryRequest)(nil),       // 0: github.parser.ParseRepositoryRequest
+	(*ParseRepositoryResponse)(nil),      // 1: github.parser.ParseRepositoryResponse
+	(*ListRepositoriesRequest)(nil),      // 2: github.parser.ListRepositoriesRequest
+	(*ListRepositoriesResponse)(nil),     // 3: github.parser.ListRepositoriesResponse
+	(*Repository)(nil),                   // 4: github.parser.Repository
+	(*ParseIssuesRequest)(nil),           // 5: github.parser.ParseIssuesRequest
+	(*ParseIssuesResponse)(nil),          // 6: github.parser.ParseIssuesResponse
+	(*IssuePage)(nil),                    // 7: github.parser.IssuePage
+	(*SaveResult)(nil),                   // 8: github.parser.SaveResult
+	(*ItemFailure)(nil),                  // 9: github.parser.ItemFailure
+	(*ListIssuesRequest)(nil),            // 10: github.parser.ListIssuesRequest
+	(*ListIssuesResponse)(nil),           // 11: github.parser.ListIssuesResponse
+	(*Issue)(nil),                        // 12: github.parser.Issue
+	(*ParsePullRequestsRequest)(nil),     // 13: github.parser.ParsePullRequestsRequest
+	(*ParsePullRequestsResponse)(nil),    // 14: github.parser.ParsePullRequestsResponse
+	(*PullRequestPage)(nil),              // 15: github.parser.PullRequestPage
+	(*ListPullRequestsRequest)(nil),      // 16: github.parser.ListPullRequestsRequest
+	(*ListPullRequestsResponse)(nil),     // 17: github.parser.ListPullRequestsResponse
+	(*PullRequest)(nil),                  // 18: github.parser.PullRequest
+	(*CISummary)(nil),                    // 19: github.parser.CISummary
+	(*ParseUserRequest)(nil),             // 20: github.parser.ParseUserRequest
+	(*ParseUserResponse)(nil),            // 21: github.parser.ParseUserResponse
+	(*ListUsersRequest)(nil),             // 22: github.parser.ListUsersRequest
+	(*ListUsersResponse)(nil),            // 23: github.parser.ListUsersResponse
+	(*User)(nil),                         // 24: github.parser.User
+	(*ListDependenciesRequest)(nil),      // 25: github.parser.ListDependenciesRequest
+	(*ListDependenciesResponse)(nil),     // 26: github.parser.ListDependenciesResponse
+	(*Dependency)(nil),                   // 27: github.parser.Dependency
+	(*ListSecurityAlertsRequest)(nil),    // 28: github.parser.ListSecurityAlertsRequest
+	(*ListSecurityAlertsResponse)(nil),   // 29: github.parser.ListSecurityAlertsResponse
+	(*SecurityAlert)(nil),                // 30: github.parser.SecurityAlert
+	(*RepositoryAlertCount)(nil),         // 31: github.parser.RepositoryAlertCount
+	(*StartParsingJobRequest)(nil),       // 32: github.parser.StartParsingJobRequest
+	(*JobOptions)(nil),                   // 33: github.parser.JobOptions
+	(*RetryPolicy)(nil),                  // 34: github.parser.RetryPolicy
+	(*StartParsingJobResponse)(nil),      // 35: github.parser.StartParsingJobResponse
+	(*ParsingEstimate)(nil),              // 36: github.parser.ParsingEstimate
+	(*StepEstimate)(nil),                 // 37: github.parser.StepEstimate
+	(*StartBatchParsingJobRequest)(nil),  // 38: github.parser.StartBatchParsingJobRequest
+	(*StartBatchParsingJobResponse)(nil), // 39: github.parser.StartBatchParsingJobResponse
+	(*GetParsingJobStatusRequest)(nil),   // 40: github.parser.GetParsingJobStatusRequest
+	(*GetParsingJobStatusResponse)(nil),  // 41: github.parser.GetParsingJobStatusResponse
+	(*CallbackDelivery)(nil),             // 42: github.parser.CallbackDelivery
+	(*CallbackAttempt)(nil),              // 43: github.parser.CallbackAttempt
+	(*JobCheckpoint)(nil),                // 44: github.parser.JobCheckpoint
+	(*BatchSummary)(nil),                 // 45: github.parser.BatchSummary
+	(*BatchChild)(nil),                   // 46: github.parser.BatchChild
+	(*JobAttempt)(nil),                   // 47: github.parser.JobAttempt
+	(*ParsingJobParams)(nil),             // 48: github.parser.ParsingJobParams
+	(*JobStepResult)(nil),                // 49: github.parser.JobStepResult
+	(*StepUsage)(nil),                    // 50: github.parser.StepUsage
+	(*GetParsingJobReportRequest)(nil),   // 51: github.parser.GetParsingJobReportRequest
+	(*ParsingJobReport)(nil),             // 52: github.parser.ParsingJobReport
+	(*ListParsingJobsRequest)(nil),       // 53: github.parser.ListParsingJobsRequest
+	(*ListParsingJobsResponse)(nil),      // 54: github.parser.ListParsingJobsResponse
+	(*WatchParsingJobRequest)(nil),       // 55: github.parser.WatchParsingJobRequest
+	(*JobEvent)(nil),                     // 56: github.parser.JobEvent
+	(*CancelParsingJobRequest)(nil),      // 57: github.parser.CancelParsingJobRequest
+	(*PauseParsingJobRequest)(nil),       // 58: github.parser.PauseParsingJobRequest
+	(*ResumeParsingJobRequest)(nil),      // 59: github.parser.ResumeParsingJobRequest
+	(*RetryParsingJobRequest)(nil),       // 60: github.parser.RetryParsingJobRequest
+	(*JobResults)(nil),                   // 61: github.parser.JobResults
+	(*ReconciliationResult)(nil),         // 62: github.parser.ReconciliationResult
+	(*ReconciledItem)(nil),               // 63: github.parser.ReconciledItem
+	(*CreateScheduleRequest)(nil),        // 64: github.parser.CreateScheduleRequest
+	(*Schedule)(nil),                     // 65: github.parser.Schedule
+	(*ListSchedulesRequest)(nil),         // 66: github.parser.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),        // 67: github.parser.ListSchedulesResponse
+	(*DeleteScheduleRequest)(nil),        // 68: github.parser.DeleteScheduleRequest
+	(*DeleteScheduleResponse)(nil),       // 69: github.parser.DeleteScheduleResponse
 }
 var file_internal_infrastructure_api_proto_github_parser_proto_depIdxs = []int32{
 	4,  // 0: github.parser.ParseRepositoryResponse.repository:type_name -> github.parser.Repository
 	4,  // 1: github.parser.ListRepositoriesResponse.repositories:type_name -> github.parser.Repository
-	9,  // 2: github.parser.ParseIssuesResponse.issues:type_name -> github.parser.Issue
-	9,  // 3: github.parser.ListIssuesResponse.issues:type_name -> github.parser.Issue
-	14, // 4: github.parser.ParsePullRequestsResponse.pull_requests:type_name -> github.parser.PullRequest
-	14, // 5: github.parser.ListPullRequestsResponse.pull_requests:type_name -> github.parser.PullRequest
-	19, // 6: github.parser.ParseUserResponse.user:type_name -> github.parser.User
-	19, // 7: github.parser.ListUsersResponse.users:type_name -> github.parser.User
-	0,  // 8: github.parser.GithubParserService.ParseRepository:input_type -> github.parser.ParseRepositoryRequest
-	2,  // 9: github.parser.GithubParserService.ListRepositories:input_type -> github.parser.ListRepositoriesRequest
-	5,  // 10: github.parser.GithubParserService.ParseIssues:input_type -> github.parser.ParseIssuesRequest
-	7,  // 11: github.parser.GithubParserService.ListIssues:input_type -> github.parser.ListIssuesRequest
-	10, // 12: github.parser.GithubParserService.ParsePullRequests:input_type -> github.parser.ParsePullRequestsRequest
-	12, // 13: github.parser.GithubParserService.ListPullRequests:input_type -> github.parser.ListPullRequestsRequest
-	15, // 14: github.parser.GithubParserService.ParseUser:input_type -> github.parser.ParseUserRequest
-	17, // 15: github.parser.GithubParserService.ListUsers:input_type -> github.parser.ListUsersRequest
-	20, // 16: github.parser.GithubParserService.StartParsingJob:input_type -> github.parser.StartParsingJobRequest
-	22, // 17: github.parser.GithubParserService.GetParsingJobStatus:input_type -> github.parser.GetParsingJobStatusRequest
-	1,  // 18: github.parser.GithubParserService.ParseRepository:output_type -> github.parser.ParseRepositoryResponse
-	3,  // 19: github.parser.GithubParserService.ListRepositories:output_type -> github.parser.ListRepositoriesResponse
-	6,  // 20: github.parser.GithubParserService.ParseIssues:output_type -> github.parser.ParseIssuesResponse
-	8,  // 21: github.parser.GithubParserService.ListIssues:output_type -> github.parser.ListIssuesResponse
-	11, // 22: github.parser.GithubParserService.ParsePullRequests:output_type -> github.parser.ParsePullRequestsResponse
-	13, // 23: github.parser.GithubParserService.ListPullRequests:output_type -> github.parser.ListPullRequestsResponse
-	16, // 24: github.parser.GithubParserService.ParseUser:output_type -> github.parser.ParseUserResponse
-	18, // 25: github.parser.GithubParserService.ListUsers:output_type -> github.parser.ListUsersResponse
-	21, // 26: github.parser.GithubParserService.StartParsingJob:output_type -> github.parser.StartParsingJobResponse
-	23, // 27: github.parser.GithubParserService.GetParsingJobStatus:output_type -> github.parser.GetParsingJobStatusResponse
-	18, // [18:28] is the sub-list for method output_type
-	8,  // [8:18] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	8,  // 2: github.parser.ParseIssuesResponse.result:type_name -> github.parser.SaveResult
+	12, // 3: github.parser.IssuePage.issues:type_name -> github.parser.Issue
+	8,  // 4: github.parser.IssuePage.result:type_name -> github.parser.SaveResult
+	9,  // 5: github.parser.SaveResult.failures:type_name -> github.parser.ItemFailure
+	12, // 6: github.parser.ListIssuesResponse.issues:type_name -> github.parser.Issue
+	8,  // 7: github.parser.ParsePullRequestsResponse.result:type_name -> github.parser.SaveResult
+	18, // 8: github.parser.PullRequestPage.pull_requests:type_name -> github.parser.PullRequest
+	8,  // 9: github.parser.PullRequestPage.result:type_name -> github.parser.SaveResult
+	18, // 10: github.parser.ListPullRequestsResponse.pull_requests:type_name -> github.parser.PullRequest
+	19, // 11: github.parser.PullRequest.ci:type_name -> github.parser.CISummary
+	24, // 12: github.parser.ParseUserResponse.user:type_name -> github.parser.User
+	24, // 13: github.parser.ListUsersResponse.users:type_name -> github.parser.User
+	27, // 14: github.parser.ListDependenciesResponse.dependencies:type_name -> github.parser.Dependency
+	30, // 15: github.parser.ListSecurityAlertsResponse.alerts:type_name -> github.parser.SecurityAlert
+	31, // 16: github.parser.ListSecurityAlertsResponse.open_alert_counts:type_name -> github.parser.RepositoryAlertCount
+	34, // 17: github.parser.StartParsingJobRequest.retry:type_name -> github.parser.RetryPolicy
+	33, // 18: github.parser.StartParsingJobRequest.options:type_name -> github.parser.JobOptions
+	36, // 19: github.parser.StartParsingJobResponse.estimate:type_name -> github.parser.ParsingEstimate
+	37, // 20: github.parser.ParsingEstimate.steps:type_name -> github.parser.StepEstimate
+	34, // 21: github.parser.StartBatchParsingJobRequest.retry:type_name -> github.parser.RetryPolicy
+	33, // 22: github.parser.StartBatchParsingJobRequest.options:type_name -> github.parser.JobOptions
+	62, // 23: github.parser.GetParsingJobStatusResponse.reconciliation:type_name -> github.parser.ReconciliationResult
+	61, // 24: github.parser.GetParsingJobStatusResponse.results:type_name -> github.parser.JobResults
+	48, // 25: github.parser.GetParsingJobStatusResponse.params:type_name -> github.parser.ParsingJobParams
+	49, // 26: github.parser.GetParsingJobStatusResponse.steps:type_name -> github.parser.JobStepResult
+	47, // 27: github.parser.GetParsingJobStatusResponse.attempt_history:type_name -> github.parser.JobAttempt
+	45, // 28: github.parser.GetParsingJobStatusResponse.batch:type_name -> github.parser.BatchSummary
+	46, // 29: github.parser.GetParsingJobStatusResponse.children:type_name -> github.parser.BatchChild
+	44, // 30: github.parser.GetParsingJobStatusResponse.checkpoints:type_name -> github.parser.JobCheckpoint
+	42, // 31: github.parser.GetParsingJobStatusResponse.callbacks:type_name -> github.parser.CallbackDelivery
+	43, // 32: github.parser.CallbackDelivery.attempts:type_name -> github.parser.CallbackAttempt
+	61, // 33: github.parser.BatchChild.results:type_name -> github.parser.JobResults
+	34, // 34: github.parser.ParsingJobParams.retry:type_name -> github.parser.RetryPolicy
+	33, // 35: github.parser.ParsingJobParams.options:type_name -> github.parser.JobOptions
+	50, // 36: github.parser.JobStepResult.usage:type_name -> github.parser.StepUsage
+	9,  // 37: github.parser.StepUsage.failed_items:type_name -> github.parser.ItemFailure
+	49, // 38: github.parser.ParsingJobReport.steps:type_name -> github.parser.JobStepResult
+	50, // 39: github.parser.ParsingJobReport.usage:type_name -> github.parser.StepUsage
+	41, // 40: github.parser.ListParsingJobsResponse.jobs:type_name -> github.parser.GetParsingJobStatusResponse
+	49, // 41: github.parser.JobEvent.step:type_name -> github.parser.JobStepResult
+	41, // 42: github.parser.JobEvent.job:type_name -> github.parser.GetParsingJobStatusResponse
+	63, // 43: github.parser.ReconciliationResult.deleted:type_name -> github.parser.ReconciledItem
+	63, // 44: github.parser.ReconciliationResult.transferred:type_name -> github.parser.ReconciledItem
+	32, // 45: github.parser.CreateScheduleRequest.job:type_name -> github.parser.StartParsingJobRequest
+	48, // 46: github.parser.Schedule.params:type_name -> github.parser.ParsingJobParams
+	65, // 47: github.parser.ListSchedulesResponse.schedules:type_name -> github.parser.Schedule
+	0,  // 48: github.parser.GithubParserService.ParseRepository:input_type -> github.parser.ParseRepositoryRequest
+	2,  // 49: github.parser.GithubParserService.ListRepositories:input_type -> github.parser.ListRepositoriesRequest
+	5,  // 50: github.parser.GithubParserService.ParseIssues:input_type -> github.parser.ParseIssuesRequest
+	5,  // 51: github.parser.GithubParserService.ParseIssuesStream:input_type -> github.parser.ParseIssuesRequest
+	10, // 52: github.parser.GithubParserService.ListIssues:input_type -> github.parser.ListIssuesRequest
+	13, // 53: github.parser.GithubParserService.ParsePullRequests:input_type -> github.parser.ParsePullRequestsRequest
+	13, // 54: github.parser.GithubParserService.ParsePullRequestsStream:input_type -> github.parser.ParsePullRequestsRequest
+	16, // 55: github.parser.GithubParserService.ListPullRequests:input_type -> github.parser.ListPullRequestsRequest
+	20, // 56: github.parser.GithubParserService.ParseUser:input_type -> github.parser.ParseUserRequest
+	22, // 57: github.parser.GithubParserService.ListUsers:input_type -> github.parser.ListUsersRequest
+	25, // 58: github.parser.GithubParserService.ListDependencies:input_type -> github.parser.ListDependenciesRequest
+	28, // 59: github.parser.GithubParserService.ListSecurityAlerts:input_type -> github.parser.ListSecurityAlertsRequest
+	32, // 60: github.parser.GithubParserService.StartParsingJob:input_type -> github.parser.StartParsingJobRequest
+	38, // 61: github.parser.GithubParserService.StartBatchParsingJob:input_type -> github.parser.StartBatchParsingJobRequest
+	40, // 62: github.parser.GithubParserService.GetParsingJobStatus:input_type -> github.parser.GetParsingJobStatusRequest
+	51, // 63: github.parser.GithubParserService.GetParsingJobReport:input_type -> github.parser.GetParsingJobReportRequest
+	53, // 64: github.parser.GithubParserService.ListParsingJobs:input_type -> github.parser.ListParsingJobsRequest
+	55, // 65: github.parser.GithubParserService.WatchParsingJob:input_type -> github.parser.WatchParsingJobRequest
+	57, // 66: github.parser.GithubParserService.CancelParsingJob:input_type -> github.parser.CancelParsingJobRequest
+	58, // 67: github.parser.GithubParserService.PauseParsingJob:input_type -> github.parser.PauseParsingJobRequest
+	59, // 68: github.parser.GithubParserService.ResumeParsingJob:input_type -> github.parser.ResumeParsingJobRequest
+	60, // 69: github.parser.GithubParserService.RetryParsingJob:input_type -> github.parser.RetryParsingJobRequest
+	64, // 70: github.parser.GithubParserService.CreateSchedule:input_type -> github.parser.CreateScheduleRequest
+	66, // 71: github.parser.GithubParserService.ListSchedules:input_type -> github.parser.ListSchedulesRequest
+	68, // 72: github.parser.GithubParserService.DeleteSchedule:input_type -> github.parser.DeleteScheduleRequest
+	1,  // 73: github.parser.GithubParserService.ParseRepository:output_type -> github.parser.ParseRepositoryResponse
+	3,  // 74: github.parser.GithubParserService.ListRepositories:output_type -> github.parser.ListRepositoriesResponse
+	6,  // 75: github.parser.GithubParserService.ParseIssues:output_type -> github.parser.ParseIssuesResponse
+	7,  // 76: github.parser.GithubParserService.ParseIssuesStream:output_type -> github.parser.IssuePage
+	11, // 77: github.parser.GithubParserService.ListIssues:output_type -> github.parser.ListIssuesResponse
+	14, // 78: github.parser.GithubParserService.ParsePullRequests:output_type -> github.parser.ParsePullRequestsResponse
+	15, // 79: github.parser.GithubParserService.ParsePullRequestsStream:output_type -> github.parser.PullRequestPage
+	17, // 80: github.parser.GithubParserService.ListPullRequests:output_type -> github.parser.ListPullRequestsResponse
+	21, // 81: github.parser.GithubParserService.ParseUser:output_type -> github.parser.ParseUserResponse
+	23, // 82: github.parser.GithubParserService.ListUsers:output_type -> github.parser.ListUsersResponse
+	26, // 83: github.parser.GithubParserService.ListDependencies:output_type -> github.parser.ListDependenciesResponse
+	29, // 84: github.parser.GithubParserService.ListSecurityAlerts:output_type -> github.parser.ListSecurityAlertsResponse
+	35, // 85: github.parser.GithubParserService.StartParsingJob:output_type -> github.parser.StartParsingJobResponse
+	39, // 86: github.parser.GithubParserService.StartBatchParsingJob:output_type -> github.parser.StartBatchParsingJobResponse
+	41, // 87: github.parser.GithubParserService.GetParsingJobStatus:output_type -> github.parser.GetParsingJobStatusResponse
+	52, // 88: github.parser.GithubParserService.GetParsingJobReport:output_type -> github.parser.ParsingJobReport
+	54, // 89: github.parser.GithubParserService.ListParsingJobs:output_type -> github.parser.ListParsingJobsResponse
+	56, // 90: github.parser.GithubParserService.WatchParsingJob:output_type -> github.parser.JobEvent
+	41, // 91: github.parser.GithubParserService.CancelParsingJob:output_type -> github.parser.GetParsingJobStatusResponse
+	41, // 92: github.parser.GithubParserService.PauseParsingJob:output_type -> github.parser.GetParsingJobStatusResponse
+	41, // 93: github.parser.GithubParserService.ResumeParsingJob:output_type -> github.parser.GetParsingJobStatusResponse
+	41, // 94: github.parser.GithubParserService.RetryParsingJob:output_type -> github.parser.GetParsingJobStatusResponse
+	65, // 95: github.parser.GithubParserService.CreateSchedule:output_type -> github.parser.Schedule
+	67, // 96: github.parser.GithubParserService.ListSchedules:output_type -> github.parser.ListSchedulesResponse
+	69, // 97: github.parser.GithubParserService.DeleteSchedule:output_type -> github.parser.DeleteScheduleResponse
+	73, // [73:98] is the sub-list for method output_type
+	48, // [48:73] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_internal_infrastructure_api_proto_github_parser_proto_init() }
@@ -1866,7 +6313,7 @@ func file_internal_infrastructure_api_proto_github_parser_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_infrastructure_api_proto_github_parser_proto_rawDesc), len(file_internal_infrastructure_api_proto_github_parser_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
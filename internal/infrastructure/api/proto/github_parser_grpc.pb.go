@@ -19,16 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GithubParserService_ParseRepository_FullMethodName     = "/github.parser.GithubParserService/ParseRepository"
-	GithubParserService_ListRepositories_FullMethodName    = "/github.parser.GithubParserService/ListRepositories"
-	GithubParserService_ParseIssues_FullMethodName         = "/github.parser.GithubParserService/ParseIssues"
-	GithubParserService_ListIssues_FullMethodName          = "/github.parser.GithubParserService/ListIssues"
-	GithubParserService_ParsePullRequests_FullMethodName   = "/github.parser.GithubParserService/ParsePullRequests"
-	GithubParserService_ListPullRequests_FullMethodName    = "/github.parser.GithubParserService/ListPullRequests"
-	GithubParserService_ParseUser_FullMethodName           = "/github.parser.GithubParserService/ParseUser"
-	GithubParserService_ListUsers_FullMethodName           = "/github.parser.GithubParserService/ListUsers"
-	GithubParserService_StartParsingJob_FullMethodName     = "/github.parser.GithubParserService/StartParsingJob"
-	GithubParserService_GetParsingJobStatus_FullMethodName = "/github.parser.GithubParserService/GetParsingJobStatus"
+	GithubParserService_ParseRepository_FullMethodName         = "/github.parser.GithubParserService/ParseRepository"
+	GithubParserService_ListRepositories_FullMethodName        = "/github.parser.GithubParserService/ListRepositories"
+	GithubParserService_ParseIssues_FullMethodName             = "/github.parser.GithubParserService/ParseIssues"
+	GithubParserService_ParseIssuesStream_FullMethodName       = "/github.parser.GithubParserService/ParseIssuesStream"
+	GithubParserService_ListIssues_FullMethodName              = "/github.parser.GithubParserService/ListIssues"
+	GithubParserService_ParsePullRequests_FullMethodName       = "/github.parser.GithubParserService/ParsePullRequests"
+	GithubParserService_ParsePullRequestsStream_FullMethodName = "/github.parser.GithubParserService/ParsePullRequestsStream"
+	GithubParserService_ListPullRequests_FullMethodName        = "/github.parser.GithubParserService/ListPullRequests"
+	GithubParserService_ParseUser_FullMethodName               = "/github.parser.GithubParserService/ParseUser"
+	GithubParserService_ListUsers_FullMethodName               = "/github.parser.GithubParserService/ListUsers"
+	GithubParserService_ListDependencies_FullMethodName        = "/github.parser.GithubParserService/ListDependencies"
+	GithubParserService_ListSecurityAlerts_FullMethodName      = "/github.parser.GithubParserService/ListSecurityAlerts"
+	GithubParserService_StartParsingJob_FullMethodName         = "/github.parser.GithubParserService/StartParsingJob"
+	GithubParserService_StartBatchParsingJob_FullMethodName    = "/github.parser.GithubParserService/StartBatchParsingJob"
+	GithubParserService_GetParsingJobStatus_FullMethodName     = "/github.parser.GithubParserService/GetParsingJobStatus"
+	GithubParserService_GetParsingJobReport_FullMethodName     = "/github.parser.GithubParserService/GetParsingJobReport"
+	GithubParserService_ListParsingJobs_FullMethodName         = "/github.parser.GithubParserService/ListParsingJobs"
+	GithubParserService_WatchParsingJob_FullMethodName         = "/github.parser.GithubParserService/WatchParsingJob"
+	GithubParserService_CancelParsingJob_FullMethodName        = "/github.parser.GithubParserService/CancelParsingJob"
+	GithubParserService_PauseParsingJob_FullMethodName         = "/github.parser.GithubParserService/PauseParsingJob"
+	GithubParserService_ResumeParsingJob_FullMethodName        = "/github.parser.GithubParserService/ResumeParsingJob"
+	GithubParserService_RetryParsingJob_FullMethodName         = "/github.parser.GithubParserService/RetryParsingJob"
+	GithubParserService_CreateSchedule_FullMethodName          = "/github.parser.GithubParserService/CreateSchedule"
+	GithubParserService_ListSchedules_FullMethodName           = "/github.parser.GithubParserService/ListSchedules"
+	GithubParserService_DeleteSchedule_FullMethodName          = "/github.parser.GithubParserService/DeleteSchedule"
 )
 
 // GithubParserServiceClient is the client API for GithubParserService service.
@@ -38,18 +53,37 @@ type GithubParserServiceClient interface {
 	// Репозитории
 	ParseRepository(ctx context.Context, in *ParseRepositoryRequest, opts ...grpc.CallOption) (*ParseRepositoryResponse, error)
 	ListRepositories(ctx context.Context, in *ListRepositoriesRequest, opts ...grpc.CallOption) (*ListRepositoriesResponse, error)
-	// Issues
+	// Issues. ParseIssues возвращает только число сохранённых issues,
+	// ParseIssuesStream дополнительно отдаёт их постранично по мере сохранения
 	ParseIssues(ctx context.Context, in *ParseIssuesRequest, opts ...grpc.CallOption) (*ParseIssuesResponse, error)
+	ParseIssuesStream(ctx context.Context, in *ParseIssuesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IssuePage], error)
 	ListIssues(ctx context.Context, in *ListIssuesRequest, opts ...grpc.CallOption) (*ListIssuesResponse, error)
-	// Pull Requests
+	// Pull Requests, так же как issues
 	ParsePullRequests(ctx context.Context, in *ParsePullRequestsRequest, opts ...grpc.CallOption) (*ParsePullRequestsResponse, error)
+	ParsePullRequestsStream(ctx context.Context, in *ParsePullRequestsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PullRequestPage], error)
 	ListPullRequests(ctx context.Context, in *ListPullRequestsRequest, opts ...grpc.CallOption) (*ListPullRequestsResponse, error)
 	// Пользователи
 	ParseUser(ctx context.Context, in *ParseUserRequest, opts ...grpc.CallOption) (*ParseUserResponse, error)
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// Зависимости
+	ListDependencies(ctx context.Context, in *ListDependenciesRequest, opts ...grpc.CallOption) (*ListDependenciesResponse, error)
+	// Уязвимости
+	ListSecurityAlerts(ctx context.Context, in *ListSecurityAlertsRequest, opts ...grpc.CallOption) (*ListSecurityAlertsResponse, error)
 	// Задачи парсинга
 	StartParsingJob(ctx context.Context, in *StartParsingJobRequest, opts ...grpc.CallOption) (*StartParsingJobResponse, error)
+	StartBatchParsingJob(ctx context.Context, in *StartBatchParsingJobRequest, opts ...grpc.CallOption) (*StartBatchParsingJobResponse, error)
 	GetParsingJobStatus(ctx context.Context, in *GetParsingJobStatusRequest, opts ...grpc.CallOption) (*GetParsingJobStatusResponse, error)
+	GetParsingJobReport(ctx context.Context, in *GetParsingJobReportRequest, opts ...grpc.CallOption) (*ParsingJobReport, error)
+	ListParsingJobs(ctx context.Context, in *ListParsingJobsRequest, opts ...grpc.CallOption) (*ListParsingJobsResponse, error)
+	WatchParsingJob(ctx context.Context, in *WatchParsingJobRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobEvent], error)
+	CancelParsingJob(ctx context.Context, in *CancelParsingJobRequest, opts ...grpc.CallOption) (*GetParsingJobStatusResponse, error)
+	PauseParsingJob(ctx context.Context, in *PauseParsingJobRequest, opts ...grpc.CallOption) (*GetParsingJobStatusResponse, error)
+	ResumeParsingJob(ctx context.Context, in *ResumeParsingJobRequest, opts ...grpc.CallOption) (*GetParsingJobStatusResponse, error)
+	RetryParsingJob(ctx context.Context, in *RetryParsingJobRequest, opts ...grpc.CallOption) (*GetParsingJobStatusResponse, error)
+	// Расписания задач парсинга
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
 }
 
 type githubParserServiceClient struct {
@@ -90,6 +124,25 @@ func (c *githubParserServiceClient) ParseIssues(ctx context.Context, in *ParseIs
 	return out, nil
 }
 
+func (c *githubParserServiceClient) ParseIssuesStream(ctx context.Context, in *ParseIssuesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IssuePage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GithubParserService_ServiceDesc.Streams[0], GithubParserService_ParseIssuesStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ParseIssuesRequest, IssuePage]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GithubParserService_ParseIssuesStreamClient = grpc.ServerStreamingClient[IssuePage]
+
 func (c *githubParserServiceClient) ListIssues(ctx context.Context, in *ListIssuesRequest, opts ...grpc.CallOption) (*ListIssuesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListIssuesResponse)
@@ -110,6 +163,25 @@ func (c *githubParserServiceClient) ParsePullRequests(ctx context.Context, in *P
 	return out, nil
 }
 
+func (c *githubParserServiceClient) ParsePullRequestsStream(ctx context.Context, in *ParsePullRequestsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PullRequestPage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GithubParserService_ServiceDesc.Streams[1], GithubParserService_ParsePullRequestsStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ParsePullRequestsRequest, PullRequestPage]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GithubParserService_ParsePullRequestsStreamClient = grpc.ServerStreamingClient[PullRequestPage]
+
 func (c *githubParserServiceClient) ListPullRequests(ctx context.Context, in *ListPullRequestsRequest, opts ...grpc.CallOption) (*ListPullRequestsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPullRequestsResponse)
@@ -140,6 +212,26 @@ func (c *githubParserServiceClient) ListUsers(ctx context.Context, in *ListUsers
 	return out, nil
 }
 
+func (c *githubParserServiceClient) ListDependencies(ctx context.Context, in *ListDependenciesRequest, opts ...grpc.CallOption) (*ListDependenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDependenciesResponse)
+	err := c.cc.Invoke(ctx, GithubParserService_ListDependencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubParserServiceClient) ListSecurityAlerts(ctx context.Context, in *ListSecurityAlertsRequest, opts ...grpc.CallOption) (*ListSecurityAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSecurityAlertsResponse)
+	err := c.cc.Invoke(ctx, GithubParserService_ListSecurityAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *githubParserServiceClient) StartParsingJob(ctx context.Context, in *StartParsingJobRequest, opts ...grpc.CallOption) (*StartParsingJobResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StartParsingJobResponse)
@@ -150,6 +242,16 @@ func (c *githubParserServiceClient) StartParsingJob(ctx context.Context, in *Sta
 	return out, nil
 }
 
+func (c *githubParserServiceClient) StartBatchParsingJob(ctx context.Context, in *StartBatchParsingJobRequest, opts ...grpc.CallOption) (*StartBatchParsingJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartBatchParsingJobResponse)
+	err := c.cc.Invoke(ctx, GithubParserService_StartBatchParsingJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *githubParserServiceClient) GetParsingJobStatus(ctx context.Context, in *GetParsingJobStatusRequest, opts ...grpc.CallOption) (*GetParsingJobStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetParsingJobStatusResponse)
@@ -160,6 +262,115 @@ func (c *githubParserServiceClient) GetParsingJobStatus(ctx context.Context, in
 	return out, nil
 }
 
+func (c *githubParserServiceClient) GetParsingJobReport(ctx context.Context, in *GetParsingJobReportRequest, opts ...grpc.CallOption) (*ParsingJobReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParsingJobReport)
+	err := c.cc.Invoke(ctx, GithubParserService_GetParsingJobReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubParserServiceClient) ListParsingJobs(ctx context.Context, in *ListParsingJobsRequest, opts ...grpc.CallOption) (*ListParsingJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListParsingJobsResponse)
+	err := c.cc.Invoke(ctx, GithubParserService_ListParsingJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubParserServiceClient) WatchParsingJob(ctx context.Context, in *WatchParsingJobRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GithubParserService_ServiceDesc.Streams[2], GithubParserService_WatchParsingJob_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchParsingJobRequest, JobEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GithubParserService_WatchParsingJobClient = grpc.ServerStreamingClient[JobEvent]
+
+func (c *githubParserServiceClient) CancelParsingJob(ctx context.Context, in *CancelParsingJobRequest, opts ...grpc.CallOption) (*GetParsingJobStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetParsingJobStatusResponse)
+	err := c.cc.Invoke(ctx, GithubParserService_CancelParsingJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubParserServiceClient) PauseParsingJob(ctx context.Context, in *PauseParsingJobRequest, opts ...grpc.CallOption) (*GetParsingJobStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetParsingJobStatusResponse)
+	err := c.cc.Invoke(ctx, GithubParserService_PauseParsingJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubParserServiceClient) ResumeParsingJob(ctx context.Context, in *ResumeParsingJobRequest, opts ...grpc.CallOption) (*GetParsingJobStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetParsingJobStatusResponse)
+	err := c.cc.Invoke(ctx, GithubParserService_ResumeParsingJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubParserServiceClient) RetryParsingJob(ctx context.Context, in *RetryParsingJobRequest, opts ...grpc.CallOption) (*GetParsingJobStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetParsingJobStatusResponse)
+	err := c.cc.Invoke(ctx, GithubParserService_RetryParsingJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubParserServiceClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, GithubParserService_CreateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubParserServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, GithubParserService_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *githubParserServiceClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, GithubParserService_DeleteSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GithubParserServiceServer is the server API for GithubParserService service.
 // All implementations must embed UnimplementedGithubParserServiceServer
 // for forward compatibility.
@@ -167,18 +378,37 @@ type GithubParserServiceServer interface {
 	// Репозитории
 	ParseRepository(context.Context, *ParseRepositoryRequest) (*ParseRepositoryResponse, error)
 	ListRepositories(context.Context, *ListRepositoriesRequest) (*ListRepositoriesResponse, error)
-	// Issues
+	// Issues. ParseIssues возвращает только число сохранённых issues,
+	// ParseIssuesStream дополнительно отдаёт их постранично по мере сохранения
 	ParseIssues(context.Context, *ParseIssuesRequest) (*ParseIssuesResponse, error)
+	ParseIssuesStream(*ParseIssuesRequest, grpc.ServerStreamingServer[IssuePage]) error
 	ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error)
-	// Pull Requests
+	// Pull Requests, так же как issues
 	ParsePullRequests(context.Context, *ParsePullRequestsRequest) (*ParsePullRequestsResponse, error)
+	ParsePullRequestsStream(*ParsePullRequestsRequest, grpc.ServerStreamingServer[PullRequestPage]) error
 	ListPullRequests(context.Context, *ListPullRequestsRequest) (*ListPullRequestsResponse, error)
 	// Пользователи
 	ParseUser(context.Context, *ParseUserRequest) (*ParseUserResponse, error)
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// Зависимости
+	ListDependencies(context.Context, *ListDependenciesRequest) (*ListDependenciesResponse, error)
+	// Уязвимости
+	ListSecurityAlerts(context.Context, *ListSecurityAlertsRequest) (*ListSecurityAlertsResponse, error)
 	// Задачи парсинга
 	StartParsingJob(context.Context, *StartParsingJobRequest) (*StartParsingJobResponse, error)
+	StartBatchParsingJob(context.Context, *StartBatchParsingJobRequest) (*StartBatchParsingJobResponse, error)
 	GetParsingJobStatus(context.Context, *GetParsingJobStatusRequest) (*GetParsingJobStatusResponse, error)
+	GetParsingJobReport(context.Context, *GetParsingJobReportRequest) (*ParsingJobReport, error)
+	ListParsingJobs(context.Context, *ListParsingJobsRequest) (*ListParsingJobsResponse, error)
+	WatchParsingJob(*WatchParsingJobRequest, grpc.ServerStreamingServer[JobEvent]) error
+	CancelParsingJob(context.Context, *CancelParsingJobRequest) (*GetParsingJobStatusResponse, error)
+	PauseParsingJob(context.Context, *PauseParsingJobRequest) (*GetParsingJobStatusResponse, error)
+	ResumeParsingJob(context.Context, *ResumeParsingJobRequest) (*GetParsingJobStatusResponse, error)
+	RetryParsingJob(context.Context, *RetryParsingJobRequest) (*GetParsingJobStatusResponse, error)
+	// Расписания задач парсинга
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*Schedule, error)
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
 	mustEmbedUnimplementedGithubParserServiceServer()
 }
 
@@ -198,12 +428,18 @@ func (UnimplementedGithubParserServiceServer) ListRepositories(context.Context,
 func (UnimplementedGithubParserServiceServer) ParseIssues(context.Context, *ParseIssuesRequest) (*ParseIssuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ParseIssues not implemented")
 }
+func (UnimplementedGithubParserServiceServer) ParseIssuesStream(*ParseIssuesRequest, grpc.ServerStreamingServer[IssuePage]) error {
+	return status.Errorf(codes.Unimplemented, "method ParseIssuesStream not implemented")
+}
 func (UnimplementedGithubParserServiceServer) ListIssues(context.Context, *ListIssuesRequest) (*ListIssuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListIssues not implemented")
 }
 func (UnimplementedGithubParserServiceServer) ParsePullRequests(context.Context, *ParsePullRequestsRequest) (*ParsePullRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ParsePullRequests not implemented")
 }
+func (UnimplementedGithubParserServiceServer) ParsePullRequestsStream(*ParsePullRequestsRequest, grpc.ServerStreamingServer[PullRequestPage]) error {
+	return status.Errorf(codes.Unimplemented, "method ParsePullRequestsStream not implemented")
+}
 func (UnimplementedGithubParserServiceServer) ListPullRequests(context.Context, *ListPullRequestsRequest) (*ListPullRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPullRequests not implemented")
 }
@@ -213,12 +449,51 @@ func (UnimplementedGithubParserServiceServer) ParseUser(context.Context, *ParseU
 func (UnimplementedGithubParserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedGithubParserServiceServer) ListDependencies(context.Context, *ListDependenciesRequest) (*ListDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDependencies not implemented")
+}
+func (UnimplementedGithubParserServiceServer) ListSecurityAlerts(context.Context, *ListSecurityAlertsRequest) (*ListSecurityAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecurityAlerts not implemented")
+}
 func (UnimplementedGithubParserServiceServer) StartParsingJob(context.Context, *StartParsingJobRequest) (*StartParsingJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartParsingJob not implemented")
 }
+func (UnimplementedGithubParserServiceServer) StartBatchParsingJob(context.Context, *StartBatchParsingJobRequest) (*StartBatchParsingJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBatchParsingJob not implemented")
+}
 func (UnimplementedGithubParserServiceServer) GetParsingJobStatus(context.Context, *GetParsingJobStatusRequest) (*GetParsingJobStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetParsingJobStatus not implemented")
 }
+func (UnimplementedGithubParserServiceServer) GetParsingJobReport(context.Context, *GetParsingJobReportRequest) (*ParsingJobReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParsingJobReport not implemented")
+}
+func (UnimplementedGithubParserServiceServer) ListParsingJobs(context.Context, *ListParsingJobsRequest) (*ListParsingJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListParsingJobs not implemented")
+}
+func (UnimplementedGithubParserServiceServer) WatchParsingJob(*WatchParsingJobRequest, grpc.ServerStreamingServer[JobEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchParsingJob not implemented")
+}
+func (UnimplementedGithubParserServiceServer) CancelParsingJob(context.Context, *CancelParsingJobRequest) (*GetParsingJobStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelParsingJob not implemented")
+}
+func (UnimplementedGithubParserServiceServer) PauseParsingJob(context.Context, *PauseParsingJobRequest) (*GetParsingJobStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseParsingJob not implemented")
+}
+func (UnimplementedGithubParserServiceServer) ResumeParsingJob(context.Context, *ResumeParsingJobRequest) (*GetParsingJobStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeParsingJob not implemented")
+}
+func (UnimplementedGithubParserServiceServer) RetryParsingJob(context.Context, *RetryParsingJobRequest) (*GetParsingJobStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryParsingJob not implemented")
+}
+func (UnimplementedGithubParserServiceServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedGithubParserServiceServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedGithubParserServiceServer) DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
 func (UnimplementedGithubParserServiceServer) mustEmbedUnimplementedGithubParserServiceServer() {}
 func (UnimplementedGithubParserServiceServer) testEmbeddedByValue()                             {}
 
@@ -294,6 +569,17 @@ func _GithubParserService_ParseIssues_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GithubParserService_ParseIssuesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ParseIssuesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GithubParserServiceServer).ParseIssuesStream(m, &grpc.GenericServerStream[ParseIssuesRequest, IssuePage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GithubParserService_ParseIssuesStreamServer = grpc.ServerStreamingServer[IssuePage]
+
 func _GithubParserService_ListIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListIssuesRequest)
 	if err := dec(in); err != nil {
@@ -330,6 +616,17 @@ func _GithubParserService_ParsePullRequests_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GithubParserService_ParsePullRequestsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ParsePullRequestsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GithubParserServiceServer).ParsePullRequestsStream(m, &grpc.GenericServerStream[ParsePullRequestsRequest, PullRequestPage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GithubParserService_ParsePullRequestsStreamServer = grpc.ServerStreamingServer[PullRequestPage]
+
 func _GithubParserService_ListPullRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPullRequestsRequest)
 	if err := dec(in); err != nil {
@@ -384,6 +681,42 @@ func _GithubParserService_ListUsers_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GithubParserService_ListDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubParserServiceServer).ListDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GithubParserService_ListDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubParserServiceServer).ListDependencies(ctx, req.(*ListDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubParserService_ListSecurityAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecurityAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubParserServiceServer).ListSecurityAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GithubParserService_ListSecurityAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubParserServiceServer).ListSecurityAlerts(ctx, req.(*ListSecurityAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GithubParserService_StartParsingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartParsingJobRequest)
 	if err := dec(in); err != nil {
@@ -402,6 +735,24 @@ func _GithubParserService_StartParsingJob_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GithubParserService_StartBatchParsingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBatchParsingJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubParserServiceServer).StartBatchParsingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GithubParserService_StartBatchParsingJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubParserServiceServer).StartBatchParsingJob(ctx, req.(*StartBatchParsingJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GithubParserService_GetParsingJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetParsingJobStatusRequest)
 	if err := dec(in); err != nil {
@@ -420,6 +771,179 @@ func _GithubParserService_GetParsingJobStatus_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GithubParserService_GetParsingJobReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetParsingJobReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubParserServiceServer).GetParsingJobReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GithubParserService_GetParsingJobReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubParserServiceServer).GetParsingJobReport(ctx, req.(*GetParsingJobReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubParserService_ListParsingJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListParsingJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubParserServiceServer).ListParsingJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GithubParserService_ListParsingJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubParserServiceServer).ListParsingJobs(ctx, req.(*ListParsingJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubParserService_WatchParsingJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchParsingJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GithubParserServiceServer).WatchParsingJob(m, &grpc.GenericServerStream[WatchParsingJobRequest, JobEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GithubParserService_WatchParsingJobServer = grpc.ServerStreamingServer[JobEvent]
+
+func _GithubParserService_CancelParsingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelParsingJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubParserServiceServer).CancelParsingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GithubParserService_CancelParsingJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubParserServiceServer).CancelParsingJob(ctx, req.(*CancelParsingJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubParserService_PauseParsingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseParsingJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubParserServiceServer).PauseParsingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GithubParserService_PauseParsingJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubParserServiceServer).PauseParsingJob(ctx, req.(*PauseParsingJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubParserService_ResumeParsingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeParsingJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubParserServiceServer).ResumeParsingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GithubParserService_ResumeParsingJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubParserServiceServer).ResumeParsingJob(ctx, req.(*ResumeParsingJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubParserService_RetryParsingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryParsingJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubParserServiceServer).RetryParsingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GithubParserService_RetryParsingJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubParserServiceServer).RetryParsingJob(ctx, req.(*RetryParsingJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubParserService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubParserServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GithubParserService_CreateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubParserServiceServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubParserService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubParserServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GithubParserService_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubParserServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GithubParserService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GithubParserServiceServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GithubParserService_DeleteSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GithubParserServiceServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GithubParserService_ServiceDesc is the grpc.ServiceDesc for GithubParserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -459,15 +983,79 @@ var GithubParserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _GithubParserService_ListUsers_Handler,
 		},
+		{
+			MethodName: "ListDependencies",
+			Handler:    _GithubParserService_ListDependencies_Handler,
+		},
+		{
+			MethodName: "ListSecurityAlerts",
+			Handler:    _GithubParserService_ListSecurityAlerts_Handler,
+		},
 		{
 			MethodName: "StartParsingJob",
 			Handler:    _GithubParserService_StartParsingJob_Handler,
 		},
+		{
+			MethodName: "StartBatchParsingJob",
+			Handler:    _GithubParserService_StartBatchParsingJob_Handler,
+		},
 		{
 			MethodName: "GetParsingJobStatus",
 			Handler:    _GithubParserService_GetParsingJobStatus_Handler,
 		},
+		{
+			MethodName: "GetParsingJobReport",
+			Handler:    _GithubParserService_GetParsingJobReport_Handler,
+		},
+		{
+			MethodName: "ListParsingJobs",
+			Handler:    _GithubParserService_ListParsingJobs_Handler,
+		},
+		{
+			MethodName: "CancelParsingJob",
+			Handler:    _GithubParserService_CancelParsingJob_Handler,
+		},
+		{
+			MethodName: "PauseParsingJob",
+			Handler:    _GithubParserService_PauseParsingJob_Handler,
+		},
+		{
+			MethodName: "ResumeParsingJob",
+			Handler:    _GithubParserService_ResumeParsingJob_Handler,
+		},
+		{
+			MethodName: "RetryParsingJob",
+			Handler:    _GithubParserService_RetryParsingJob_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _GithubParserService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _GithubParserService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _GithubParserService_DeleteSchedule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ParseIssuesStream",
+			Handler:       _GithubParserService_ParseIssuesStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ParsePullRequestsStream",
+			Handler:       _GithubParserService_ParsePullRequestsStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchParsingJob",
+			Handler:       _GithubParserService_WatchParsingJob_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/infrastructure/api/proto/github_parser.proto",
 }
@@ -0,0 +1,293 @@
+// Package cassette provides an HTTP transport that records GitHub API traffic to a file
+// and replays it later, so code depending on the API can be exercised offline.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Mode selects whether a transport records or replays interactions
+type Mode int
+
+const (
+	ModeRecord Mode = iota
+	ModeReplay
+)
+
+// ParseMode converts "record" or "replay" into a Mode
+func ParseMode(s string) (Mode, error) {
+	switch s {
+	case "record":
+		return ModeRecord, nil
+	case "replay":
+		return ModeReplay, nil
+	default:
+		return 0, fmt.Errorf("cassette: unknown mode %q", s)
+	}
+}
+
+// scrubbedHeaders are never written to a cassette
+var scrubbedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Proxy-Authorization"}
+
+// Cassette is the on-disk list of recorded interactions
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded request/response pair
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the recorded part of an HTTP request
+type Request struct {
+	Method  string          `json:"method"`
+	URL     string          `json:"url"`
+	Headers http.Header     `json:"headers,omitempty"`
+	Body    json.RawMessage `json:"body,omitempty"`
+}
+
+// Response is the recorded part of an HTTP response
+type Response struct {
+	StatusCode int             `json:"status_code"`
+	Headers    http.Header     `json:"headers,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+}
+
+// Transport is an http.RoundTripper that records interactions through the next transport
+// or replays them from a cassette file
+type Transport struct {
+	mode Mode
+	path string
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	cassette *Cassette
+	// played counts replayed interactions per request key
+	played map[string]int
+}
+
+// NewRecorder creates a transport that forwards requests to next and records them; call Save to write the cassette.
+// A nil next uses http.DefaultTransport.
+func NewRecorder(path string, next http.RoundTripper) *Transport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &Transport{
+		mode:     ModeRecord,
+		path:     path,
+		next:     next,
+		cassette: &Cassette{},
+	}
+}
+
+// NewReplayer creates a transport that serves responses from the cassette at path without network access
+func NewReplayer(path string) (*Transport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("cassette: decode %s: %w", path, err)
+	}
+
+	return &Transport{
+		mode:     ModeReplay,
+		path:     path,
+		cassette: &c,
+		played:   make(map[string]int),
+	}, nil
+}
+
+// New creates a recorder or a replayer depending on mode
+func New(path string, mode Mode, next http.RoundTripper) (*Transport, error) {
+	if mode == ModeReplay {
+		return NewReplayer(path)
+	}
+	return NewRecorder(path, next), nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.mode == ModeReplay {
+		return t.replay(req)
+	}
+	return t.record(req)
+}
+
+// Save writes recorded interactions to the cassette file; it is a no-op in replay mode
+func (t *Transport) Save() error {
+	if t.mode == ModeReplay {
+		return nil
+	}
+
+	t.mu.Lock()
+	data, err := json.MarshalIndent(t.cassette, "", "  ")
+	t.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(t.path, data, 0o644)
+}
+
+func (t *Transport) record(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := &Interaction{
+		Request: Request{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: scrub(req.Header),
+			Body:    encodeBody(reqBody),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    scrub(resp.Header),
+			Body:       encodeBody(respBody),
+		},
+	}
+
+	t.mu.Lock()
+	t.cassette.Interactions = append(t.cassette.Interactions, interaction)
+	t.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay serves recorded interactions matching the request method and URL in recorded order.
+// Once all matches were served, the last one is repeated.
+func (t *Transport) replay(req *http.Request) (*http.Response, error) {
+	key := requestKey(req.Method, req.URL)
+
+	t.mu.Lock()
+	var matches []*Interaction
+	for _, interaction := range t.cassette.Interactions {
+		u, err := url.Parse(interaction.Request.URL)
+		if err != nil {
+			continue
+		}
+		if requestKey(interaction.Request.Method, u) == key {
+			matches = append(matches, interaction)
+		}
+	}
+
+	if len(matches) == 0 {
+		t.mu.Unlock()
+		return nil, fmt.Errorf("cassette: no recorded interaction for %s %s in %s", req.Method, req.URL, t.path)
+	}
+
+	i := t.played[key]
+	if i >= len(matches) {
+		i = len(matches) - 1
+	}
+	t.played[key]++
+	interaction := matches[i]
+	t.mu.Unlock()
+
+	header := interaction.Response.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	body := decodeBody(interaction.Response.Body)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// requestKey identifies a request by method, path and query with sorted parameters
+func requestKey(method string, u *url.URL) string {
+	return method + " " + u.Path + "?" + u.Query().Encode()
+}
+
+// readBody reads and restores a request or response body
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// encodeBody keeps JSON bodies readable in the cassette and stores anything else as a JSON string
+func encodeBody(data []byte) json.RawMessage {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if json.Valid(data) {
+		return json.RawMessage(data)
+	}
+
+	encoded, _ := json.Marshal(string(data))
+	return encoded
+}
+
+// decodeBody reverses encodeBody
+func decodeBody(raw json.RawMessage) []byte {
+	if len(raw) > 0 && raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			return []byte(s)
+		}
+	}
+	return raw
+}
+
+// scrub returns a copy of headers without credentials
+func scrub(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+
+	clean := h.Clone()
+	for _, name := range scrubbedHeaders {
+		clean.Del(name)
+	}
+	return clean
+}
@@ -0,0 +1,143 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordScrubsCredentialsAndReplays(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "token secret" {
+			t.Errorf("Authorization header was not forwarded")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Write([]byte(`{"id":1,"name":"demo"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder := NewRecorder(path, nil)
+	client := &http.Client{Transport: recorder}
+
+	req, _ := http.NewRequest("GET", server.URL+"/repos/octo/demo?b=2&a=1", nil)
+	req.Header.Set("Authorization", "token secret")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("record request: %v", err)
+	}
+	recorded, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("save cassette: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read cassette: %v", err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Fatalf("cassette contains credentials:\n%s", data)
+	}
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("open cassette: %v", err)
+	}
+
+	// Query parameter order must not matter
+	resp, err = (&http.Client{Transport: replayer}).Get(server.URL + "/repos/octo/demo?a=1&b=2")
+	if err != nil {
+		t.Fatalf("replay request: %v", err)
+	}
+	replayed, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	// JSON bodies are stored indented, so compare them compacted
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, replayed); err != nil {
+		t.Fatalf("replayed body is not JSON: %v", err)
+	}
+	if compact.String() != string(recorded) {
+		t.Errorf("replayed body = %s, want %s", compact.String(), recorded)
+	}
+	if resp.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("replayed Content-Type = %q", resp.Header.Get("Content-Type"))
+	}
+}
+
+func TestReplayServesRepeatedRequestsInOrder(t *testing.T) {
+	path := writeCassette(t, `{"interactions": [
+		{"request": {"method": "GET", "url": "https://api.github.com/rate"}, "response": {"status_code": 200, "body": {"n": 1}}},
+		{"request": {"method": "GET", "url": "https://api.github.com/rate"}, "response": {"status_code": 200, "body": {"n": 2}}},
+		{"request": {"method": "GET", "url": "https://api.github.com/plain"}, "response": {"status_code": 500, "body": "boom"}}
+	]}`)
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("open cassette: %v", err)
+	}
+	client := &http.Client{Transport: replayer}
+
+	// The last match is repeated once the recorded ones are used up
+	for _, want := range []string{`{"n": 1}`, `{"n": 2}`, `{"n": 2}`} {
+		if got := getBody(t, client, "https://api.github.com/rate"); got != want {
+			t.Errorf("body = %s, want %s", got, want)
+		}
+	}
+
+	resp, err := client.Get("https://api.github.com/plain")
+	if err != nil {
+		t.Fatalf("replay request: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusInternalServerError || string(body) != "boom" {
+		t.Errorf("got %d %q, want 500 \"boom\"", resp.StatusCode, body)
+	}
+}
+
+func TestReplayUnknownRequest(t *testing.T) {
+	path := writeCassette(t, `{"interactions": []}`)
+
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("open cassette: %v", err)
+	}
+
+	_, err = (&http.Client{Transport: replayer}).Get("https://api.github.com/repos/octo/demo")
+	if err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+		t.Fatalf("err = %v, want missing interaction error", err)
+	}
+}
+
+func writeCassette(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write cassette: %v", err)
+	}
+	return path
+}
+
+func getBody(t *testing.T, client *http.Client, url string) string {
+	t.Helper()
+
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	return string(body)
+}
@@ -2,10 +2,14 @@ package github
 
 import (
 	"context"
+	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/metrics"
 	"github.com/Dhoini/GitHub_Parser/pkg/utils/RateLimiter"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/callstats"
 	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
 	"github.com/google/go-github/v39/github"
 	"golang.org/x/oauth2"
@@ -18,16 +22,61 @@ type Client struct {
 	logger    *logger.Logger
 }
 
-func NewGithubClient(token string, metrics *metrics.Metrics, logger *logger.Logger) *Client {
-	ctx := context.Background()
+// ClientOption configures the GitHub client
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	transport http.RoundTripper
+	baseURL   string
+}
+
+// WithTransport sets the HTTP transport used below authentication, e.g. a cassette recorder or replayer
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(o *clientOptions) {
+		o.transport = transport
+	}
+}
+
+// WithBaseURL points the client at another API root, e.g. GitHub Enterprise or a fake server
+func WithBaseURL(baseURL string) ClientOption {
+	return func(o *clientOptions) {
+		o.baseURL = baseURL
+	}
+}
+
+func NewGithubClient(token string, metrics *metrics.Metrics, logger *logger.Logger, opts ...ClientOption) *Client {
+	var options clientOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// oauth2 wraps the transport of the client found in the context;
+	// the transport counts calls for contexts carrying callstats.Stats, e.g. a job step,
+	// and makes every call, whoever makes it, wait for the one rate limit of the token
+	rateLimit := RateLimiter.NewRateLimit(logger)
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient,
+		&http.Client{Transport: callstats.Transport(rateLimit.Transport(options.transport))})
+
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: token},
 	)
 	tc := oauth2.NewClient(ctx, ts)
 
+	client := github.NewClient(tc)
+	if options.baseURL != "" {
+		// The GitHub client resolves relative paths, so the base URL must end with a slash
+		baseURL, err := url.Parse(strings.TrimSuffix(options.baseURL, "/") + "/")
+		if err != nil {
+			logger.Error("Invalid GitHub API base URL %q, using the default: %v", options.baseURL, err)
+		} else {
+			client.BaseURL = baseURL
+			client.UploadURL = baseURL
+		}
+	}
+
 	return &Client{
-		client:    github.NewClient(tc),
-		rateLimit: RateLimiter.NewRateLimit(logger),
+		client:    client,
+		rateLimit: rateLimit,
 		metrics:   metrics,
 		logger:    logger,
 	}
@@ -43,13 +92,8 @@ func (c *Client) GetRateLimit() *RateLimiter.RateLimit {
 	return c.rateLimit
 }
 
-// GetRepository gets a repository with rate limiting
+// GetRepository gets a repository with metrics; the transport waits for the rate limit
 func (c *Client) GetRepository(ctx context.Context, owner, repo string) (*github.Repository, error) {
-	// Wait if necessary to comply with API rate limits
-	if err := c.rateLimit.Wait(ctx); err != nil {
-		return nil, err
-	}
-
 	// Record metrics
 	c.metrics.APIRequests.WithLabelValues("GetRepository").Inc()
 	start := time.Now()
@@ -58,34 +102,18 @@ func (c *Client) GetRepository(ctx context.Context, owner, repo string) (*github
 	}()
 
 	// Make the API call
-	repository, resp, err := c.client.Repositories.Get(ctx, owner, repo)
+	repository, _, err := c.client.Repositories.Get(ctx, owner, repo)
 	if err != nil {
 		c.logger.Error("Failed to get repository: %v", err)
 		c.metrics.Errors.WithLabelValues("GetRepository").Inc()
-
-		// Если это ошибка лимита запросов, обновляем информацию о лимите
-		if resp != nil && resp.Rate.Remaining == 0 {
-			c.rateLimit.UpdateLimits(0, resp.Rate.Reset.Time)
-		}
-
 		return nil, err
 	}
 
-	// Update rate limits based on response
-	if resp.Rate.Remaining >= 0 {
-		c.rateLimit.UpdateLimits(resp.Rate.Remaining, resp.Rate.Reset.Time)
-	}
-
 	return repository, nil
 }
 
-// GetIssues gets repository issues with rate limiting
+// GetIssues gets repository issues with metrics; the transport waits for the rate limit
 func (c *Client) GetIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, error) {
-	// Wait if necessary to comply with API rate limits
-	if err := c.rateLimit.Wait(ctx); err != nil {
-		return nil, err
-	}
-
 	// Record metrics
 	c.metrics.APIRequests.WithLabelValues("GetIssues").Inc()
 	start := time.Now()
@@ -94,34 +122,18 @@ func (c *Client) GetIssues(ctx context.Context, owner, repo string, opts *github
 	}()
 
 	// Make the API call
-	issues, resp, err := c.client.Issues.ListByRepo(ctx, owner, repo, opts)
+	issues, _, err := c.client.Issues.ListByRepo(ctx, owner, repo, opts)
 	if err != nil {
 		c.logger.Error("Failed to get issues: %v", err)
 		c.metrics.Errors.WithLabelValues("GetIssues").Inc()
-
-		// Если это ошибка лимита запросов, обновляем информацию о лимите
-		if resp != nil && resp.Rate.Remaining == 0 {
-			c.rateLimit.UpdateLimits(0, resp.Rate.Reset.Time)
-		}
-
 		return nil, err
 	}
 
-	// Update rate limits based on response
-	if resp.Rate.Remaining >= 0 {
-		c.rateLimit.UpdateLimits(resp.Rate.Remaining, resp.Rate.Reset.Time)
-	}
-
 	return issues, nil
 }
 
-// GetPullRequests gets repository pull requests with rate limiting
+// GetPullRequests gets repository pull requests with metrics; the transport waits for the rate limit
 func (c *Client) GetPullRequests(ctx context.Context, owner, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, error) {
-	// Wait if necessary to comply with API rate limits
-	if err := c.rateLimit.Wait(ctx); err != nil {
-		return nil, err
-	}
-
 	// Record metrics
 	c.metrics.APIRequests.WithLabelValues("GetPullRequests").Inc()
 	start := time.Now()
@@ -130,34 +142,18 @@ func (c *Client) GetPullRequests(ctx context.Context, owner, repo string, opts *
 	}()
 
 	// Make the API call
-	prs, resp, err := c.client.PullRequests.List(ctx, owner, repo, opts)
+	prs, _, err := c.client.PullRequests.List(ctx, owner, repo, opts)
 	if err != nil {
 		c.logger.Error("Failed to get pull requests: %v", err)
 		c.metrics.Errors.WithLabelValues("GetPullRequests").Inc()
-
-		// Если это ошибка лимита запросов, обновляем информацию о лимите
-		if resp != nil && resp.Rate.Remaining == 0 {
-			c.rateLimit.UpdateLimits(0, resp.Rate.Reset.Time)
-		}
-
 		return nil, err
 	}
 
-	// Update rate limits based on response
-	if resp.Rate.Remaining >= 0 {
-		c.rateLimit.UpdateLimits(resp.Rate.Remaining, resp.Rate.Reset.Time)
-	}
-
 	return prs, nil
 }
 
-// GetUser gets a user with rate limiting
+// GetUser gets a user with metrics; the transport waits for the rate limit
 func (c *Client) GetUser(ctx context.Context, username string) (*github.User, error) {
-	// Wait if necessary to comply with API rate limits
-	if err := c.rateLimit.Wait(ctx); err != nil {
-		return nil, err
-	}
-
 	// Record metrics
 	c.metrics.APIRequests.WithLabelValues("GetUser").Inc()
 	start := time.Now()
@@ -166,23 +162,12 @@ func (c *Client) GetUser(ctx context.Context, username string) (*github.User, er
 	}()
 
 	// Make the API call
-	user, resp, err := c.client.Users.Get(ctx, username)
+	user, _, err := c.client.Users.Get(ctx, username)
 	if err != nil {
 		c.logger.Error("Failed to get user: %v", err)
 		c.metrics.Errors.WithLabelValues("GetUser").Inc()
-
-		// Если это ошибка лимита запросов, обновляем информацию о лимите
-		if resp != nil && resp.Rate.Remaining == 0 {
-			c.rateLimit.UpdateLimits(0, resp.Rate.Reset.Time)
-		}
-
 		return nil, err
 	}
 
-	// Update rate limits based on response
-	if resp.Rate.Remaining >= 0 {
-		c.rateLimit.UpdateLimits(resp.Rate.Remaining, resp.Rate.Reset.Time)
-	}
-
 	return user, nil
 }
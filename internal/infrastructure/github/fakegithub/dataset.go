@@ -0,0 +1,167 @@
+package fakegithub
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v39/github"
+)
+
+// Dataset is the in-memory state served by the fake API
+type Dataset struct {
+	Users        []*github.User
+	Repositories []*RepositoryData
+}
+
+// RepositoryData holds a repository together with its issues and pull requests.
+// Pull requests are also listed by the issues endpoint, as on GitHub.
+type RepositoryData struct {
+	Repository   *github.Repository
+	Issues       []*github.Issue
+	PullRequests []*github.PullRequest
+}
+
+// seedTime is the creation time of the oldest seeded object
+var seedTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// DefaultDataset returns a deterministic dataset: octo/demo with enough issues to span several pages
+// of the default page size, and a small octo/tools repository
+func DefaultDataset() *Dataset {
+	users := []*github.User{
+		newUser(501, "octo", "Organization", "The Octo Org"),
+		newUser(502, "alice", "User", "Alice Example"),
+		newUser(503, "bob", "User", "Bob Example"),
+	}
+
+	demo := &RepositoryData{
+		Repository: newRepository(1001, users[0], "demo", "Demo repository for the fake GitHub API", "Go", 42, 7),
+	}
+	authors := users[1:]
+	for i := 1; i <= 45; i++ {
+		state := "open"
+		if i%3 == 0 {
+			state = "closed"
+		}
+		demo.Issues = append(demo.Issues, newIssue(int64(3000+i), i, fmt.Sprintf("Issue %d", i), state, authors[i%len(authors)], i))
+	}
+	for i := 1; i <= 12; i++ {
+		state := "open"
+		if i%2 == 0 {
+			state = "closed"
+		}
+		demo.PullRequests = append(demo.PullRequests, newPullRequest(int64(4000+i), 100+i, fmt.Sprintf("Pull request %d", i), state, authors[i%len(authors)], 45+i))
+	}
+
+	tools := &RepositoryData{
+		Repository: newRepository(1002, users[0], "tools", "Small helper repository", "Python", 3, 1),
+		Issues: []*github.Issue{
+			newIssue(3101, 1, "Add CLI flags", "open", users[1], 1),
+			newIssue(3102, 2, "Fix packaging", "closed", users[2], 2),
+		},
+		PullRequests: []*github.PullRequest{
+			newPullRequest(4101, 3, "Support Python 3.12", "open", users[2], 3),
+		},
+	}
+
+	return &Dataset{
+		Users:        users,
+		Repositories: []*RepositoryData{demo, tools},
+	}
+}
+
+// FindRepository looks a repository up by owner and name, ignoring case as GitHub does
+func (d *Dataset) FindRepository(owner, name string) *RepositoryData {
+	fullName := owner + "/" + name
+	for _, repo := range d.Repositories {
+		if strings.EqualFold(repo.Repository.GetFullName(), fullName) {
+			return repo
+		}
+	}
+	return nil
+}
+
+// FindUser looks a user up by login, ignoring case
+func (d *Dataset) FindUser(login string) *github.User {
+	for _, user := range d.Users {
+		if strings.EqualFold(user.GetLogin(), login) {
+			return user
+		}
+	}
+	return nil
+}
+
+func newUser(id int64, login, userType, name string) *github.User {
+	createdAt := github.Timestamp{Time: seedTime.AddDate(-1, 0, int(id%100))}
+	return &github.User{
+		ID:        github.Int64(id),
+		Login:     github.String(login),
+		Name:      github.String(name),
+		Type:      github.String(userType),
+		HTMLURL:   github.String("https://github.com/" + login),
+		AvatarURL: github.String(fmt.Sprintf("https://avatars.githubusercontent.com/u/%d", id)),
+		CreatedAt: &createdAt,
+		UpdatedAt: &createdAt,
+	}
+}
+
+func newRepository(id int64, owner *github.User, name, description, language string, stars, forks int) *github.Repository {
+	createdAt := github.Timestamp{Time: seedTime}
+	return &github.Repository{
+		ID:              github.Int64(id),
+		Name:            github.String(name),
+		FullName:        github.String(owner.GetLogin() + "/" + name),
+		Description:     github.String(description),
+		Private:         github.Bool(false),
+		Owner:           owner,
+		Language:        github.String(language),
+		StargazersCount: github.Int(stars),
+		ForksCount:      github.Int(forks),
+		DefaultBranch:   github.String("main"),
+		HTMLURL:         github.String("https://github.com/" + owner.GetLogin() + "/" + name),
+		CreatedAt:       &createdAt,
+		UpdatedAt:       &createdAt,
+	}
+}
+
+// newIssue creates an issue created day days after seedTime
+func newIssue(id int64, number int, title, state string, author *github.User, day int) *github.Issue {
+	createdAt := seedTime.AddDate(0, 0, day)
+	updatedAt := createdAt.Add(time.Hour)
+	issue := &github.Issue{
+		ID:        github.Int64(id),
+		Number:    github.Int(number),
+		Title:     github.String(title),
+		Body:      github.String("Seeded " + strings.ToLower(title)),
+		State:     github.String(state),
+		User:      author,
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+	}
+	if state == "closed" {
+		issue.ClosedAt = &updatedAt
+	}
+	return issue
+}
+
+// newPullRequest creates a pull request created day days after seedTime; closed pull requests are merged
+func newPullRequest(id int64, number int, title, state string, author *github.User, day int) *github.PullRequest {
+	createdAt := seedTime.AddDate(0, 0, day)
+	updatedAt := createdAt.Add(2 * time.Hour)
+	pr := &github.PullRequest{
+		ID:        github.Int64(id),
+		Number:    github.Int(number),
+		Title:     github.String(title),
+		Body:      github.String("Seeded " + strings.ToLower(title)),
+		State:     github.String(state),
+		User:      author,
+		Head:      &github.PullRequestBranch{SHA: github.String(fmt.Sprintf("%040x", id))},
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+	}
+	if state == "closed" {
+		pr.ClosedAt = &updatedAt
+		pr.MergedAt = &updatedAt
+	}
+	return pr
+}
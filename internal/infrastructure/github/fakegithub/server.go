@@ -0,0 +1,567 @@
+// Package fakegithub implements an in-process stand-in for api.github.com.
+// It serves repositories, issues, pull requests and users from a seeded in-memory dataset
+// with GitHub's pagination and rate-limit headers, and can inject latency and failures.
+package fakegithub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v39/github"
+)
+
+const (
+	defaultPerPage   = 30
+	maxPerPage       = 100
+	defaultRateLimit = 5000
+	rateLimitWindow  = time.Hour
+)
+
+// FaultKind selects the failure injected by a Fault
+type FaultKind int
+
+const (
+	// FaultServerError answers with a 5xx status, 502 unless Fault.Status is set
+	FaultServerError FaultKind = iota + 1
+	// FaultAbuseLimit answers with a 403 secondary rate limit and a Retry-After header
+	FaultAbuseLimit
+	// FaultNotFound answers with 404
+	FaultNotFound
+)
+
+// Fault describes a failure injected into matching requests
+type Fault struct {
+	Kind FaultKind
+	// PathPrefix restricts the fault to request paths starting with it; empty matches every request
+	PathPrefix string
+	// Skip lets the given number of matching requests through before the fault starts
+	Skip int
+	// Count is the number of requests to fail; 0 fails requests until the faults are cleared
+	Count int
+	// Status overrides the status code of a FaultServerError
+	Status int
+	// RetryAfter is reported with a FaultAbuseLimit, one minute by default
+	RetryAfter time.Duration
+}
+
+// Server is an http.Handler emulating the subset of the GitHub REST API used by the parser
+type Server struct {
+	mux *http.ServeMux
+
+	mu        sync.Mutex
+	data      *Dataset
+	latency   time.Duration
+	faults    []*Fault
+	rateLimit int
+	remaining int
+	reset     time.Time
+	requests  []string
+}
+
+// New creates a fake API serving data; a nil dataset serves DefaultDataset
+func New(data *Dataset) *Server {
+	if data == nil {
+		data = DefaultDataset()
+	}
+
+	s := &Server{
+		mux:       http.NewServeMux(),
+		data:      data,
+		rateLimit: defaultRateLimit,
+		remaining: defaultRateLimit,
+		reset:     time.Now().Add(rateLimitWindow),
+	}
+
+	s.mux.HandleFunc("GET /rate_limit", s.handleRateLimit)
+	s.mux.HandleFunc("GET /users/{username}", s.handleUser)
+	s.mux.HandleFunc("GET /repos/{owner}/{repo}", s.handleRepository)
+	s.mux.HandleFunc("GET /repos/{owner}/{repo}/issues", s.handleIssues)
+	s.mux.HandleFunc("GET /repos/{owner}/{repo}/issues/{number}", s.handleIssue)
+	s.mux.HandleFunc("GET /repos/{owner}/{repo}/pulls", s.handlePullRequests)
+	s.mux.HandleFunc("GET /repos/{owner}/{repo}/pulls/{number}", s.handlePullRequest)
+	s.mux.HandleFunc("GET /repos/{owner}/{repo}/commits/{ref}/status", s.handleCombinedStatus)
+	s.mux.HandleFunc("GET /repos/{owner}/{repo}/commits/{ref}/check-runs", s.handleCheckRuns)
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "Not Found", "")
+	})
+
+	return s
+}
+
+// SetLatency delays every response by d
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// InjectFault adds a failure for matching requests; faults are checked in the order they were added
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all injected faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// SetRateLimit resets the primary rate limit to limit requests per hour.
+// Requests beyond it are answered with 403 and X-RateLimit-Remaining: 0.
+func (s *Server) SetRateLimit(limit int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimit = limit
+	s.remaining = limit
+	s.reset = time.Now().Add(rateLimitWindow)
+}
+
+// Update changes the served dataset under the server lock
+func (s *Server) Update(fn func(data *Dataset)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.data)
+}
+
+// Requests returns the number of requests received so far
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.requests)
+}
+
+// RequestLog returns the path and query of every request received so far, in order
+func (s *Server) RequestLog() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.URL.RequestURI())
+	latency := s.latency
+	fault := s.matchFault(r.URL.Path)
+
+	// The primary rate limit is charged before faults, as GitHub counts failed requests too
+	limited := s.remaining <= 0
+	if !limited {
+		s.remaining--
+	}
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(s.rateLimit))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(s.remaining))
+	w.Header().Set("X-RateLimit-Used", strconv.Itoa(s.rateLimit-s.remaining))
+	w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(s.reset.Unix(), 10))
+	w.Header().Set("X-RateLimit-Resource", "core")
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	switch {
+	case limited:
+		writeError(w, http.StatusForbidden, "API rate limit exceeded", "https://docs.github.com/rest/overview/resources-in-the-rest-api#rate-limiting")
+	case fault != nil:
+		writeFault(w, fault)
+	default:
+		s.mux.ServeHTTP(w, r)
+	}
+}
+
+// matchFault returns the first fault matching path and consumes one of its occurrences; callers hold s.mu
+func (s *Server) matchFault(path string) *Fault {
+	for i, fault := range s.faults {
+		if !strings.HasPrefix(path, fault.PathPrefix) {
+			continue
+		}
+		if fault.Skip > 0 {
+			fault.Skip--
+			continue
+		}
+
+		if fault.Count > 0 {
+			fault.Count--
+			if fault.Count == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+func writeFault(w http.ResponseWriter, fault *Fault) {
+	switch fault.Kind {
+	case FaultAbuseLimit:
+		retryAfter := fault.RetryAfter
+		if retryAfter == 0 {
+			retryAfter = time.Minute
+		}
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+		writeError(w, http.StatusForbidden, "You have exceeded a secondary rate limit. Please wait a few minutes before you try again.",
+			"https://docs.github.com/en/free-pro-team@latest/rest/reference/#abuse-rate-limits")
+	case FaultNotFound:
+		writeError(w, http.StatusNotFound, "Not Found", "")
+	default:
+		status := fault.Status
+		if status == 0 {
+			status = http.StatusBadGateway
+		}
+		writeError(w, status, http.StatusText(status), "")
+	}
+}
+
+func (s *Server) handleRateLimit(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	rate := github.Rate{Limit: s.rateLimit, Remaining: s.remaining, Reset: github.Timestamp{Time: s.reset}}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"resources": map[string]github.Rate{"core": rate},
+		"rate":      rate,
+	})
+}
+
+func (s *Server) handleUser(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	user := s.data.FindUser(r.PathValue("username"))
+	s.mu.Unlock()
+
+	if user == nil {
+		writeError(w, http.StatusNotFound, "Not Found", "")
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) handleRepository(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo := s.data.FindRepository(r.PathValue("owner"), r.PathValue("repo"))
+	if repo == nil {
+		writeError(w, http.StatusNotFound, "Not Found", "")
+		return
+	}
+
+	// Open issues include open pull requests, as on GitHub
+	response := *repo.Repository
+	openIssues := 0
+	for _, issue := range repo.Issues {
+		if issue.GetState() == "open" {
+			openIssues++
+		}
+	}
+	for _, pr := range repo.PullRequests {
+		if pr.GetState() == "open" {
+			openIssues++
+		}
+	}
+	response.OpenIssuesCount = github.Int(openIssues)
+
+	writeJSON(w, http.StatusOK, &response)
+}
+
+// handleIssues lists issues and pull requests, newest first unless sort and direction say otherwise
+func (s *Server) handleIssues(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo := s.data.FindRepository(r.PathValue("owner"), r.PathValue("repo"))
+	if repo == nil {
+		writeError(w, http.StatusNotFound, "Not Found", "")
+		return
+	}
+
+	// Like GitHub, since keeps issues updated at or after it; pull requests have no such filter
+	state := stateFilter(r)
+	since, _ := time.Parse(time.RFC3339, r.URL.Query().Get("since"))
+	var issues []*github.Issue
+	for _, issue := range repo.Issues {
+		if matchesState(issue.GetState(), state) && !issue.GetUpdatedAt().Before(since) {
+			issues = append(issues, withRepositoryURL(r, repo, issue))
+		}
+	}
+	for _, pr := range repo.PullRequests {
+		if matchesState(pr.GetState(), state) && !pr.GetUpdatedAt().Before(since) {
+			issues = append(issues, pullRequestIssue(r, repo, pr))
+		}
+	}
+
+	sortByTime(r, issues, func(issue *github.Issue) (time.Time, time.Time) {
+		return issue.GetCreatedAt(), issue.GetUpdatedAt()
+	})
+
+	writePage(w, r, issues)
+}
+
+func (s *Server) handleIssue(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo := s.data.FindRepository(r.PathValue("owner"), r.PathValue("repo"))
+	number, err := strconv.Atoi(r.PathValue("number"))
+	if repo == nil || err != nil {
+		writeError(w, http.StatusNotFound, "Not Found", "")
+		return
+	}
+
+	for _, issue := range repo.Issues {
+		if issue.GetNumber() == number {
+			writeJSON(w, http.StatusOK, withRepositoryURL(r, repo, issue))
+			return
+		}
+	}
+	for _, pr := range repo.PullRequests {
+		if pr.GetNumber() == number {
+			writeJSON(w, http.StatusOK, pullRequestIssue(r, repo, pr))
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "Not Found", "")
+}
+
+func (s *Server) handlePullRequests(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo := s.data.FindRepository(r.PathValue("owner"), r.PathValue("repo"))
+	if repo == nil {
+		writeError(w, http.StatusNotFound, "Not Found", "")
+		return
+	}
+
+	state := stateFilter(r)
+	var prs []*github.PullRequest
+	for _, pr := range repo.PullRequests {
+		if matchesState(pr.GetState(), state) {
+			prs = append(prs, pr)
+		}
+	}
+
+	sortByTime(r, prs, func(pr *github.PullRequest) (time.Time, time.Time) {
+		return pr.GetCreatedAt(), pr.GetUpdatedAt()
+	})
+
+	writePage(w, r, prs)
+}
+
+func (s *Server) handlePullRequest(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo := s.data.FindRepository(r.PathValue("owner"), r.PathValue("repo"))
+	number, err := strconv.Atoi(r.PathValue("number"))
+	if repo == nil || err != nil {
+		writeError(w, http.StatusNotFound, "Not Found", "")
+		return
+	}
+
+	for _, pr := range repo.PullRequests {
+		if pr.GetNumber() == number {
+			writeJSON(w, http.StatusOK, pr)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "Not Found", "")
+}
+
+// handleCombinedStatus reports no commit statuses for known repositories
+func (s *Server) handleCombinedStatus(w http.ResponseWriter, r *http.Request) {
+	if !s.repositoryExists(r) {
+		writeError(w, http.StatusNotFound, "Not Found", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &github.CombinedStatus{
+		State:      github.String("pending"),
+		SHA:        github.String(r.PathValue("ref")),
+		TotalCount: github.Int(0),
+		Statuses:   []*github.RepoStatus{},
+	})
+}
+
+// handleCheckRuns reports no check runs for known repositories
+func (s *Server) handleCheckRuns(w http.ResponseWriter, r *http.Request) {
+	if !s.repositoryExists(r) {
+		writeError(w, http.StatusNotFound, "Not Found", "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &github.ListCheckRunsResults{
+		Total:     github.Int(0),
+		CheckRuns: []*github.CheckRun{},
+	})
+}
+
+func (s *Server) repositoryExists(r *http.Request) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data.FindRepository(r.PathValue("owner"), r.PathValue("repo")) != nil
+}
+
+// stateFilter returns the requested state; GitHub lists open items by default
+func stateFilter(r *http.Request) string {
+	if state := r.URL.Query().Get("state"); state != "" {
+		return state
+	}
+	return "open"
+}
+
+func matchesState(state, filter string) bool {
+	return filter == "all" || state == filter
+}
+
+// sortByTime orders items by the sort ("created" or "updated") and direction query parameters, newest first by default
+func sortByTime[T any](r *http.Request, items []T, times func(T) (created, updated time.Time)) {
+	query := r.URL.Query()
+	byUpdated := query.Get("sort") == "updated"
+	ascending := query.Get("direction") == "asc"
+
+	sort.SliceStable(items, func(i, j int) bool {
+		ci, ui := times(items[i])
+		cj, uj := times(items[j])
+		ti, tj := ci, cj
+		if byUpdated {
+			ti, tj = ui, uj
+		}
+		if ascending {
+			return ti.Before(tj)
+		}
+		return ti.After(tj)
+	})
+}
+
+// apiURL returns the URL of path on this server as seen by the client
+func apiURL(r *http.Request, path string) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + path
+}
+
+func withRepositoryURL(r *http.Request, repo *RepositoryData, issue *github.Issue) *github.Issue {
+	response := *issue
+	response.RepositoryURL = github.String(apiURL(r, "/repos/"+repo.Repository.GetFullName()))
+	return &response
+}
+
+// pullRequestIssue converts a pull request into its issue representation
+func pullRequestIssue(r *http.Request, repo *RepositoryData, pr *github.PullRequest) *github.Issue {
+	return &github.Issue{
+		ID:            pr.ID,
+		Number:        pr.Number,
+		Title:         pr.Title,
+		Body:          pr.Body,
+		State:         pr.State,
+		User:          pr.User,
+		CreatedAt:     pr.CreatedAt,
+		UpdatedAt:     pr.UpdatedAt,
+		ClosedAt:      pr.ClosedAt,
+		RepositoryURL: github.String(apiURL(r, "/repos/"+repo.Repository.GetFullName())),
+		PullRequestLinks: &github.PullRequestLinks{
+			URL: github.String(apiURL(r, fmt.Sprintf("/repos/%s/pulls/%d", repo.Repository.GetFullName(), pr.GetNumber()))),
+		},
+	}
+}
+
+// writePage writes the requested page of items with a Link header pointing at the neighbouring pages
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	query := r.URL.Query()
+
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage <= 0 {
+		perPage = defaultPerPage
+	}
+	if perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	lastPage := (len(items) + perPage - 1) / perPage
+	if lastPage == 0 {
+		lastPage = 1
+	}
+
+	start := (page - 1) * perPage
+	end := start + perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	if end > len(items) {
+		end = len(items)
+	}
+
+	if link := linkHeader(r, page, lastPage); link != "" {
+		w.Header().Set("Link", link)
+	}
+
+	result := items[start:end]
+	if result == nil {
+		result = []T{}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// linkHeader builds a GitHub style Link header for page out of lastPage
+func linkHeader(r *http.Request, page, lastPage int) string {
+	pageURL := func(n int) string {
+		query := r.URL.Query()
+		query.Set("page", strconv.Itoa(n))
+		u := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+		return apiURL(r, u.String())
+	}
+
+	var links []string
+	if page < lastPage {
+		links = append(links,
+			fmt.Sprintf(`<%s>; rel="next"`, pageURL(page+1)),
+			fmt.Sprintf(`<%s>; rel="last"`, pageURL(lastPage)))
+	}
+	if page > 1 {
+		links = append(links,
+			fmt.Sprintf(`<%s>; rel="first"`, pageURL(1)),
+			fmt.Sprintf(`<%s>; rel="prev"`, pageURL(page-1)))
+	}
+
+	return strings.Join(links, ", ")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message, documentationURL string) {
+	if documentationURL == "" {
+		documentationURL = "https://docs.github.com/rest"
+	}
+	writeJSON(w, status, map[string]string{
+		"message":           message,
+		"documentation_url": documentationURL,
+	})
+}
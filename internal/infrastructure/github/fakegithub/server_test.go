@@ -0,0 +1,215 @@
+package fakegithub
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v39/github"
+)
+
+// newTestClient starts server and returns a GitHub client pointed at it
+func newTestClient(t *testing.T, server *Server) *github.Client {
+	t.Helper()
+
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(httpServer.URL + "/")
+	return client
+}
+
+func TestIssuesPagination(t *testing.T) {
+	client := newTestClient(t, New(nil))
+	ctx := context.Background()
+
+	opts := &github.IssueListByRepoOptions{State: "all", ListOptions: github.ListOptions{PerPage: 20}}
+
+	var numbers []int
+	pages := 0
+	for {
+		issues, resp, err := client.Issues.ListByRepo(ctx, "octo", "demo", opts)
+		if err != nil {
+			t.Fatalf("ListByRepo page %d: %v", opts.Page, err)
+		}
+		pages++
+
+		if resp.Rate.Limit != defaultRateLimit || resp.Rate.Remaining != defaultRateLimit-pages {
+			t.Errorf("rate = %+v, want %d remaining", resp.Rate, defaultRateLimit-pages)
+		}
+
+		for _, issue := range issues {
+			numbers = append(numbers, issue.GetNumber())
+		}
+
+		if resp.NextPage == 0 {
+			if resp.FirstPage != 1 || resp.PrevPage != pages-1 {
+				t.Errorf("last page links: first %d, prev %d", resp.FirstPage, resp.PrevPage)
+			}
+			break
+		}
+		if resp.LastPage != 3 {
+			t.Errorf("last page = %d, want 3", resp.LastPage)
+		}
+		opts.Page = resp.NextPage
+	}
+
+	// 45 issues and 12 pull requests, newest first
+	if pages != 3 || len(numbers) != 57 {
+		t.Fatalf("got %d items on %d pages, want 57 on 3", len(numbers), pages)
+	}
+	if numbers[0] != 112 || numbers[len(numbers)-1] != 1 {
+		t.Errorf("order: first #%d, last #%d", numbers[0], numbers[len(numbers)-1])
+	}
+}
+
+func TestStateFilter(t *testing.T) {
+	client := newTestClient(t, New(nil))
+
+	prs, _, err := client.PullRequests.List(context.Background(), "octo", "demo", &github.PullRequestListOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+
+	// Pull requests are listed open only by default
+	if len(prs) != 6 {
+		t.Errorf("got %d open pull requests, want 6", len(prs))
+	}
+	for _, pr := range prs {
+		if pr.GetState() != "open" {
+			t.Errorf("PR #%d is %s", pr.GetNumber(), pr.GetState())
+		}
+	}
+}
+
+func TestIssuesSince(t *testing.T) {
+	client := newTestClient(t, New(nil))
+
+	issues, _, err := client.Issues.ListByRepo(context.Background(), "octo", "demo", &github.IssueListByRepoOptions{
+		State:       "all",
+		Since:       seedTime.AddDate(0, 0, 40),
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		t.Fatalf("ListByRepo: %v", err)
+	}
+
+	// Issues #40 to #45 and all 12 pull requests were updated since day 40
+	if len(issues) != 18 {
+		t.Errorf("got %d issues updated since day 40, want 18", len(issues))
+	}
+}
+
+func TestFaults(t *testing.T) {
+	server := New(nil)
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	server.InjectFault(Fault{Kind: FaultServerError, PathPrefix: "/repos/octo/demo", Count: 1})
+
+	_, resp, err := client.Repositories.Get(ctx, "octo", "demo")
+	if err == nil || resp.StatusCode != http.StatusBadGateway {
+		t.Fatalf("first request: err %v, want 502", err)
+	}
+
+	// The fault was used up
+	repo, _, err := client.Repositories.Get(ctx, "octo", "demo")
+	if err != nil {
+		t.Fatalf("second request: %v", err)
+	}
+	if repo.GetOpenIssuesCount() != 36 {
+		t.Errorf("open issues = %d, want 36", repo.GetOpenIssuesCount())
+	}
+
+	server.InjectFault(Fault{Kind: FaultAbuseLimit, RetryAfter: 30 * time.Second})
+	_, _, err = client.Users.Get(ctx, "alice")
+	var abuseErr *github.AbuseRateLimitError
+	if !errors.As(err, &abuseErr) {
+		t.Fatalf("err = %v, want AbuseRateLimitError", err)
+	}
+	if abuseErr.RetryAfter == nil || *abuseErr.RetryAfter != 30*time.Second {
+		t.Errorf("retry after = %v, want 30s", abuseErr.RetryAfter)
+	}
+
+	server.ClearFaults()
+	server.InjectFault(Fault{Kind: FaultNotFound, PathPrefix: "/users/"})
+	for i := 0; i < 2; i++ {
+		_, resp, err = client.Users.Get(ctx, "alice")
+		if err == nil || resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("request %d: err %v, want 404", i, err)
+		}
+	}
+
+	if server.Requests() != 5 {
+		t.Errorf("requests = %d, want 5", server.Requests())
+	}
+}
+
+func TestFaultSkip(t *testing.T) {
+	server := New(nil)
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	server.InjectFault(Fault{Kind: FaultServerError, PathPrefix: "/repos/octo/demo/issues", Skip: 1, Count: 1})
+
+	opts := &github.IssueListByRepoOptions{ListOptions: github.ListOptions{PerPage: 10}}
+	for page, wantErr := range []bool{false, true, false} {
+		opts.Page = page + 1
+		_, _, err := client.Issues.ListByRepo(ctx, "octo", "demo", opts)
+		if (err != nil) != wantErr {
+			t.Fatalf("page %d: err = %v, want error %v", opts.Page, err, wantErr)
+		}
+	}
+
+	log := server.RequestLog()
+	if len(log) != 3 || !strings.Contains(log[1], "page=2") {
+		t.Errorf("request log = %v", log)
+	}
+}
+
+func TestRateLimitExhausted(t *testing.T) {
+	server := New(nil)
+	server.SetRateLimit(1)
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	if _, _, err := client.Users.Get(ctx, "octo"); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+
+	// A fresh client does not know about the exhausted limit and hits the server
+	client = newTestClient(t, server)
+	_, _, err := client.Users.Get(ctx, "octo")
+	var rateErr *github.RateLimitError
+	if !errors.As(err, &rateErr) {
+		t.Fatalf("err = %v, want RateLimitError", err)
+	}
+	if rateErr.Rate.Limit != 1 || rateErr.Rate.Remaining != 0 {
+		t.Errorf("rate = %+v", rateErr.Rate)
+	}
+}
+
+func TestLatencyHonoursCancellation(t *testing.T) {
+	server := New(nil)
+	server.SetLatency(time.Minute)
+	client := newTestClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, _, err := client.Users.Get(ctx, "octo"); err == nil {
+		t.Fatal("expected a timeout")
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("request took %s", time.Since(start))
+	}
+}
@@ -33,6 +33,11 @@ type Metrics struct {
 	ParsingJobsTotal  prometheus.Counter
 	ParsingJobsErrors prometheus.Counter
 
+	// Очередь заданий парсинга
+	JobQueueDepth    prometheus.Gauge
+	JobQueueWait     prometheus.Histogram
+	JobQueueRejected prometheus.Counter
+
 	// Информация о состоянии БД
 	DBConnectionsOpen prometheus.Gauge
 	DBOperations      *prometheus.CounterVec
@@ -118,6 +123,28 @@ func NewMetrics(logger *logger.Logger) *Metrics {
 			},
 		),
 
+		JobQueueDepth: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "github_parser_job_queue_depth",
+				Help: "Number of parsing jobs waiting for a worker",
+			},
+		),
+
+		JobQueueWait: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:    "github_parser_job_queue_wait_seconds",
+				Help:    "Time parsing jobs spend in the queue before a worker picks them up",
+				Buckets: prometheus.ExponentialBuckets(0.01, 4, 10),
+			},
+		),
+
+		JobQueueRejected: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "github_parser_job_queue_rejected_total",
+				Help: "Total number of parsing jobs rejected because the queue was full",
+			},
+		),
+
 		DBConnectionsOpen: prometheus.NewGauge(
 			prometheus.GaugeOpts{
 				Name: "github_parser_db_connections_open",
@@ -146,6 +173,9 @@ func NewMetrics(logger *logger.Logger) *Metrics {
 		m.ParsingJobs,
 		m.ParsingJobsTotal,
 		m.ParsingJobsErrors,
+		m.JobQueueDepth,
+		m.JobQueueWait,
+		m.JobQueueRejected,
 		m.DBConnectionsOpen,
 		m.DBOperations,
 	)
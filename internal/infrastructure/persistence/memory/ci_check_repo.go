@@ -0,0 +1,43 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+)
+
+type CICheckRepositoryMemory struct {
+	mu     sync.RWMutex
+	checks map[string]entity.CICheck
+}
+
+func NewCICheckRepository() repository.CICheckRepository {
+	return &CICheckRepositoryMemory{checks: make(map[string]entity.CICheck)}
+}
+
+func (r *CICheckRepositoryMemory) Save(ctx context.Context, check *entity.CICheck) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checks[key(check.Source, check.ID)] = *check
+	return nil
+}
+
+func (r *CICheckRepositoryMemory) ListByPullRequest(ctx context.Context, pullRequestID int64) ([]*entity.CICheck, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var checks []*entity.CICheck
+	for _, check := range r.checks {
+		if check.PullRequestID == pullRequestID {
+			check := check
+			checks = append(checks, &check)
+		}
+	}
+
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Name < checks[j].Name })
+	return checks, nil
+}
@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+)
+
+type CodeOwnerRepositoryMemory struct {
+	mu    sync.RWMutex
+	rules map[int64][]entity.CodeOwnerRule
+}
+
+func NewCodeOwnerRepository() repository.CodeOwnerRepository {
+	return &CodeOwnerRepositoryMemory{rules: make(map[int64][]entity.CodeOwnerRule)}
+}
+
+func (r *CodeOwnerRepositoryMemory) ReplaceForRepository(ctx context.Context, repoID int64, rules []*entity.CodeOwnerRule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := make([]entity.CodeOwnerRule, 0, len(rules))
+	for _, rule := range rules {
+		stored = append(stored, *rule)
+	}
+	r.rules[repoID] = stored
+	return nil
+}
+
+func (r *CodeOwnerRepositoryMemory) ListByRepository(ctx context.Context, repoID int64) ([]*entity.CodeOwnerRule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var rules []*entity.CodeOwnerRule
+	for _, rule := range r.rules[repoID] {
+		rule := rule
+		rules = append(rules, &rule)
+	}
+	return rules, nil
+}
@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+)
+
+type DependencyRepositoryMemory struct {
+	mu   sync.RWMutex
+	deps map[string][]entity.Dependency
+}
+
+func NewDependencyRepository() repository.DependencyRepository {
+	return &DependencyRepositoryMemory{deps: make(map[string][]entity.Dependency)}
+}
+
+func (r *DependencyRepositoryMemory) ReplaceForManifest(ctx context.Context, repoID int64, manifest string, deps []*entity.Dependency) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := make([]entity.Dependency, 0, len(deps))
+	for _, dep := range deps {
+		stored = append(stored, *dep)
+	}
+	r.deps[key(repoID, manifest)] = stored
+	return nil
+}
+
+func (r *DependencyRepositoryMemory) List(ctx context.Context, filter repository.DependencyFilter) ([]*entity.Dependency, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var deps []*entity.Dependency
+	for _, manifestDeps := range r.deps {
+		for _, dep := range manifestDeps {
+			if filter.Name != "" && dep.Name != filter.Name {
+				continue
+			}
+			if filter.Version != "" && dep.Version != filter.Version {
+				continue
+			}
+			if filter.Ecosystem != "" && dep.Ecosystem != filter.Ecosystem {
+				continue
+			}
+			if filter.RepositoryID != 0 && dep.RepositoryID != filter.RepositoryID {
+				continue
+			}
+			dep := dep
+			deps = append(deps, &dep)
+		}
+	}
+
+	sort.Slice(deps, func(i, j int) bool {
+		if deps[i].RepositoryFullName != deps[j].RepositoryFullName {
+			return deps[i].RepositoryFullName < deps[j].RepositoryFullName
+		}
+		return deps[i].Name < deps[j].Name
+	})
+
+	return paginate(deps, filter.Offset, filter.Limit), nil
+}
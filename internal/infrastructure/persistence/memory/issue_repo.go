@@ -0,0 +1,107 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+)
+
+type IssueRepositoryMemory struct {
+	mu     sync.RWMutex
+	issues map[int64]entity.Issue
+}
+
+func NewIssueRepository() repository.IssueRepository {
+	return &IssueRepositoryMemory{issues: make(map[int64]entity.Issue)}
+}
+
+func (r *IssueRepositoryMemory) Save(ctx context.Context, issue *entity.Issue) (entity.SaveOutcome, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *issue
+	// An issue seen upstream again is no longer deleted
+	saved.DeletedAt = nil
+	saved.TransferredTo = ""
+	outcome := entity.SaveCreated
+	if existing, ok := r.issues[issue.ID]; ok {
+		outcome = entity.SaveUpdated
+		if reflect.DeepEqual(existing, saved) {
+			outcome = entity.SaveUnchanged
+		}
+	}
+	r.issues[issue.ID] = saved
+	return outcome, nil
+}
+
+func (r *IssueRepositoryMemory) FindByID(ctx context.Context, id int64) (*entity.Issue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	issue, ok := r.issues[id]
+	if !ok {
+		return nil, nil
+	}
+	return &issue, nil
+}
+
+func (r *IssueRepositoryMemory) List(ctx context.Context, filter repository.IssueFilter) ([]*entity.Issue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var issues []*entity.Issue
+	for _, issue := range r.issues {
+		if filter.RepositoryID != 0 && issue.RepositoryID != filter.RepositoryID {
+			continue
+		}
+		if filter.State != "" && issue.State != filter.State {
+			continue
+		}
+		if !filter.IncludeDeleted && issue.DeletedAt != nil {
+			continue
+		}
+		issue := issue
+		issues = append(issues, &issue)
+	}
+
+	// Сортировка по времени создания (сначала новые)
+	sort.Slice(issues, func(i, j int) bool {
+		if !issues[i].CreatedAt.Equal(issues[j].CreatedAt) {
+			return issues[i].CreatedAt.After(issues[j].CreatedAt)
+		}
+		return issues[i].ID > issues[j].ID
+	})
+
+	return paginate(issues, filter.Offset, filter.Limit), nil
+}
+
+func (r *IssueRepositoryMemory) MarkDeleted(ctx context.Context, id int64, deletedAt time.Time) error {
+	return r.update(id, func(issue *entity.Issue) {
+		issue.DeletedAt = &deletedAt
+	})
+}
+
+func (r *IssueRepositoryMemory) MarkTransferred(ctx context.Context, id int64, transferredTo string, transferredAt time.Time) error {
+	return r.update(id, func(issue *entity.Issue) {
+		issue.DeletedAt = &transferredAt
+		issue.TransferredTo = transferredTo
+	})
+}
+
+func (r *IssueRepositoryMemory) update(id int64, apply func(issue *entity.Issue)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	issue, ok := r.issues[id]
+	if !ok {
+		return nil
+	}
+	apply(&issue)
+	r.issues[id] = issue
+	return nil
+}
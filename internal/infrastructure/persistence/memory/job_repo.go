@@ -0,0 +1,267 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+)
+
+type JobRepositoryMemory struct {
+	mu   sync.RWMutex
+	jobs map[string]*entity.ParsingJob
+}
+
+func NewJobRepository() repository.JobRepository {
+	return &JobRepositoryMemory{jobs: make(map[string]*entity.ParsingJob)}
+}
+
+func (r *JobRepositoryMemory) Save(ctx context.Context, job *entity.ParsingJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Like the unique index of the Mongo repository
+	if key := job.Params.IdempotencyKey; key != "" {
+		for _, other := range r.jobs {
+			if other.ID != job.ID && other.Params.IdempotencyKey == key {
+				return repository.ErrDuplicateJob
+			}
+		}
+	}
+
+	// Like the Mongo repository, a save keeps the lease and the callbacks of a stored job
+	// and is rejected when another owner holds it
+	clone := cloneJob(job)
+	if stored, ok := r.jobs[job.ID]; ok {
+		if stored.LeaseOwner != job.LeaseOwner {
+			return repository.ErrLeaseLost
+		}
+		clone.LeaseOwner = stored.LeaseOwner
+		clone.LeaseExpiresAt = stored.LeaseExpiresAt
+		clone.StopRequest = stored.StopRequest
+		clone.Callbacks = stored.Callbacks
+	} else {
+		clone.Callbacks = nil
+	}
+	r.jobs[job.ID] = clone
+	return nil
+}
+
+func (r *JobRepositoryMemory) FindByID(ctx context.Context, id string) (*entity.ParsingJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, nil
+	}
+	return cloneJob(job), nil
+}
+
+func (r *JobRepositoryMemory) FindByIdempotencyKey(ctx context.Context, key string) (*entity.ParsingJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, job := range r.jobs {
+		if job.Params.IdempotencyKey == key {
+			return cloneJob(job), nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *JobRepositoryMemory) ListByStatus(ctx context.Context, statuses ...string) ([]*entity.ParsingJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var jobs []*entity.ParsingJob
+	for _, job := range r.jobs {
+		for _, status := range statuses {
+			if job.Status == status {
+				jobs = append(jobs, cloneJob(job))
+				break
+			}
+		}
+	}
+
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].CreatedAt.Before(jobs[j].CreatedAt) })
+	return jobs, nil
+}
+
+func (r *JobRepositoryMemory) List(ctx context.Context, filter repository.JobFilter) ([]*entity.ParsingJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var jobs []*entity.ParsingJob
+	for _, job := range r.jobs {
+		if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, job.Status) {
+			continue
+		}
+		if filter.OwnerName != "" && !strings.EqualFold(job.Params.OwnerName, filter.OwnerName) {
+			continue
+		}
+		if filter.RepoName != "" && !strings.EqualFold(job.Params.RepoName, filter.RepoName) {
+			continue
+		}
+		if filter.ParentID != "" && job.Params.ParentID != filter.ParentID {
+			continue
+		}
+		if filter.JobType != "" && jobType(job) != filter.JobType {
+			continue
+		}
+		if !filter.CreatedAfter.IsZero() && job.CreatedAt.Before(filter.CreatedAfter) {
+			continue
+		}
+		if !filter.CreatedBefore.IsZero() && !job.CreatedAt.Before(filter.CreatedBefore) {
+			continue
+		}
+		jobs = append(jobs, cloneJob(job))
+	}
+
+	// Сортировка по времени создания (сначала новые)
+	sort.Slice(jobs, func(i, j int) bool {
+		if !jobs[i].CreatedAt.Equal(jobs[j].CreatedAt) {
+			return jobs[i].CreatedAt.After(jobs[j].CreatedAt)
+		}
+		return jobs[i].ID > jobs[j].ID
+	})
+
+	return paginate(jobs, filter.Offset, filter.Limit), nil
+}
+
+func (r *JobRepositoryMemory) ListUnleased(ctx context.Context, now time.Time, statuses ...string) ([]*entity.ParsingJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var jobs []*entity.ParsingJob
+	for _, job := range r.jobs {
+		if slices.Contains(statuses, job.Status) && !job.Leased(now) {
+			jobs = append(jobs, cloneJob(job))
+		}
+	}
+
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].CreatedAt.Before(jobs[j].CreatedAt) })
+	return jobs, nil
+}
+
+func (r *JobRepositoryMemory) Claim(ctx context.Context, id, owner string, now, until time.Time, statuses ...string) (*entity.ParsingJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok || !slices.Contains(statuses, job.Status) {
+		return nil, nil
+	}
+	if job.LeaseOwner != owner && job.Leased(now) {
+		return nil, nil
+	}
+
+	job.LeaseOwner = owner
+	job.LeaseExpiresAt = &until
+	return cloneJob(job), nil
+}
+
+func (r *JobRepositoryMemory) Release(ctx context.Context, id, owner string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if job, ok := r.jobs[id]; ok && job.LeaseOwner == owner {
+		job.LeaseOwner = ""
+		job.LeaseExpiresAt = nil
+		job.StopRequest = ""
+	}
+	return nil
+}
+
+func (r *JobRepositoryMemory) RequestStop(ctx context.Context, id, stop string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if job, ok := r.jobs[id]; ok {
+		job.StopRequest = stop
+	}
+	return nil
+}
+
+func (r *JobRepositoryMemory) SaveCallback(ctx context.Context, id string, delivery entity.CallbackDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil
+	}
+
+	delivery = cloneCallback(delivery)
+	for i := range job.Callbacks {
+		if job.Callbacks[i].ID == delivery.ID {
+			job.Callbacks[i] = delivery
+			return nil
+		}
+	}
+	job.Callbacks = append(job.Callbacks, delivery)
+	return nil
+}
+
+func (r *JobRepositoryMemory) ListPendingCallbacks(ctx context.Context) ([]*entity.ParsingJob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var jobs []*entity.ParsingJob
+	for _, job := range r.jobs {
+		if slices.ContainsFunc(job.Callbacks, func(delivery entity.CallbackDelivery) bool {
+			return delivery.Status == entity.CallbackStatusPending
+		}) {
+			jobs = append(jobs, cloneJob(job))
+		}
+	}
+
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].CreatedAt.Before(jobs[j].CreatedAt) })
+	return jobs, nil
+}
+
+// jobType returns the type of a job, jobs stored without one are parse jobs
+func jobType(job *entity.ParsingJob) string {
+	if job.Params.JobType == "" {
+		return entity.JobTypeParse
+	}
+	return job.Params.JobType
+}
+
+// cloneJob copies a job so callers cannot modify the stored one
+func cloneJob(job *entity.ParsingJob) *entity.ParsingJob {
+	clone := *job
+	clone.Transitions = append([]entity.JobTransition(nil), job.Transitions...)
+	clone.CompletedSteps = append([]string(nil), job.CompletedSteps...)
+	clone.Steps = append([]entity.JobStepResult(nil), job.Steps...)
+	for i := range clone.Steps {
+		clone.Steps[i].SampleErrors = append([]string(nil), job.Steps[i].SampleErrors...)
+		clone.Steps[i].FailedItems = append([]entity.ItemFailure(nil), job.Steps[i].FailedItems...)
+	}
+	clone.Checkpoints = append([]entity.JobCheckpoint(nil), job.Checkpoints...)
+	clone.AttemptHistory = append([]entity.JobAttempt(nil), job.AttemptHistory...)
+	if job.Batch != nil {
+		batch := *job.Batch
+		batch.JobIDs = append([]string(nil), job.Batch.JobIDs...)
+		clone.Batch = &batch
+	}
+	if job.LeaseExpiresAt != nil {
+		expires := *job.LeaseExpiresAt
+		clone.LeaseExpiresAt = &expires
+	}
+	clone.Callbacks = nil
+	for _, delivery := range job.Callbacks {
+		clone.Callbacks = append(clone.Callbacks, cloneCallback(delivery))
+	}
+	return &clone
+}
+
+func cloneCallback(delivery entity.CallbackDelivery) entity.CallbackDelivery {
+	delivery.Attempts = append([]entity.CallbackAttempt(nil), delivery.Attempts...)
+	return delivery
+}
@@ -0,0 +1,15 @@
+package memory
+
+import (
+	"fmt"
+	"strings"
+)
+
+// key builds a composite map key from the parts of a unique index
+func key(parts ...interface{}) string {
+	strs := make([]string, len(parts))
+	for i, part := range parts {
+		strs[i] = fmt.Sprint(part)
+	}
+	return strings.Join(strs, "\x00")
+}
@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+)
+
+type PullRequestRepositoryMemory struct {
+	mu  sync.RWMutex
+	prs map[int64]entity.PullRequest
+}
+
+func NewPullRequestRepository() repository.PullRequestRepository {
+	return &PullRequestRepositoryMemory{prs: make(map[int64]entity.PullRequest)}
+}
+
+func (r *PullRequestRepositoryMemory) Save(ctx context.Context, pr *entity.PullRequest) (entity.SaveOutcome, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *pr
+	// A pull request seen upstream again is no longer deleted
+	saved.DeletedAt = nil
+	outcome := entity.SaveCreated
+	if existing, ok := r.prs[pr.ID]; ok {
+		outcome = entity.SaveUpdated
+		if reflect.DeepEqual(existing, saved) {
+			outcome = entity.SaveUnchanged
+		}
+	}
+	r.prs[pr.ID] = saved
+	return outcome, nil
+}
+
+func (r *PullRequestRepositoryMemory) FindByID(ctx context.Context, id int64) (*entity.PullRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	pr, ok := r.prs[id]
+	if !ok {
+		return nil, nil
+	}
+	return &pr, nil
+}
+
+func (r *PullRequestRepositoryMemory) FindByNumber(ctx context.Context, repoID int64, number int) (*entity.PullRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, pr := range r.prs {
+		if pr.RepositoryID == repoID && pr.Number == number {
+			return &pr, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *PullRequestRepositoryMemory) List(ctx context.Context, filter repository.PullRequestFilter) ([]*entity.PullRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var prs []*entity.PullRequest
+	for _, pr := range r.prs {
+		if filter.RepositoryID != 0 && pr.RepositoryID != filter.RepositoryID {
+			continue
+		}
+		if filter.State != "" && pr.State != filter.State {
+			continue
+		}
+		if filter.CIState != "" && pr.CI.State != filter.CIState {
+			continue
+		}
+		if !filter.IncludeDeleted && pr.DeletedAt != nil {
+			continue
+		}
+		pr := pr
+		prs = append(prs, &pr)
+	}
+
+	// Сортировка по времени создания (сначала новые)
+	sort.Slice(prs, func(i, j int) bool {
+		if !prs[i].CreatedAt.Equal(prs[j].CreatedAt) {
+			return prs[i].CreatedAt.After(prs[j].CreatedAt)
+		}
+		return prs[i].ID > prs[j].ID
+	})
+
+	return paginate(prs, filter.Offset, filter.Limit), nil
+}
+
+func (r *PullRequestRepositoryMemory) MarkDeleted(ctx context.Context, id int64, deletedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pr, ok := r.prs[id]
+	if !ok {
+		return nil
+	}
+	pr.DeletedAt = &deletedAt
+	r.prs[id] = pr
+	return nil
+}
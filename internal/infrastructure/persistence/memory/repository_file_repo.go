@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+)
+
+type RepositoryFileRepositoryMemory struct {
+	mu    sync.RWMutex
+	files map[string]entity.RepositoryFile
+}
+
+func NewRepositoryFileRepository() repository.RepositoryFileRepository {
+	return &RepositoryFileRepositoryMemory{files: make(map[string]entity.RepositoryFile)}
+}
+
+func (r *RepositoryFileRepositoryMemory) Save(ctx context.Context, file *entity.RepositoryFile) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.files[key(file.RepositoryID, file.Path)] = *file
+	return nil
+}
+
+func (r *RepositoryFileRepositoryMemory) FindByPath(ctx context.Context, repoID int64, path string) (*entity.RepositoryFile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	file, ok := r.files[key(repoID, path)]
+	if !ok {
+		return nil, nil
+	}
+	return &file, nil
+}
+
+func (r *RepositoryFileRepositoryMemory) Delete(ctx context.Context, repoID int64, path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.files, key(repoID, path))
+	return nil
+}
@@ -0,0 +1,122 @@
+// Package memory provides in-memory implementations of the domain repositories for tests and demos
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+)
+
+type RepositoryRepositoryMemory struct {
+	mu    sync.RWMutex
+	repos map[int64]entity.Repository
+}
+
+func NewRepositoryRepository() repository.RepositoryRepository {
+	return &RepositoryRepositoryMemory{repos: make(map[int64]entity.Repository)}
+}
+
+func (r *RepositoryRepositoryMemory) Save(ctx context.Context, repo *entity.Repository) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *repo
+	// Keep the stored rename history when the caller did not load it
+	if saved.Renames == nil {
+		saved.Renames = r.repos[repo.ID].Renames
+	}
+	r.repos[repo.ID] = saved
+	return nil
+}
+
+func (r *RepositoryRepositoryMemory) FindByID(ctx context.Context, id int64) (*entity.Repository, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	repo, ok := r.repos[id]
+	if !ok {
+		return nil, nil
+	}
+	return &repo, nil
+}
+
+func (r *RepositoryRepositoryMemory) FindByOwnerAndName(ctx context.Context, owner, name string) (*entity.Repository, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	fullName := owner + "/" + name
+	for _, repo := range r.repos {
+		if repo.OwnerLogin == owner && repo.Name == name {
+			return &repo, nil
+		}
+		for _, rename := range repo.Renames {
+			if rename.FromFullName == fullName {
+				return &repo, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (r *RepositoryRepositoryMemory) List(ctx context.Context, filter repository.RepositoryFilter) ([]*entity.Repository, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var repos []*entity.Repository
+	for _, repo := range r.repos {
+		if filter.OwnerLogin != "" && repo.OwnerLogin != filter.OwnerLogin {
+			continue
+		}
+		if filter.Language != "" && repo.Language != filter.Language {
+			continue
+		}
+		if repo.StarsCount < filter.MinStars {
+			continue
+		}
+		if !filter.IncludeDeleted && repo.DeletedAt != nil {
+			continue
+		}
+		repo := repo
+		repos = append(repos, &repo)
+	}
+
+	sort.Slice(repos, func(i, j int) bool {
+		if repos[i].StarsCount != repos[j].StarsCount {
+			return repos[i].StarsCount > repos[j].StarsCount
+		}
+		return strings.Compare(repos[i].FullName, repos[j].FullName) < 0
+	})
+
+	return paginate(repos, filter.Offset, filter.Limit), nil
+}
+
+func (r *RepositoryRepositoryMemory) MarkDeleted(ctx context.Context, id int64, deletedAt time.Time, reason string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	repo, ok := r.repos[id]
+	if !ok {
+		return nil
+	}
+	repo.DeletedAt = &deletedAt
+	repo.DeletionReason = reason
+	r.repos[id] = repo
+	return nil
+}
+
+// paginate applies offset and limit to a sorted slice; a zero limit returns everything after offset
+func paginate[T any](items []T, offset, limit int) []T {
+	if offset >= len(items) {
+		return nil
+	}
+	items = items[offset:]
+	if limit > 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+)
+
+type ScheduleRepositoryMemory struct {
+	mu        sync.RWMutex
+	schedules map[string]entity.Schedule
+}
+
+func NewScheduleRepository() repository.ScheduleRepository {
+	return &ScheduleRepositoryMemory{schedules: make(map[string]entity.Schedule)}
+}
+
+func (r *ScheduleRepositoryMemory) Save(ctx context.Context, schedule *entity.Schedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.schedules[schedule.ID] = *schedule
+	return nil
+}
+
+func (r *ScheduleRepositoryMemory) FindByID(ctx context.Context, id string) (*entity.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedule, ok := r.schedules[id]
+	if !ok {
+		return nil, nil
+	}
+	return &schedule, nil
+}
+
+func (r *ScheduleRepositoryMemory) List(ctx context.Context) ([]*entity.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var schedules []*entity.Schedule
+	for _, schedule := range r.schedules {
+		schedule := schedule
+		schedules = append(schedules, &schedule)
+	}
+
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].CreatedAt.Before(schedules[j].CreatedAt) })
+	return schedules, nil
+}
+
+func (r *ScheduleRepositoryMemory) ListDue(ctx context.Context, now time.Time) ([]*entity.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var schedules []*entity.Schedule
+	for _, schedule := range r.schedules {
+		if schedule.NextRunAt.IsZero() || schedule.NextRunAt.After(now) {
+			continue
+		}
+		schedule := schedule
+		schedules = append(schedules, &schedule)
+	}
+
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].NextRunAt.Before(schedules[j].NextRunAt) })
+	return schedules, nil
+}
+
+func (r *ScheduleRepositoryMemory) ClaimRun(ctx context.Context, id string, due, next time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	schedule, ok := r.schedules[id]
+	if !ok || !schedule.NextRunAt.Equal(due) {
+		return false, nil
+	}
+	schedule.NextRunAt = next
+	r.schedules[id] = schedule
+	return true, nil
+}
+
+func (r *ScheduleRepositoryMemory) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.schedules, id)
+	return nil
+}
@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+)
+
+type SecurityAlertRepositoryMemory struct {
+	mu     sync.RWMutex
+	alerts map[string]entity.SecurityAlert
+}
+
+func NewSecurityAlertRepository() repository.SecurityAlertRepository {
+	return &SecurityAlertRepositoryMemory{alerts: make(map[string]entity.SecurityAlert)}
+}
+
+func (r *SecurityAlertRepositoryMemory) Save(ctx context.Context, alert *entity.SecurityAlert) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.alerts[key(alert.RepositoryID, alert.Source, alert.Number, alert.GHSAID, alert.Package)] = *alert
+	return nil
+}
+
+func (r *SecurityAlertRepositoryMemory) List(ctx context.Context, filter repository.SecurityAlertFilter) ([]*entity.SecurityAlert, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var alerts []*entity.SecurityAlert
+	for _, alert := range r.alerts {
+		if filter.RepositoryID != 0 && alert.RepositoryID != filter.RepositoryID {
+			continue
+		}
+		if filter.Source != "" && alert.Source != filter.Source {
+			continue
+		}
+		if filter.Severity != "" && alert.Severity != filter.Severity {
+			continue
+		}
+		if filter.State != "" && alert.State != filter.State {
+			continue
+		}
+		alert := alert
+		alerts = append(alerts, &alert)
+	}
+
+	// Сортировка по времени создания (сначала новые)
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].CreatedAt.After(alerts[j].CreatedAt) })
+
+	return paginate(alerts, filter.Offset, filter.Limit), nil
+}
+
+func (r *SecurityAlertRepositoryMemory) CountOpenByRepository(ctx context.Context) (map[int64]int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := make(map[int64]int)
+	for _, alert := range r.alerts {
+		if alert.Open {
+			counts[alert.RepositoryID]++
+		}
+	}
+	return counts, nil
+}
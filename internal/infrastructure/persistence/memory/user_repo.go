@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+)
+
+type UserRepositoryMemory struct {
+	mu    sync.RWMutex
+	users map[int64]entity.User
+}
+
+func NewUserRepository() repository.UserRepository {
+	return &UserRepositoryMemory{users: make(map[int64]entity.User)}
+}
+
+func (r *UserRepositoryMemory) Save(ctx context.Context, user *entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users[user.ID] = *user
+	return nil
+}
+
+func (r *UserRepositoryMemory) FindByID(ctx context.Context, id int64) (*entity.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return nil, nil
+	}
+	return &user, nil
+}
+
+func (r *UserRepositoryMemory) FindByLogin(ctx context.Context, login string) (*entity.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, user := range r.users {
+		if user.Login == login {
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *UserRepositoryMemory) List(ctx context.Context, filter repository.UserFilter) ([]*entity.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var users []*entity.User
+	for _, user := range r.users {
+		// Частичное совпадение логина без учёта регистра, как в MongoDB
+		if filter.Login != "" && !strings.Contains(strings.ToLower(user.Login), strings.ToLower(filter.Login)) {
+			continue
+		}
+		user := user
+		users = append(users, &user)
+	}
+
+	sort.Slice(users, func(i, j int) bool { return users[i].Login < users[j].Login })
+
+	return paginate(users, filter.Offset, filter.Limit), nil
+}
@@ -0,0 +1,72 @@
+package mongodb
+
+import (
+	"context"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CICheckRepositoryMongo struct {
+	collection *mongo.Collection
+	logger     *logger.Logger
+}
+
+func NewCICheckRepository(db *mongo.Database, logger *logger.Logger) repository.CICheckRepository {
+	return &CICheckRepositoryMongo{
+		collection: db.Collection("ci_checks"),
+		logger:     logger,
+	}
+}
+
+func (r *CICheckRepositoryMongo) Save(ctx context.Context, check *entity.CICheck) error {
+	// Status and check run IDs come from different sequences, so the source is part of the key
+	filter := bson.M{"source": check.Source, "id": check.ID}
+	update := bson.M{"$set": bson.M{
+		"id":            check.ID,
+		"source":        check.Source,
+		"name":          check.Name,
+		"state":         check.State,
+		"rawState":      check.RawState,
+		"url":           check.URL,
+		"headSHA":       check.HeadSHA,
+		"pullRequestID": check.PullRequestID,
+		"repositoryID":  check.RepositoryID,
+		"startedAt":     check.StartedAt,
+		"completedAt":   check.CompletedAt,
+	}}
+
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		r.logger.Error("Failed to save CI check: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *CICheckRepositoryMongo) ListByPullRequest(ctx context.Context, pullRequestID int64) ([]*entity.CICheck, error) {
+	findFilter := bson.M{"pullRequestID": pullRequestID}
+
+	// Сортировка по имени проверки
+	findOptions := options.Find().SetSort(bson.M{"name": 1})
+
+	cursor, err := r.collection.Find(ctx, findFilter, findOptions)
+	if err != nil {
+		r.logger.Error("Failed to list CI checks: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var checks []*entity.CICheck
+	if err := cursor.All(ctx, &checks); err != nil {
+		r.logger.Error("Failed to decode CI checks: %v", err)
+		return nil, err
+	}
+
+	return checks, nil
+}
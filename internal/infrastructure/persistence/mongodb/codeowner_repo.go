@@ -0,0 +1,65 @@
+package mongodb
+
+import (
+	"context"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CodeOwnerRepositoryMongo struct {
+	collection *mongo.Collection
+	logger     *logger.Logger
+}
+
+func NewCodeOwnerRepository(db *mongo.Database, logger *logger.Logger) repository.CodeOwnerRepository {
+	return &CodeOwnerRepositoryMongo{
+		collection: db.Collection("codeowner_rules"),
+		logger:     logger,
+	}
+}
+
+func (r *CodeOwnerRepositoryMongo) ReplaceForRepository(ctx context.Context, repoID int64, rules []*entity.CodeOwnerRule) error {
+	if _, err := r.collection.DeleteMany(ctx, bson.M{"repositoryID": repoID}); err != nil {
+		r.logger.Error("Failed to delete CODEOWNERS rules: %v", err)
+		return err
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		docs = append(docs, rule)
+	}
+
+	if _, err := r.collection.InsertMany(ctx, docs); err != nil {
+		r.logger.Error("Failed to insert CODEOWNERS rules: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *CodeOwnerRepositoryMongo) ListByRepository(ctx context.Context, repoID int64) ([]*entity.CodeOwnerRule, error) {
+	findOptions := options.Find().SetSort(bson.M{"line": 1})
+
+	cursor, err := r.collection.Find(ctx, bson.M{"repositoryID": repoID}, findOptions)
+	if err != nil {
+		r.logger.Error("Failed to list CODEOWNERS rules: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rules []*entity.CodeOwnerRule
+	if err := cursor.All(ctx, &rules); err != nil {
+		r.logger.Error("Failed to decode CODEOWNERS rules: %v", err)
+		return nil, err
+	}
+
+	return rules, nil
+}
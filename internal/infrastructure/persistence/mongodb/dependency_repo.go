@@ -0,0 +1,94 @@
+package mongodb
+
+import (
+	"context"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type DependencyRepositoryMongo struct {
+	collection *mongo.Collection
+	logger     *logger.Logger
+}
+
+func NewDependencyRepository(db *mongo.Database, logger *logger.Logger) repository.DependencyRepository {
+	return &DependencyRepositoryMongo{
+		collection: db.Collection("dependencies"),
+		logger:     logger,
+	}
+}
+
+func (r *DependencyRepositoryMongo) ReplaceForManifest(ctx context.Context, repoID int64, manifest string, deps []*entity.Dependency) error {
+	filter := bson.M{"repositoryID": repoID, "manifest": manifest}
+	if _, err := r.collection.DeleteMany(ctx, filter); err != nil {
+		r.logger.Error("Failed to delete dependencies: %v", err)
+		return err
+	}
+
+	if len(deps) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, 0, len(deps))
+	for _, dep := range deps {
+		docs = append(docs, dep)
+	}
+
+	if _, err := r.collection.InsertMany(ctx, docs); err != nil {
+		r.logger.Error("Failed to insert dependencies: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *DependencyRepositoryMongo) List(ctx context.Context, filter repository.DependencyFilter) ([]*entity.Dependency, error) {
+	findFilter := bson.M{}
+
+	if filter.Name != "" {
+		findFilter["name"] = filter.Name
+	}
+
+	if filter.Version != "" {
+		findFilter["version"] = filter.Version
+	}
+
+	if filter.Ecosystem != "" {
+		findFilter["ecosystem"] = filter.Ecosystem
+	}
+
+	if filter.RepositoryID != 0 {
+		findFilter["repositoryID"] = filter.RepositoryID
+	}
+
+	// Настройка пагинации
+	findOptions := options.Find()
+	if filter.Limit > 0 {
+		findOptions.SetLimit(int64(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		findOptions.SetSkip(int64(filter.Offset))
+	}
+
+	// Сортировка по имени репозитория
+	findOptions.SetSort(bson.D{{Key: "repositoryFullName", Value: 1}, {Key: "name", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, findFilter, findOptions)
+	if err != nil {
+		r.logger.Error("Failed to list dependencies: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var deps []*entity.Dependency
+	if err := cursor.All(ctx, &deps); err != nil {
+		r.logger.Error("Failed to decode dependencies: %v", err)
+		return nil, err
+	}
+
+	return deps, nil
+}
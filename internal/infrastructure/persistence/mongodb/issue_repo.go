@@ -2,6 +2,8 @@ package mongodb
 
 import (
 	"context"
+	"time"
+
 	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
 	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
 	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
@@ -22,7 +24,7 @@ func NewIssueRepository(db *mongo.Database, logger *logger.Logger) repository.Is
 	}
 }
 
-func (r *IssueRepositoryMongo) Save(ctx context.Context, issue *entity.Issue) error {
+func (r *IssueRepositoryMongo) Save(ctx context.Context, issue *entity.Issue) (entity.SaveOutcome, error) {
 	filter := bson.M{"id": issue.ID}
 	update := bson.M{"$set": bson.M{
 		"id":           issue.ID,
@@ -35,16 +37,25 @@ func (r *IssueRepositoryMongo) Save(ctx context.Context, issue *entity.Issue) er
 		"createdAt":    issue.CreatedAt,
 		"updatedAt":    issue.UpdatedAt,
 		"closedAt":     issue.ClosedAt,
+		// An issue seen upstream again is no longer deleted
+		"deletedAt":     nil,
+		"transferredTo": "",
 	}}
 
 	opts := options.Update().SetUpsert(true)
-	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
 	if err != nil {
 		r.logger.Error("Failed to save issue: %v", err)
-		return err
+		return "", err
 	}
 
-	return nil
+	switch {
+	case result.UpsertedCount > 0:
+		return entity.SaveCreated, nil
+	case result.ModifiedCount > 0:
+		return entity.SaveUpdated, nil
+	}
+	return entity.SaveUnchanged, nil
 }
 
 func (r *IssueRepositoryMongo) FindByID(ctx context.Context, id int64) (*entity.Issue, error) {
@@ -75,6 +86,10 @@ func (r *IssueRepositoryMongo) List(ctx context.Context, filter repository.Issue
 		findFilter["state"] = filter.State
 	}
 
+	if !filter.IncludeDeleted {
+		findFilter["deletedAt"] = nil
+	}
+
 	// Настройка пагинации
 	findOptions := options.Find()
 	if filter.Limit > 0 {
@@ -102,3 +117,32 @@ func (r *IssueRepositoryMongo) List(ctx context.Context, filter repository.Issue
 
 	return issues, nil
 }
+
+func (r *IssueRepositoryMongo) MarkDeleted(ctx context.Context, id int64, deletedAt time.Time) error {
+	filter := bson.M{"id": id}
+	update := bson.M{"$set": bson.M{"deletedAt": deletedAt}}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.logger.Error("Failed to mark issue as deleted: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *IssueRepositoryMongo) MarkTransferred(ctx context.Context, id int64, transferredTo string, transferredAt time.Time) error {
+	filter := bson.M{"id": id}
+	update := bson.M{"$set": bson.M{
+		"deletedAt":     transferredAt,
+		"transferredTo": transferredTo,
+	}}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.logger.Error("Failed to mark issue as transferred: %v", err)
+		return err
+	}
+
+	return nil
+}
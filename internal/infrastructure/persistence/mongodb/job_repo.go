@@ -0,0 +1,333 @@
+package mongodb
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type JobRepositoryMongo struct {
+	collection *mongo.Collection
+	logger     *logger.Logger
+}
+
+func NewJobRepository(db *mongo.Database, logger *logger.Logger) repository.JobRepository {
+	return &JobRepositoryMongo{
+		collection: db.Collection("parsing_jobs"),
+		logger:     logger,
+	}
+}
+
+func (r *JobRepositoryMongo) Save(ctx context.Context, job *entity.ParsingJob) error {
+	// Only the lease owner of the saved copy may replace the stored job
+	filter := bson.M{"id": job.ID, "leaseOwner": job.LeaseOwner}
+	if job.LeaseOwner == "" {
+		filter["leaseOwner"] = bson.M{"$in": bson.A{nil, ""}}
+	}
+	update := bson.M{"$set": bson.M{
+		"id":             job.ID,
+		"params":         job.Params,
+		"status":         job.Status,
+		"progress":       job.Progress,
+		"errorMessage":   job.ErrorMessage,
+		"transitions":    job.Transitions,
+		"results":        job.Results,
+		"completedSteps": job.CompletedSteps,
+		"steps":          job.Steps,
+		"checkpoints":    job.Checkpoints,
+		"reconciliation": job.Reconciliation,
+		"attempts":       job.Attempts,
+		"failedAttempts": job.FailedAttempts,
+		"attemptHistory": job.AttemptHistory,
+		"nextAttemptAt":  job.NextAttemptAt,
+		"batch":          job.Batch,
+		"createdAt":      job.CreatedAt,
+		"updatedAt":      job.UpdatedAt,
+		"startedAt":      job.StartedAt,
+		"finishedAt":     job.FinishedAt,
+	}, "$setOnInsert": bson.M{
+		// The lease of a stored job changes only through Claim and Release; callbacks are left to SaveCallback
+		"leaseOwner":     job.LeaseOwner,
+		"leaseExpiresAt": job.LeaseExpiresAt,
+	}}
+
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			// The upsert of a job held by another owner collides with the stored job itself
+			if stored, findErr := r.FindByID(ctx, job.ID); findErr == nil && stored != nil {
+				return repository.ErrLeaseLost
+			}
+			return repository.ErrDuplicateJob
+		}
+		r.logger.Error("Failed to save parsing job: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// EnsureJobIndexes creates the indexes the job repository relies on: unique job IDs and idempotency keys
+func EnsureJobIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("parsing_jobs").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "params.idempotencyKey", Value: 1}},
+			// Only jobs submitted with a key take part
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{
+				"params.idempotencyKey": bson.M{"$type": "string"},
+			}),
+		},
+	})
+	return err
+}
+
+func (r *JobRepositoryMongo) FindByID(ctx context.Context, id string) (*entity.ParsingJob, error) {
+	var job entity.ParsingJob
+	err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&job)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find parsing job: %v", err)
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (r *JobRepositoryMongo) FindByIdempotencyKey(ctx context.Context, key string) (*entity.ParsingJob, error) {
+	var job entity.ParsingJob
+	err := r.collection.FindOne(ctx, bson.M{"params.idempotencyKey": key}).Decode(&job)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find parsing job by idempotency key: %v", err)
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (r *JobRepositoryMongo) ListByStatus(ctx context.Context, statuses ...string) ([]*entity.ParsingJob, error) {
+	findFilter := bson.M{"status": bson.M{"$in": statuses}}
+	findOptions := options.Find().SetSort(bson.M{"createdAt": 1})
+
+	cursor, err := r.collection.Find(ctx, findFilter, findOptions)
+	if err != nil {
+		r.logger.Error("Failed to list parsing jobs: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var jobs []*entity.ParsingJob
+	if err := cursor.All(ctx, &jobs); err != nil {
+		r.logger.Error("Failed to decode parsing jobs: %v", err)
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// equalFold matches a string equal to s under case folding, like strings.EqualFold
+func equalFold(s string) primitive.Regex {
+	return primitive.Regex{Pattern: "^" + regexp.QuoteMeta(s) + "$", Options: "i"}
+}
+
+// unleased matches jobs no replica holds at now
+func unleased(now time.Time) bson.A {
+	return bson.A{
+		bson.M{"leaseOwner": bson.M{"$in": bson.A{nil, ""}}},
+		bson.M{"leaseExpiresAt": nil},
+		bson.M{"leaseExpiresAt": bson.M{"$lte": now}},
+	}
+}
+
+func (r *JobRepositoryMongo) ListUnleased(ctx context.Context, now time.Time, statuses ...string) ([]*entity.ParsingJob, error) {
+	findFilter := bson.M{
+		"status": bson.M{"$in": statuses},
+		"$or":    unleased(now),
+	}
+	findOptions := options.Find().SetSort(bson.M{"createdAt": 1})
+
+	cursor, err := r.collection.Find(ctx, findFilter, findOptions)
+	if err != nil {
+		r.logger.Error("Failed to list unleased parsing jobs: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var jobs []*entity.ParsingJob
+	if err := cursor.All(ctx, &jobs); err != nil {
+		r.logger.Error("Failed to decode parsing jobs: %v", err)
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (r *JobRepositoryMongo) Claim(ctx context.Context, id, owner string, now, until time.Time, statuses ...string) (*entity.ParsingJob, error) {
+	// A single findOneAndUpdate makes the claim atomic across replicas
+	filter := bson.M{
+		"id":     id,
+		"status": bson.M{"$in": statuses},
+		"$or":    append(unleased(now), bson.M{"leaseOwner": owner}),
+	}
+	update := bson.M{"$set": bson.M{
+		"leaseOwner":     owner,
+		"leaseExpiresAt": until,
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var job entity.ParsingJob
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&job)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		r.logger.Error("Failed to claim parsing job: %v", err)
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (r *JobRepositoryMongo) Release(ctx context.Context, id, owner string) error {
+	filter := bson.M{"id": id, "leaseOwner": owner}
+	update := bson.M{"$set": bson.M{
+		"leaseOwner":     "",
+		"leaseExpiresAt": nil,
+		"stopRequest":    "",
+	}}
+
+	if _, err := r.collection.UpdateOne(ctx, filter, update); err != nil {
+		r.logger.Error("Failed to release parsing job: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *JobRepositoryMongo) RequestStop(ctx context.Context, id, stop string) error {
+	update := bson.M{"$set": bson.M{"stopRequest": stop}}
+
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"id": id}, update); err != nil {
+		r.logger.Error("Failed to request stop of parsing job: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *JobRepositoryMongo) SaveCallback(ctx context.Context, id string, delivery entity.CallbackDelivery) error {
+	// Replace the stored delivery, or append it when there is none yet
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"id": id, "callbacks.id": delivery.ID},
+		bson.M{"$set": bson.M{"callbacks.$": delivery}})
+	if err == nil && result.MatchedCount == 0 {
+		_, err = r.collection.UpdateOne(ctx,
+			bson.M{"id": id, "callbacks.id": bson.M{"$ne": delivery.ID}},
+			bson.M{"$push": bson.M{"callbacks": delivery}})
+	}
+	if err != nil {
+		r.logger.Error("Failed to save callback of parsing job: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *JobRepositoryMongo) ListPendingCallbacks(ctx context.Context) ([]*entity.ParsingJob, error) {
+	findFilter := bson.M{"callbacks.status": entity.CallbackStatusPending}
+	findOptions := options.Find().SetSort(bson.M{"createdAt": 1})
+
+	cursor, err := r.collection.Find(ctx, findFilter, findOptions)
+	if err != nil {
+		r.logger.Error("Failed to list parsing jobs with pending callbacks: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var jobs []*entity.ParsingJob
+	if err := cursor.All(ctx, &jobs); err != nil {
+		r.logger.Error("Failed to decode parsing jobs: %v", err)
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (r *JobRepositoryMongo) List(ctx context.Context, filter repository.JobFilter) ([]*entity.ParsingJob, error) {
+	findFilter := bson.M{}
+
+	if len(filter.Statuses) > 0 {
+		findFilter["status"] = bson.M{"$in": filter.Statuses}
+	}
+
+	if filter.OwnerName != "" {
+		findFilter["params.ownerName"] = equalFold(filter.OwnerName)
+	}
+
+	if filter.RepoName != "" {
+		findFilter["params.repoName"] = equalFold(filter.RepoName)
+	}
+
+	if filter.ParentID != "" {
+		findFilter["params.parentId"] = filter.ParentID
+	}
+
+	if filter.JobType == entity.JobTypeParse {
+		// Jobs stored without a type are parse jobs
+		findFilter["params.jobType"] = bson.M{"$in": bson.A{nil, "", entity.JobTypeParse}}
+	} else if filter.JobType != "" {
+		findFilter["params.jobType"] = filter.JobType
+	}
+
+	createdAt := bson.M{}
+	if !filter.CreatedAfter.IsZero() {
+		createdAt["$gte"] = filter.CreatedAfter
+	}
+	if !filter.CreatedBefore.IsZero() {
+		createdAt["$lt"] = filter.CreatedBefore
+	}
+	if len(createdAt) > 0 {
+		findFilter["createdAt"] = createdAt
+	}
+
+	// Настройка пагинации
+	findOptions := options.Find()
+	if filter.Limit > 0 {
+		findOptions.SetLimit(int64(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		findOptions.SetSkip(int64(filter.Offset))
+	}
+
+	// Сортировка по времени создания (сначала новые)
+	findOptions.SetSort(bson.D{{Key: "createdAt", Value: -1}, {Key: "id", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, findFilter, findOptions)
+	if err != nil {
+		r.logger.Error("Failed to list parsing jobs: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var jobs []*entity.ParsingJob
+	if err := cursor.All(ctx, &jobs); err != nil {
+		r.logger.Error("Failed to decode parsing jobs: %v", err)
+		return nil, err
+	}
+
+	return jobs, nil
+}
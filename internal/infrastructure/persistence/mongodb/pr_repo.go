@@ -2,6 +2,8 @@ package mongodb
 
 import (
 	"context"
+	"time"
+
 	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
 	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
 	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
@@ -22,7 +24,7 @@ func NewPullRequestRepository(db *mongo.Database, logger *logger.Logger) reposit
 	}
 }
 
-func (r *PullRequestRepositoryMongo) Save(ctx context.Context, pr *entity.PullRequest) error {
+func (r *PullRequestRepositoryMongo) Save(ctx context.Context, pr *entity.PullRequest) (entity.SaveOutcome, error) {
 	filter := bson.M{"id": pr.ID}
 	update := bson.M{"$set": bson.M{
 		"id":           pr.ID,
@@ -32,20 +34,30 @@ func (r *PullRequestRepositoryMongo) Save(ctx context.Context, pr *entity.PullRe
 		"state":        pr.State,
 		"authorLogin":  pr.AuthorLogin,
 		"repositoryID": pr.RepositoryID,
+		"headSHA":      pr.HeadSHA,
+		"ci":           pr.CI,
 		"createdAt":    pr.CreatedAt,
 		"updatedAt":    pr.UpdatedAt,
 		"mergedAt":     pr.MergedAt,
 		"closedAt":     pr.ClosedAt,
+		// A pull request seen upstream again is no longer deleted
+		"deletedAt": nil,
 	}}
 
 	opts := options.Update().SetUpsert(true)
-	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	result, err := r.collection.UpdateOne(ctx, filter, update, opts)
 	if err != nil {
 		r.logger.Error("Failed to save pull request: %v", err)
-		return err
+		return "", err
 	}
 
-	return nil
+	switch {
+	case result.UpsertedCount > 0:
+		return entity.SaveCreated, nil
+	case result.ModifiedCount > 0:
+		return entity.SaveUpdated, nil
+	}
+	return entity.SaveUnchanged, nil
 }
 
 func (r *PullRequestRepositoryMongo) FindByID(ctx context.Context, id int64) (*entity.PullRequest, error) {
@@ -96,6 +108,14 @@ func (r *PullRequestRepositoryMongo) List(ctx context.Context, filter repository
 		findFilter["state"] = filter.State
 	}
 
+	if !filter.IncludeDeleted {
+		findFilter["deletedAt"] = nil
+	}
+
+	if filter.CIState != "" {
+		findFilter["ci.state"] = filter.CIState
+	}
+
 	// Настройка пагинации
 	findOptions := options.Find()
 	if filter.Limit > 0 {
@@ -123,3 +143,16 @@ func (r *PullRequestRepositoryMongo) List(ctx context.Context, filter repository
 
 	return prs, nil
 }
+
+func (r *PullRequestRepositoryMongo) MarkDeleted(ctx context.Context, id int64, deletedAt time.Time) error {
+	filter := bson.M{"id": id}
+	update := bson.M{"$set": bson.M{"deletedAt": deletedAt}}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.logger.Error("Failed to mark pull request as deleted: %v", err)
+		return err
+	}
+
+	return nil
+}
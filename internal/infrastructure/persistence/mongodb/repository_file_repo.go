@@ -0,0 +1,72 @@
+package mongodb
+
+import (
+	"context"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RepositoryFileRepositoryMongo struct {
+	collection *mongo.Collection
+	logger     *logger.Logger
+}
+
+func NewRepositoryFileRepository(db *mongo.Database, logger *logger.Logger) repository.RepositoryFileRepository {
+	return &RepositoryFileRepositoryMongo{
+		collection: db.Collection("repository_files"),
+		logger:     logger,
+	}
+}
+
+func (r *RepositoryFileRepositoryMongo) Save(ctx context.Context, file *entity.RepositoryFile) error {
+	filter := bson.M{"repositoryID": file.RepositoryID, "path": file.Path}
+	update := bson.M{"$set": bson.M{
+		"repositoryID": file.RepositoryID,
+		"path":         file.Path,
+		"sha":          file.SHA,
+		"size":         file.Size,
+		"content":      file.Content,
+		"fetchedAt":    file.FetchedAt,
+	}}
+
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		r.logger.Error("Failed to save repository file: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *RepositoryFileRepositoryMongo) FindByPath(ctx context.Context, repoID int64, path string) (*entity.RepositoryFile, error) {
+	filter := bson.M{"repositoryID": repoID, "path": path}
+
+	var file entity.RepositoryFile
+	err := r.collection.FindOne(ctx, filter).Decode(&file)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			r.logger.Info("Repository file not found: repo=%d, path=%s", repoID, path)
+			return nil, nil
+		}
+		r.logger.Error("Failed to find repository file by path: %v", err)
+		return nil, err
+	}
+
+	return &file, nil
+}
+
+func (r *RepositoryFileRepositoryMongo) Delete(ctx context.Context, repoID int64, path string) error {
+	filter := bson.M{"repositoryID": repoID, "path": path}
+
+	if _, err := r.collection.DeleteOne(ctx, filter); err != nil {
+		r.logger.Error("Failed to delete repository file: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package mongodb
 
 import (
 	"context"
+	"time"
+
 	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
 	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
 	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
@@ -24,7 +26,7 @@ func NewRepositoryRepository(db *mongo.Database, logger *logger.Logger) reposito
 
 func (r *RepositoryRepositoryMongo) Save(ctx context.Context, repo *entity.Repository) error {
 	filter := bson.M{"id": repo.ID}
-	update := bson.M{"$set": bson.M{
+	fields := bson.M{
 		"id":              repo.ID,
 		"name":            repo.Name,
 		"fullName":        repo.FullName,
@@ -37,7 +39,16 @@ func (r *RepositoryRepositoryMongo) Save(ctx context.Context, repo *entity.Repos
 		"openIssuesCount": repo.OpenIssuesCount,
 		"createdAt":       repo.CreatedAt,
 		"updatedAt":       repo.UpdatedAt,
-	}}
+		"deletedAt":       repo.DeletedAt,
+		"deletionReason":  repo.DeletionReason,
+	}
+
+	// Keep the stored rename history when the caller did not load it
+	if repo.Renames != nil {
+		fields["renames"] = repo.Renames
+	}
+
+	update := bson.M{"$set": fields}
 
 	opts := options.Update().SetUpsert(true)
 	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
@@ -67,10 +78,10 @@ func (r *RepositoryRepositoryMongo) FindByID(ctx context.Context, id int64) (*en
 }
 
 func (r *RepositoryRepositoryMongo) FindByOwnerAndName(ctx context.Context, owner, name string) (*entity.Repository, error) {
-	filter := bson.M{
-		"ownerLogin": owner,
-		"name":       name,
-	}
+	filter := bson.M{"$or": []bson.M{
+		{"ownerLogin": owner, "name": name},
+		{"renames.fromFullName": owner + "/" + name},
+	}}
 
 	var repo entity.Repository
 	err := r.collection.FindOne(ctx, filter).Decode(&repo)
@@ -101,6 +112,11 @@ func (r *RepositoryRepositoryMongo) List(ctx context.Context, filter repository.
 		findFilter["starsCount"] = bson.M{"$gte": filter.MinStars}
 	}
 
+	// Tombstoned repositories are hidden unless requested explicitly
+	if !filter.IncludeDeleted {
+		findFilter["deletedAt"] = nil
+	}
+
 	// Настройка пагинации
 	findOptions := options.Find()
 	if filter.Limit > 0 {
@@ -128,3 +144,19 @@ func (r *RepositoryRepositoryMongo) List(ctx context.Context, filter repository.
 
 	return repos, nil
 }
+
+func (r *RepositoryRepositoryMongo) MarkDeleted(ctx context.Context, id int64, deletedAt time.Time, reason string) error {
+	filter := bson.M{"id": id}
+	update := bson.M{"$set": bson.M{
+		"deletedAt":      deletedAt,
+		"deletionReason": reason,
+	}}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.logger.Error("Failed to mark repository as deleted: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package mongodb
+
+import (
+	"context"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type ScheduleRepositoryMongo struct {
+	collection *mongo.Collection
+	logger     *logger.Logger
+}
+
+func NewScheduleRepository(db *mongo.Database, logger *logger.Logger) repository.ScheduleRepository {
+	return &ScheduleRepositoryMongo{
+		collection: db.Collection("parsing_schedules"),
+		logger:     logger,
+	}
+}
+
+func (r *ScheduleRepositoryMongo) Save(ctx context.Context, schedule *entity.Schedule) error {
+	filter := bson.M{"id": schedule.ID}
+	update := bson.M{"$set": bson.M{
+		"id":             schedule.ID,
+		"params":         schedule.Params,
+		"cron":           schedule.Cron,
+		"interval":       schedule.Interval,
+		"lastRunAt":      schedule.LastRunAt,
+		"lastJobId":      schedule.LastJobID,
+		"lastRunResult":  schedule.LastRunResult,
+		"lastRunMessage": schedule.LastRunMessage,
+		"nextRunAt":      schedule.NextRunAt,
+		"createdAt":      schedule.CreatedAt,
+		"updatedAt":      schedule.UpdatedAt,
+	}}
+
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		r.logger.Error("Failed to save schedule: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *ScheduleRepositoryMongo) FindByID(ctx context.Context, id string) (*entity.Schedule, error) {
+	var schedule entity.Schedule
+	err := r.collection.FindOne(ctx, bson.M{"id": id}).Decode(&schedule)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		r.logger.Error("Failed to find schedule: %v", err)
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+func (r *ScheduleRepositoryMongo) List(ctx context.Context) ([]*entity.Schedule, error) {
+	return r.find(ctx, bson.M{}, options.Find().SetSort(bson.M{"createdAt": 1}))
+}
+
+func (r *ScheduleRepositoryMongo) ListDue(ctx context.Context, now time.Time) ([]*entity.Schedule, error) {
+	findFilter := bson.M{"nextRunAt": bson.M{"$gt": time.Time{}, "$lte": now}}
+	return r.find(ctx, findFilter, options.Find().SetSort(bson.M{"nextRunAt": 1}))
+}
+
+func (r *ScheduleRepositoryMongo) ClaimRun(ctx context.Context, id string, due, next time.Time) (bool, error) {
+	filter := bson.M{"id": id, "nextRunAt": due}
+	update := bson.M{"$set": bson.M{"nextRunAt": next}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.logger.Error("Failed to claim schedule run: %v", err)
+		return false, err
+	}
+
+	return result.MatchedCount == 1, nil
+}
+
+func (r *ScheduleRepositoryMongo) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.Schedule, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		r.logger.Error("Failed to list schedules: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var schedules []*entity.Schedule
+	if err := cursor.All(ctx, &schedules); err != nil {
+		r.logger.Error("Failed to decode schedules: %v", err)
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+func (r *ScheduleRepositoryMongo) Delete(ctx context.Context, id string) error {
+	if _, err := r.collection.DeleteOne(ctx, bson.M{"id": id}); err != nil {
+		r.logger.Error("Failed to delete schedule: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package mongodb
+
+import (
+	"context"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SecurityAlertRepositoryMongo struct {
+	collection *mongo.Collection
+	logger     *logger.Logger
+}
+
+func NewSecurityAlertRepository(db *mongo.Database, logger *logger.Logger) repository.SecurityAlertRepository {
+	return &SecurityAlertRepositoryMongo{
+		collection: db.Collection("security_alerts"),
+		logger:     logger,
+	}
+}
+
+func (r *SecurityAlertRepositoryMongo) Save(ctx context.Context, alert *entity.SecurityAlert) error {
+	// Dependabot alerts are keyed by number, advisories by GHSA ID and affected package
+	filter := bson.M{
+		"repositoryID": alert.RepositoryID,
+		"source":       alert.Source,
+		"number":       alert.Number,
+		"ghsaID":       alert.GHSAID,
+		"package":      alert.Package,
+	}
+	update := bson.M{"$set": bson.M{
+		"repositoryID":       alert.RepositoryID,
+		"repositoryFullName": alert.RepositoryFullName,
+		"source":             alert.Source,
+		"number":             alert.Number,
+		"ghsaID":             alert.GHSAID,
+		"cveID":              alert.CVEID,
+		"summary":            alert.Summary,
+		"severity":           alert.Severity,
+		"ecosystem":          alert.Ecosystem,
+		"package":            alert.Package,
+		"vulnerableRange":    alert.VulnerableRange,
+		"patchedVersion":     alert.PatchedVersion,
+		"state":              alert.State,
+		"open":               alert.Open,
+		"url":                alert.URL,
+		"createdAt":          alert.CreatedAt,
+		"updatedAt":          alert.UpdatedAt,
+		"fixedAt":            alert.FixedAt,
+		"dismissedAt":        alert.DismissedAt,
+	}}
+
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		r.logger.Error("Failed to save security alert: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *SecurityAlertRepositoryMongo) List(ctx context.Context, filter repository.SecurityAlertFilter) ([]*entity.SecurityAlert, error) {
+	findFilter := bson.M{}
+
+	if filter.RepositoryID != 0 {
+		findFilter["repositoryID"] = filter.RepositoryID
+	}
+
+	if filter.Source != "" {
+		findFilter["source"] = filter.Source
+	}
+
+	if filter.Severity != "" {
+		findFilter["severity"] = filter.Severity
+	}
+
+	if filter.State != "" {
+		findFilter["state"] = filter.State
+	}
+
+	// Настройка пагинации
+	findOptions := options.Find()
+	if filter.Limit > 0 {
+		findOptions.SetLimit(int64(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		findOptions.SetSkip(int64(filter.Offset))
+	}
+
+	// Сортировка по времени создания (сначала новые)
+	findOptions.SetSort(bson.M{"createdAt": -1})
+
+	cursor, err := r.collection.Find(ctx, findFilter, findOptions)
+	if err != nil {
+		r.logger.Error("Failed to list security alerts: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var alerts []*entity.SecurityAlert
+	if err := cursor.All(ctx, &alerts); err != nil {
+		r.logger.Error("Failed to decode security alerts: %v", err)
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+func (r *SecurityAlertRepositoryMongo) CountOpenByRepository(ctx context.Context) (map[int64]int, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"open": true}}},
+		{{Key: "$group", Value: bson.M{"_id": "$repositoryID", "count": bson.M{"$sum": 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("Failed to count open security alerts: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		RepositoryID int64 `bson:"_id"`
+		Count        int   `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		r.logger.Error("Failed to decode open security alert counts: %v", err)
+		return nil, err
+	}
+
+	counts := make(map[int64]int, len(rows))
+	for _, row := range rows {
+		counts[row.RepositoryID] = row.Count
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,1120 @@
+package grpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/application/service"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	pb "github.com/Dhoini/GitHub_Parser/internal/infrastructure/api/proto"
+	githubClient "github.com/Dhoini/GitHub_Parser/internal/infrastructure/github"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/github/fakegithub"
+	"github.com/Dhoini/GitHub_Parser/internal/infrastructure/persistence/memory"
+	grpcHandler "github.com/Dhoini/GitHub_Parser/internal/interfaces/grpc"
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// callbackSecret signs the job callbacks of the test server
+const callbackSecret = "test-secret"
+
+// startServer runs the gRPC server in-process against the fake GitHub API with in-memory storage
+func startServer(t *testing.T, fake *fakegithub.Server) pb.GithubParserServiceClient {
+	t.Helper()
+	return startServerWithQueue(t, fake, 2, 0)
+}
+
+// startServerWithQueue is startServer with the given number of job workers and queue length limit
+func startServerWithQueue(t *testing.T, fake *fakegithub.Server, workers, queueSize int) pb.GithubParserServiceClient {
+	t.Helper()
+
+	api := httptest.NewServer(fake)
+	t.Cleanup(api.Close)
+
+	log := logger.New(logger.FATAL)
+	client := githubClient.NewGithubClient("test-token", nil, log, githubClient.WithBaseURL(api.URL))
+
+	repoRepo := memory.NewRepositoryRepository()
+	issueRepo := memory.NewIssueRepository()
+	prRepo := memory.NewPullRequestRepository()
+	userRepo := memory.NewUserRepository()
+	depRepo := memory.NewDependencyRepository()
+	alertRepo := memory.NewSecurityAlertRepository()
+
+	parserService := service.NewParserService(
+		service.NewGithubService(client.GetClient(), log),
+		repoRepo,
+		issueRepo,
+		prRepo,
+		userRepo,
+		memory.NewCICheckRepository(),
+		memory.NewRepositoryFileRepository(),
+		memory.NewCodeOwnerRepository(),
+		depRepo,
+		alertRepo,
+		memory.NewJobRepository(),
+		service.NewJobQueue(workers, queueSize, nil, log),
+		0,
+		entity.RetryPolicy{},
+		service.JobLeaseConfig{},
+		service.CallbackConfig{Secret: callbackSecret, Retry: entity.RetryPolicy{MaxAttempts: 3, Backoff: 10 * time.Millisecond}},
+		nil,
+		nil,
+		log,
+	)
+	parserService.StartWorkers(t.Context())
+
+	scheduler := service.NewScheduler(memory.NewScheduleRepository(), parserService, 20*time.Millisecond, log)
+	scheduler.Start(t.Context())
+
+	server := grpc.NewServer()
+	pb.RegisterGithubParserServiceServer(server, grpcHandler.NewHandler(
+		parserService, scheduler, repoRepo, issueRepo, prRepo, userRepo, depRepo, alertRepo, log,
+	))
+
+	lis := bufconn.Listen(1 << 20)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewGithubParserServiceClient(conn)
+}
+
+// waitForJob polls a parsing job until it leaves the pending, in-progress and retrying states
+func waitForJob(t *testing.T, client pb.GithubParserServiceClient, jobID string) *pb.GetParsingJobStatusResponse {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := client.GetParsingJobStatus(context.Background(), &pb.GetParsingJobStatusRequest{JobId: jobID})
+		if err != nil {
+			t.Fatalf("GetParsingJobStatus: %v", err)
+		}
+		if resp.Status != "pending" && resp.Status != "in_progress" && resp.Status != "retrying" {
+			return resp
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("job %s did not finish", jobID)
+	return nil
+}
+
+func TestParsingJobEndToEnd(t *testing.T) {
+	client := startServer(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	started, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{
+		OwnerName:         "octo",
+		RepoName:          "demo",
+		ParseIssues:       true,
+		ParsePullRequests: true,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJob(t, client, started.JobId)
+	if job.Status != "completed" || job.Progress != 100 {
+		t.Fatalf("job finished as %s (%d%%): %s", job.Status, job.Progress, job.ErrorMessage)
+	}
+	if job.Results.Issues != 45 || job.Results.PullRequests != 12 || job.Attempts != 1 || job.FinishedAt == "" {
+		t.Errorf("job results = %v, attempts %d, finished at %q", job.Results, job.Attempts, job.FinishedAt)
+	}
+	if len(job.Checkpoints) != 2 || job.Checkpoints[0].Step != "issues" || job.Checkpoints[0].Items != 45 || job.Checkpoints[0].NextPage != 0 {
+		t.Errorf("checkpoints = %v", job.Checkpoints)
+	}
+
+	report, err := client.GetParsingJobReport(ctx, &pb.GetParsingJobReportRequest{JobId: started.JobId})
+	if err != nil {
+		t.Fatalf("GetParsingJobReport: %v", err)
+	}
+	if len(report.Steps) != 3 || report.Items != 58 || report.Usage.Saved != 58 || report.Usage.ApiCalls == 0 || report.DurationMs < 0 {
+		t.Fatalf("report = %v", report)
+	}
+	for _, step := range report.Steps[1:] {
+		if step.Total != step.Items || step.Usage.Saved != step.Items || step.Usage.Failed != 0 || step.Usage.ApiCalls == 0 {
+			t.Errorf("step %s fetched %d of %d items: %v", step.Step, step.Items, step.Total, step.Usage)
+		}
+	}
+
+	if _, err := client.GetParsingJobReport(ctx, &pb.GetParsingJobReportRequest{JobId: "missing"}); status.Code(err) != codes.NotFound {
+		t.Errorf("report of a missing job: %v, want NotFound", err)
+	}
+
+	repos, err :=client.ListRepositories(ctx, &pb.ListRepositoriesRequest{OwnerLogin: "octo"})
+	if err != nil {
+		t.Fatalf("ListRepositories: %v", err)
+	}
+	if len(repos.Repositories) != 1 || repos.Repositories[0].FullName != "octo/demo" || repos.Repositories[0].OpenIssuesCount != 36 {
+		t.Fatalf("repositories = %v", repos.Repositories)
+	}
+
+	issues, err := client.ListIssues(ctx, &pb.ListIssuesRequest{RepositoryId: 1001, Limit: 100})
+	if err != nil {
+		t.Fatalf("ListIssues: %v", err)
+	}
+	if issues.TotalCount != 45 {
+		t.Errorf("got %d issues, want 45", issues.TotalCount)
+	}
+
+	prs, err := client.ListPullRequests(ctx, &pb.ListPullRequestsRequest{RepositoryId: 1001, Limit: 100})
+	if err != nil {
+		t.Fatalf("ListPullRequests: %v", err)
+	}
+	if prs.TotalCount != 12 {
+		t.Errorf("got %d pull requests, want 12", prs.TotalCount)
+	}
+}
+
+func TestParsingJobFailsOnUpstreamErrors(t *testing.T) {
+	fake := fakegithub.New(nil)
+	client := startServer(t, fake)
+	ctx := context.Background()
+
+	fake.InjectFault(fakegithub.Fault{Kind: fakegithub.FaultServerError, PathPrefix: "/repos/octo/demo/issues"})
+
+	started, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJob(t, client, started.JobId)
+	if job.Status != "failed" || job.ErrorMessage == "" {
+		t.Fatalf("job finished as %s: %q", job.Status, job.ErrorMessage)
+	}
+}
+
+func TestParsingJobRetriesTransientErrors(t *testing.T) {
+	fake := fakegithub.New(nil)
+	client := startServer(t, fake)
+	ctx := context.Background()
+
+	fake.InjectFault(fakegithub.Fault{Kind: fakegithub.FaultServerError, PathPrefix: "/repos/octo/demo/issues", Count: 1})
+
+	started, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		Retry:       &pb.RetryPolicy{MaxAttempts: 3},
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJob(t, client, started.JobId)
+	if job.Status != "completed" || job.Results.Issues != 45 {
+		t.Fatalf("job finished as %s with %d issues: %s", job.Status, job.Results.Issues, job.ErrorMessage)
+	}
+	if job.Attempts != 2 || job.FailedAttempts != 1 || len(job.AttemptHistory) != 2 {
+		t.Fatalf("attempts %d, failed %d, history %v", job.Attempts, job.FailedAttempts, job.AttemptHistory)
+	}
+	if first := job.AttemptHistory[0]; first.Status != "retrying" || first.Step != "issues" || first.Error == "" {
+		t.Errorf("first attempt = %v", first)
+	}
+	if last := job.AttemptHistory[1]; last.Status != "completed" || last.FinishedAt == "" {
+		t.Errorf("last attempt = %v", last)
+	}
+}
+
+func TestParsingJobDeadLetterAndManualRetry(t *testing.T) {
+	fake := fakegithub.New(nil)
+	client := startServer(t, fake)
+	ctx := context.Background()
+
+	fake.InjectFault(fakegithub.Fault{Kind: fakegithub.FaultServerError, PathPrefix: "/repos/octo/demo/issues", Count: 2})
+
+	started, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		Retry:       &pb.RetryPolicy{MaxAttempts: 2},
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJob(t, client, started.JobId)
+	if job.Status != "dead_letter" || job.FailedAttempts != 2 || len(job.AttemptHistory) != 2 || job.FinishedAt == "" {
+		t.Fatalf("job finished as %s after %d failed attempts, history %v", job.Status, job.FailedAttempts, job.AttemptHistory)
+	}
+
+	retried, err := client.RetryParsingJob(ctx, &pb.RetryParsingJobRequest{JobId: started.JobId})
+	if err != nil {
+		t.Fatalf("RetryParsingJob: %v", err)
+	}
+	if retried.FailedAttempts != 0 {
+		t.Errorf("failed attempts after retry = %d, want 0", retried.FailedAttempts)
+	}
+
+	job = waitForJob(t, client, started.JobId)
+	if job.Status != "completed" || job.Results.Issues != 45 || job.Attempts != 3 {
+		t.Fatalf("retried job finished as %s with %d issues after %d attempts: %s", job.Status, job.Results.Issues, job.Attempts, job.ErrorMessage)
+	}
+
+	_, err = client.RetryParsingJob(ctx, &pb.RetryParsingJobRequest{JobId: started.JobId})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("retrying a completed job: err = %v, want FailedPrecondition", err)
+	}
+
+	_, err = client.RetryParsingJob(ctx, &pb.RetryParsingJobRequest{JobId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("retrying a missing job: err = %v, want NotFound", err)
+	}
+}
+
+func TestParsingJobDoesNotRetryPermanentErrors(t *testing.T) {
+	fake := fakegithub.New(nil)
+	client := startServer(t, fake)
+	ctx := context.Background()
+
+	fake.InjectFault(fakegithub.Fault{Kind: fakegithub.FaultNotFound, PathPrefix: "/repos/octo/demo/issues"})
+
+	started, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		Retry:       &pb.RetryPolicy{MaxAttempts: 3},
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJob(t, client, started.JobId)
+	if job.Status != "failed" || job.Attempts != 1 || job.FailedAttempts != 0 {
+		t.Fatalf("job finished as %s after %d attempts (%d failed): %s", job.Status, job.Attempts, job.FailedAttempts, job.ErrorMessage)
+	}
+}
+
+func TestParseRepositoryNotFound(t *testing.T) {
+	client := startServer(t, fakegithub.New(nil))
+
+	_, err := client.ParseRepository(context.Background(), &pb.ParseRepositoryRequest{Owner: "octo", Name: "missing"})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("err = %v, want Internal", err)
+	}
+}
+
+func TestParseIssuesAndPullRequestsStream(t *testing.T) {
+	client := startServer(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	// The unary call only reports the count and what was stored
+	parsed, err := client.ParseIssues(ctx, &pb.ParseIssuesRequest{Owner: "octo", Repo: "demo"})
+	if err != nil {
+		t.Fatalf("ParseIssues: %v", err)
+	}
+	if parsed.Count != 45 || parsed.Result.GetCreated() != 45 || parsed.Result.GetFailed() != 0 {
+		t.Errorf("parsed %d issues: %v, want 45 created", parsed.Count, parsed.Result)
+	}
+	parsed, err = client.ParseIssues(ctx, &pb.ParseIssuesRequest{Owner: "octo", Repo: "demo", SavePolicy: "fail_fast"})
+	if err != nil || parsed.Result.GetUnchanged() != 45 || parsed.Result.GetCreated() != 0 {
+		t.Errorf("parsed again: %v, %v, want 45 unchanged", parsed, err)
+	}
+	if _, err := client.ParseIssues(ctx, &pb.ParseIssuesRequest{Owner: "octo", Repo: "demo", SavePolicy: "sometimes"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("unknown save policy: got %v, want InvalidArgument", err)
+	}
+
+	stream, err := client.ParsePullRequestsStream(ctx, &pb.ParsePullRequestsRequest{Owner: "octo", Repo: "demo"})
+	if err != nil {
+		t.Fatalf("ParsePullRequestsStream: %v", err)
+	}
+	var prs []*pb.PullRequest
+	var count int32
+	for {
+		page, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv: %v", err)
+		}
+		prs = append(prs, page.PullRequests...)
+		count = page.Count
+		if page.Result.GetCreated() != int32(len(page.PullRequests)) {
+			t.Errorf("page of %d pull requests: %v", len(page.PullRequests), page.Result)
+		}
+	}
+	if len(prs) != 12 || count != 12 || prs[0].RepositoryId != 1001 || prs[0].Ci == nil {
+		t.Errorf("streamed %d pull requests, count %d: %v", len(prs), count, prs)
+	}
+
+	listed, err := client.ListPullRequests(ctx, &pb.ListPullRequestsRequest{RepositoryId: 1001, Limit: 100})
+	if err != nil || len(listed.PullRequests) != 12 {
+		t.Errorf("stored pull requests = %v, %v", listed, err)
+	}
+
+	// A bad request fails the stream
+	issues, err := client.ParseIssuesStream(ctx, &pb.ParseIssuesRequest{Owner: "octo"})
+	if err == nil {
+		_, err = issues.Recv()
+	}
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("stream without repo: got %v, want InvalidArgument", err)
+	}
+}
+
+func TestStartParsingJobQueueFull(t *testing.T) {
+	fake := fakegithub.New(nil)
+	fake.SetLatency(200 * time.Millisecond)
+	client := startServerWithQueue(t, fake, 1, 1)
+
+	// One job runs and one waits, so the third submission at the latest is rejected
+	for i := 0; i < 3; i++ {
+		_, err := client.StartParsingJob(context.Background(), &pb.StartParsingJobRequest{OwnerName: "octo", RepoName: "demo"})
+		if status.Code(err) == codes.ResourceExhausted {
+			if i == 0 {
+				t.Fatal("first job was rejected")
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("StartParsingJob: %v", err)
+		}
+	}
+
+	t.Fatal("queue accepted three jobs")
+}
+
+func TestStartParsingJobIdempotencyAndDedupe(t *testing.T) {
+	fake := fakegithub.New(nil)
+	fake.SetLatency(100 * time.Millisecond)
+	client := startServer(t, fake)
+	ctx := context.Background()
+
+	req := &pb.StartParsingJobRequest{OwnerName: "octo", RepoName: "demo", ParseIssues: true, IdempotencyKey: "submit-1"}
+	first, err := client.StartParsingJob(ctx, req)
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+	if !uuidPattern.MatchString(first.JobId) {
+		t.Errorf("job ID %q is not a UUID", first.JobId)
+	}
+
+	// A retried submission gets the same job
+	again, err := client.StartParsingJob(ctx, req)
+	if err != nil || again.JobId != first.JobId {
+		t.Fatalf("repeated StartParsingJob = %v, %v, want job %s", again, err, first.JobId)
+	}
+
+	// The key cannot be reused for other work
+	_, err = client.StartParsingJob(ctx, &pb.StartParsingJobRequest{OwnerName: "octo", RepoName: "demo", ParsePullRequests: true, IdempotencyKey: "submit-1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("reused key err = %v, want InvalidArgument", err)
+	}
+
+	// Dedupe rejects the same work while the first job is unfinished, but not other work
+	_, err = client.StartParsingJob(ctx, &pb.StartParsingJobRequest{OwnerName: "octo", RepoName: "demo", ParseIssues: true, Dedupe: true})
+	if status.Code(err) != codes.AlreadyExists || !strings.Contains(err.Error(), first.JobId) {
+		t.Errorf("duplicate err = %v, want AlreadyExists naming job %s", err, first.JobId)
+	}
+	// GitHub names ignore case, so does dedupe
+	_, err = client.StartParsingJob(ctx, &pb.StartParsingJobRequest{OwnerName: "Octo", RepoName: "Demo", ParseIssues: true, Dedupe: true})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("duplicate in other case err = %v, want AlreadyExists", err)
+	}
+	other, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{OwnerName: "octo", RepoName: "demo", ParsePullRequests: true, Dedupe: true})
+	if err != nil {
+		t.Fatalf("StartParsingJob with other options: %v", err)
+	}
+
+	waitForJob(t, client, first.JobId)
+	waitForJob(t, client, other.JobId)
+
+	// Without an unfinished job the same work is accepted again
+	if _, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{OwnerName: "octo", RepoName: "demo", ParseIssues: true, Dedupe: true}); err != nil {
+		t.Errorf("StartParsingJob after the first job finished: %v", err)
+	}
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// waitForStep polls a running parsing job until it has completed the given step
+func waitForStep(t *testing.T, client pb.GithubParserServiceClient, jobID, step string) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := client.GetParsingJobStatus(context.Background(), &pb.GetParsingJobStatusRequest{JobId: jobID})
+		if err != nil {
+			t.Fatalf("GetParsingJobStatus: %v", err)
+		}
+		if slices.Contains(resp.CompletedSteps, step) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("job %s did not complete step %s", jobID, step)
+}
+
+func TestCancelRunningParsingJob(t *testing.T) {
+	fake := fakegithub.New(nil)
+	fake.SetLatency(300 * time.Millisecond)
+	client := startServer(t, fake)
+	ctx := context.Background()
+
+	started, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{
+		OwnerName:         "octo",
+		RepoName:          "demo",
+		ParseIssues:       true,
+		ParsePullRequests: true,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+	waitForStep(t, client, started.JobId, "repository")
+
+	begin := time.Now()
+	job, err := client.CancelParsingJob(ctx, &pb.CancelParsingJobRequest{JobId: started.JobId})
+	if err != nil {
+		t.Fatalf("CancelParsingJob: %v", err)
+	}
+	if elapsed := time.Since(begin); elapsed > 2*time.Second {
+		t.Errorf("cancel took %s", elapsed)
+	}
+
+	if job.Status != "cancelled" || job.FinishedAt == "" {
+		t.Errorf("job = %s finished at %q, want cancelled", job.Status, job.FinishedAt)
+	}
+	if !slices.Equal(job.CompletedSteps, []string{"repository"}) {
+		t.Errorf("completed steps = %v, want [repository]", job.CompletedSteps)
+	}
+	// Issues and pull requests run side by side, so both are interrupted
+	for _, step := range job.Steps[1:] {
+		if step.Status != "stopped" {
+			t.Errorf("interrupted step = %+v, want stopped", step)
+		}
+	}
+	if len(job.Steps) != 3 {
+		t.Errorf("steps = %v, want repository, issues and pull requests", job.Steps)
+	}
+
+	// A cancelled job cannot be resumed or cancelled again
+	if _, err := client.ResumeParsingJob(ctx, &pb.ResumeParsingJobRequest{JobId: started.JobId}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ResumeParsingJob on cancelled job: got %v, want FailedPrecondition", err)
+	}
+	if _, err := client.CancelParsingJob(ctx, &pb.CancelParsingJobRequest{JobId: started.JobId}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CancelParsingJob on cancelled job: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestPauseAndResumeParsingJob(t *testing.T) {
+	fake := fakegithub.New(nil)
+	fake.SetLatency(100 * time.Millisecond)
+	client := startServer(t, fake)
+	ctx := context.Background()
+
+	started, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{
+		OwnerName:         "octo",
+		RepoName:          "demo",
+		ParseIssues:       true,
+		ParsePullRequests: true,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+	waitForStep(t, client, started.JobId, "repository")
+
+	paused, err := client.PauseParsingJob(ctx, &pb.PauseParsingJobRequest{JobId: started.JobId})
+	if err != nil {
+		t.Fatalf("PauseParsingJob: %v", err)
+	}
+	if paused.Status != "paused" || paused.FinishedAt != "" {
+		t.Fatalf("job = %s finished at %q, want paused", paused.Status, paused.FinishedAt)
+	}
+
+	fake.SetLatency(0)
+	if _, err := client.ResumeParsingJob(ctx, &pb.ResumeParsingJobRequest{JobId: started.JobId}); err != nil {
+		t.Fatalf("ResumeParsingJob: %v", err)
+	}
+
+	job := waitForJob(t, client, started.JobId)
+	if job.Status != "completed" {
+		t.Fatalf("job status = %s (%s), want completed", job.Status, job.ErrorMessage)
+	}
+	if job.Attempts != 2 {
+		t.Errorf("attempts = %d, want 2", job.Attempts)
+	}
+	if job.Results.GetIssues() != 45 || job.Results.GetPullRequests() != 12 {
+		t.Errorf("results = %+v, want 45 issues and 12 pull requests", job.Results)
+	}
+	if !slices.Equal(job.CompletedSteps, []string{"repository", "issues", "pull_requests"}) {
+		t.Errorf("completed steps = %v", job.CompletedSteps)
+	}
+}
+
+func TestCancelQueuedParsingJob(t *testing.T) {
+	fake := fakegithub.New(nil)
+	fake.SetLatency(200 * time.Millisecond)
+	client := startServerWithQueue(t, fake, 1, 0)
+	ctx := context.Background()
+
+	var ids []string
+	for i := 0; i < 2; i++ {
+		started, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{OwnerName: "octo", RepoName: "demo", ParseIssues: true})
+		if err != nil {
+			t.Fatalf("StartParsingJob: %v", err)
+		}
+		ids = append(ids, started.JobId)
+	}
+
+	// The only worker is busy with the first job, so the second one is still waiting
+	job, err := client.CancelParsingJob(ctx, &pb.CancelParsingJobRequest{JobId: ids[1]})
+	if err != nil {
+		t.Fatalf("CancelParsingJob: %v", err)
+	}
+	if job.Status != "cancelled" || job.Attempts != 0 || len(job.CompletedSteps) != 0 {
+		t.Errorf("queued job after cancel = %+v", job)
+	}
+
+	if first := waitForJob(t, client, ids[0]); first.Status != "completed" {
+		t.Errorf("first job status = %s, want completed", first.Status)
+	}
+
+	if _, err := client.CancelParsingJob(ctx, &pb.CancelParsingJobRequest{JobId: "missing"}); status.Code(err) != codes.NotFound {
+		t.Errorf("CancelParsingJob on unknown job: got %v, want NotFound", err)
+	}
+}
+
+func TestListParsingJobs(t *testing.T) {
+	client := startServer(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	var ids []string
+	for _, req := range []*pb.StartParsingJobRequest{
+		{OwnerName: "octo", RepoName: "demo", ParseIssues: true},
+		{OwnerName: "octo", RepoName: "tools"},
+		{OwnerName: "octo", RepoName: "demo", JobType: "reconcile"},
+	} {
+		started, err := client.StartParsingJob(ctx, req)
+		if err != nil {
+			t.Fatalf("StartParsingJob: %v", err)
+		}
+		ids = append(ids, started.JobId)
+		waitForJob(t, client, started.JobId)
+	}
+
+	list := func(req *pb.ListParsingJobsRequest) []string {
+		t.Helper()
+		resp, err := client.ListParsingJobs(ctx, req)
+		if err != nil {
+			t.Fatalf("ListParsingJobs(%v): %v", req, err)
+		}
+		var got []string
+		for _, job := range resp.Jobs {
+			got = append(got, job.Id)
+		}
+		return got
+	}
+
+	for name, tc := range map[string]struct {
+		req  *pb.ListParsingJobsRequest
+		want []string
+	}{
+		"all, newest first": {&pb.ListParsingJobsRequest{}, []string{ids[2], ids[1], ids[0]}},
+		"by repository":     {&pb.ListParsingJobsRequest{OwnerName: "octo", RepoName: "demo"}, []string{ids[2], ids[0]}},
+		"parse jobs":        {&pb.ListParsingJobsRequest{JobType: "parse"}, []string{ids[1], ids[0]}},
+		"by status":         {&pb.ListParsingJobsRequest{Statuses: []string{"failed", "cancelled"}}, nil},
+		"paginated":         {&pb.ListParsingJobsRequest{Limit: 1, Offset: 1}, []string{ids[1]}},
+		"created before":    {&pb.ListParsingJobsRequest{CreatedBefore: "2000-01-01T00:00:00Z"}, nil},
+	} {
+		if got := list(tc.req); !slices.Equal(got, tc.want) {
+			t.Errorf("%s: got %v, want %v", name, got, tc.want)
+		}
+	}
+
+	resp, err := client.ListParsingJobs(ctx, &pb.ListParsingJobsRequest{JobType: "parse", RepoName: "demo"})
+	if err != nil || len(resp.Jobs) != 1 {
+		t.Fatalf("ListParsingJobs: %v, %d jobs", err, len(resp.GetJobs()))
+	}
+	job := resp.Jobs[0]
+	if !job.Params.ParseIssues || job.Params.OwnerName != "octo" {
+		t.Errorf("params = %+v", job.Params)
+	}
+	var steps []string
+	for _, step := range job.Steps {
+		steps = append(steps, step.Step)
+		if step.Status != "completed" || step.FinishedAt == "" {
+			t.Errorf("step %s = %+v, want completed", step.Step, step)
+		}
+		if step.Step == "issues" && step.Items != 45 {
+			t.Errorf("issues step fetched %d items, want 45", step.Items)
+		}
+	}
+	if !slices.Equal(steps, []string{"repository", "issues"}) {
+		t.Errorf("steps = %v, want [repository issues]", steps)
+	}
+
+	if _, err := client.ListParsingJobs(ctx, &pb.ListParsingJobsRequest{CreatedAfter: "yesterday"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("invalid created_after: got %v, want InvalidArgument", err)
+	}
+}
+
+// collectEvents reads a job event stream until the server closes it
+func collectEvents(t *testing.T, stream grpc.ServerStreamingClient[pb.JobEvent]) []*pb.JobEvent {
+	t.Helper()
+
+	var events []*pb.JobEvent
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return events
+		}
+		if err != nil {
+			t.Errorf("Recv: %v", err)
+			return events
+		}
+		events = append(events, event)
+	}
+}
+
+func TestWatchParsingJob(t *testing.T) {
+	fake := fakegithub.New(nil)
+	fake.SetLatency(50 * time.Millisecond)
+	client := startServer(t, fake)
+	ctx := context.Background()
+
+	started, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{
+		OwnerName:         "octo",
+		RepoName:          "demo",
+		ParseIssues:       true,
+		ParsePullRequests: true,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	// Two watchers follow the job at the same time
+	results := make(chan []*pb.JobEvent, 2)
+	for i := 0; i < 2; i++ {
+		stream, err := client.WatchParsingJob(ctx, &pb.WatchParsingJobRequest{JobId: started.JobId})
+		if err != nil {
+			t.Fatalf("WatchParsingJob: %v", err)
+		}
+		go func() { results <- collectEvents(t, stream) }()
+	}
+
+	for i := 0; i < 2; i++ {
+		events := <-results
+		if len(events) < 2 {
+			t.Fatalf("watcher %d got %d events", i, len(events))
+		}
+		if events[0].Type != "state" || events[0].Job.GetId() != started.JobId {
+			t.Errorf("watcher %d: first event = %+v, want job state", i, events[0])
+		}
+		if last := events[len(events)-1]; last.Type != "status" || last.Status != "completed" {
+			t.Errorf("watcher %d: last event = %+v, want completed status", i, last)
+		}
+
+		var finished []string
+		progress := int32(0)
+		for _, event := range events {
+			if event.Progress < progress {
+				t.Errorf("watcher %d: progress went back from %d to %d", i, progress, event.Progress)
+			}
+			progress = event.Progress
+			if event.Type == "step_finished" {
+				finished = append(finished, event.Step.Step)
+			}
+		}
+		if !slices.Equal(finished, []string{"repository", "issues", "pull_requests"}) {
+			t.Errorf("watcher %d: finished steps = %v", i, finished)
+		}
+	}
+
+	// A late watcher only gets the final state
+	stream, err := client.WatchParsingJob(ctx, &pb.WatchParsingJobRequest{JobId: started.JobId})
+	if err != nil {
+		t.Fatalf("WatchParsingJob: %v", err)
+	}
+	events := collectEvents(t, stream)
+	if len(events) != 1 || events[0].Type != "state" || events[0].Status != "completed" {
+		t.Errorf("late watcher events = %+v, want one completed state", events)
+	}
+
+	stream, err = client.WatchParsingJob(ctx, &pb.WatchParsingJobRequest{JobId: "missing"})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("WatchParsingJob on unknown job: got %v, want NotFound", err)
+	}
+}
+
+func TestSchedules(t *testing.T) {
+	client := startServer(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	created, err := client.CreateSchedule(ctx, &pb.CreateScheduleRequest{
+		Job:             &pb.StartParsingJobRequest{OwnerName: "octo", RepoName: "demo", ParseIssues: true},
+		IntervalSeconds: 1,
+	})
+	if err != nil {
+		t.Fatalf("CreateSchedule: %v", err)
+	}
+	if created.NextRunAt == "" || created.LastRunAt != "" {
+		t.Errorf("new schedule = %+v", created)
+	}
+
+	// The scheduler starts the first job about a second later
+	var jobID string
+	deadline := time.Now().Add(5 * time.Second)
+	for jobID == "" && time.Now().Before(deadline) {
+		resp, err := client.ListSchedules(ctx, &pb.ListSchedulesRequest{})
+		if err != nil {
+			t.Fatalf("ListSchedules: %v", err)
+		}
+		if len(resp.Schedules) != 1 {
+			t.Fatalf("got %d schedules, want 1", len(resp.Schedules))
+		}
+		jobID = resp.Schedules[0].LastJobId
+		time.Sleep(20 * time.Millisecond)
+	}
+	if jobID == "" {
+		t.Fatal("schedule did not start a job")
+	}
+
+	job := waitForJob(t, client, jobID)
+	if job.Status != "completed" || job.Params.ScheduleId != created.Id || !job.Params.ParseIssues {
+		t.Errorf("scheduled job = %s with params %+v", job.Status, job.Params)
+	}
+
+	if _, err := client.DeleteSchedule(ctx, &pb.DeleteScheduleRequest{Id: created.Id}); err != nil {
+		t.Fatalf("DeleteSchedule: %v", err)
+	}
+	if _, err := client.DeleteSchedule(ctx, &pb.DeleteScheduleRequest{Id: created.Id}); status.Code(err) != codes.NotFound {
+		t.Errorf("second DeleteSchedule: got %v, want NotFound", err)
+	}
+
+	_, err = client.CreateSchedule(ctx, &pb.CreateScheduleRequest{
+		Job:  &pb.StartParsingJobRequest{OwnerName: "octo", RepoName: "demo"},
+		Cron: "every hour",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateSchedule with a bad cron: got %v, want InvalidArgument", err)
+	}
+}
+
+func TestBatchParsingJob(t *testing.T) {
+	// One worker and a single queue slot, so the batch has to queue its children as earlier ones finish
+	client := startServerWithQueue(t, fakegithub.New(nil), 1, 1)
+	ctx := context.Background()
+
+	started, err := client.StartBatchParsingJob(ctx, &pb.StartBatchParsingJobRequest{
+		Targets:     []string{"octo/demo", "octo/missing"},
+		TargetsFile: []byte("# portfolio\nocto/tools, octo/demo\n\n"),
+		ParseIssues: true,
+	})
+	if err != nil {
+		t.Fatalf("StartBatchParsingJob: %v", err)
+	}
+	if len(started.ChildJobIds) != 3 {
+		t.Fatalf("child jobs = %v, want 3 without the duplicate", started.ChildJobIds)
+	}
+
+	job := waitForJob(t, client, started.JobId)
+	if job.Status != "failed" || job.ErrorMessage != "1 of 3 repositories failed" || job.Progress != 100 {
+		t.Fatalf("batch finished as %s (%d%%): %q", job.Status, job.Progress, job.ErrorMessage)
+	}
+	if b := job.Batch; b.Total != 3 || b.Completed != 2 || b.Failed != 1 || b.Running != 0 || b.JobType != "parse" {
+		t.Errorf("batch summary = %+v", b)
+	}
+
+	if len(job.Children) != 3 {
+		t.Fatalf("children = %v", job.Children)
+	}
+	for i, want := range []struct {
+		repo, status string
+		issues       int32
+	}{
+		{"demo", "completed", 45},
+		{"missing", "failed", 0},
+		{"tools", "completed", 0},
+	} {
+		child := job.Children[i]
+		if child.JobId != started.ChildJobIds[i] || child.RepoName != want.repo || child.Status != want.status {
+			t.Errorf("child %d = %+v, want %s %s", i, child, want.repo, want.status)
+		}
+		if want.issues > 0 && child.Results.Issues != want.issues {
+			t.Errorf("child %s fetched %d issues, want %d", want.repo, child.Results.Issues, want.issues)
+		}
+	}
+
+	listed, err := client.ListParsingJobs(ctx, &pb.ListParsingJobsRequest{ParentId: started.JobId})
+	if err != nil {
+		t.Fatalf("ListParsingJobs: %v", err)
+	}
+	if listed.TotalCount != 3 || listed.Jobs[0].Params.ParentId != started.JobId {
+		t.Errorf("listed %d children: %v", listed.TotalCount, listed.Jobs)
+	}
+
+	batches, err := client.ListParsingJobs(ctx, &pb.ListParsingJobsRequest{JobType: "batch"})
+	if err != nil {
+		t.Fatalf("ListParsingJobs: %v", err)
+	}
+	if batches.TotalCount != 1 || batches.Jobs[0].Batch.Failed != 1 {
+		t.Errorf("listed batches = %v", batches.Jobs)
+	}
+
+	if _, err := client.RetryParsingJob(ctx, &pb.RetryParsingJobRequest{JobId: started.JobId}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("RetryParsingJob on a batch: got %v, want FailedPrecondition", err)
+	}
+
+	for _, req := range []*pb.StartBatchParsingJobRequest{
+		{},
+		{Targets: []string{"octo"}},
+		{Targets: []string{"octo/demo/extra"}},
+		{Targets: []string{"octo/demo"}, JobType: "batch"},
+		{TargetsFile: []byte("# comments only\n")},
+		{TargetsFile: []byte("octo/demo\nnot-a-repo\n")},
+	} {
+		if _, err := client.StartBatchParsingJob(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("StartBatchParsingJob(%v): got %v, want InvalidArgument", req, err)
+		}
+	}
+}
+
+func TestBatchParsingJobFromTargetsFile(t *testing.T) {
+	client := startServer(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	started, err := client.StartBatchParsingJob(ctx, &pb.StartBatchParsingJobRequest{
+		TargetsFile: []byte("octo/tools # small one first\r\nocto/demo\n"),
+	})
+	if err != nil {
+		t.Fatalf("StartBatchParsingJob: %v", err)
+	}
+
+	job := waitForJob(t, client, started.JobId)
+	if job.Status != "completed" || len(job.Children) != 2 {
+		t.Fatalf("batch finished as %s with children %v", job.Status, job.Children)
+	}
+	if job.Children[0].RepoName != "tools" || job.Children[1].RepoName != "demo" {
+		t.Errorf("children = %v, want tools then demo", job.Children)
+	}
+}
+
+func TestCancelBatchParsingJob(t *testing.T) {
+	fake := fakegithub.New(nil)
+	fake.SetLatency(100 * time.Millisecond)
+	client := startServerWithQueue(t, fake, 1, 1)
+	ctx := context.Background()
+
+	started, err := client.StartBatchParsingJob(ctx, &pb.StartBatchParsingJobRequest{
+		Targets:     []string{"octo/demo", "octo/tools", "octo/other"},
+		ParseIssues: true,
+	})
+	if err != nil {
+		t.Fatalf("StartBatchParsingJob: %v", err)
+	}
+
+	job, err := client.CancelParsingJob(ctx, &pb.CancelParsingJobRequest{JobId: started.JobId})
+	if err != nil {
+		t.Fatalf("CancelParsingJob: %v", err)
+	}
+	if job.Status != "cancelled" || job.Batch.Cancelled != 3 || job.Batch.Running != 0 {
+		t.Fatalf("batch after cancel = %s, summary %+v", job.Status, job.Batch)
+	}
+	for _, child := range job.Children {
+		if child.Status != "cancelled" {
+			t.Errorf("child %s/%s is %s, want cancelled", child.OwnerName, child.RepoName, child.Status)
+		}
+	}
+
+	if _, err := client.CancelParsingJob(ctx, &pb.CancelParsingJobRequest{JobId: started.JobId}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("cancelling a cancelled batch: got %v, want FailedPrecondition", err)
+	}
+	if _, err := client.PauseParsingJob(ctx, &pb.PauseParsingJobRequest{JobId: started.JobId}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("pausing a batch: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestParsingJobCallback(t *testing.T) {
+	type callback struct {
+		header http.Header
+		body   []byte
+	}
+	received := make(chan callback, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- callback{header: r.Header, body: body}
+	}))
+	t.Cleanup(receiver.Close)
+
+	client := startServer(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	if _, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		CallbackUrl: "not a url",
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("invalid callback_url: got %v, want InvalidArgument", err)
+	}
+
+	started, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{
+		OwnerName:         "octo",
+		RepoName:          "demo",
+		ParsePullRequests: true,
+		CallbackUrl:       receiver.URL,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	var got callback
+	select {
+	case got = <-received:
+	case <-time.After(10 * time.Second):
+		t.Fatal("no callback received")
+	}
+
+	signature := service.SignCallback(callbackSecret, got.header.Get(service.CallbackTimestampHeader), got.body)
+	if got.header.Get(service.CallbackSignatureHeader) != signature || got.header.Get(service.CallbackEventHeader) != "job.completed" {
+		t.Errorf("callback headers = %v", got.header)
+	}
+
+	var payload struct {
+		JobID  string `json:"job_id"`
+		Status string `json:"status"`
+		Report struct {
+			Items int `json:"items"`
+		} `json:"report"`
+	}
+	if err := json.Unmarshal(got.body, &payload); err != nil {
+		t.Fatalf("decode callback: %v", err)
+	}
+	if payload.JobID != started.JobId || payload.Status != "completed" || payload.Report.Items != 13 {
+		t.Errorf("callback payload = %+v", payload)
+	}
+
+	// The delivery is recorded with the job once the receiver answered
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		job, err := client.GetParsingJobStatus(ctx, &pb.GetParsingJobStatusRequest{JobId: started.JobId})
+		if err != nil {
+			t.Fatalf("GetParsingJobStatus: %v", err)
+		}
+		if len(job.Callbacks) == 1 && job.Callbacks[0].Status == "delivered" {
+			if job.Params.CallbackUrl != receiver.URL || len(job.Callbacks[0].Attempts) != 1 || job.Callbacks[0].Attempts[0].StatusCode != http.StatusOK {
+				t.Errorf("callbacks = %v, params %v", job.Callbacks, job.Params)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("callback not recorded as delivered: %v", job.Callbacks)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestParsingJobDryRun(t *testing.T) {
+	client := startServer(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	resp, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{
+		OwnerName:         "octo",
+		RepoName:          "demo",
+		ParseIssues:       true,
+		ParsePullRequests: true,
+		DryRun:            true,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	estimate := resp.Estimate
+	if resp.JobId != "" || estimate == nil || len(estimate.Steps) != 3 {
+		t.Fatalf("dry run = %v", resp)
+	}
+	if estimate.Steps[1].Items != 45 || estimate.Steps[2].Items != 12 || estimate.Requests != 27 ||
+		estimate.RateLimit != 5000 || estimate.RateLimitRemaining == 0 || estimate.EstimateRequests != 4 {
+		t.Errorf("estimate = %v", estimate)
+	}
+
+	jobs, err := client.ListParsingJobs(ctx, &pb.ListParsingJobsRequest{})
+	if err != nil {
+		t.Fatalf("ListParsingJobs: %v", err)
+	}
+	repos, err := client.ListRepositories(ctx, &pb.ListRepositoriesRequest{OwnerLogin: "octo"})
+	if err != nil {
+		t.Fatalf("ListRepositories: %v", err)
+	}
+	if jobs.TotalCount != 0 || len(repos.Repositories) != 0 {
+		t.Errorf("dry run stored %d jobs and %d repositories", jobs.TotalCount, len(repos.Repositories))
+	}
+
+	if _, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{OwnerName: "octo", RepoName: "missing", DryRun: true}); status.Code(err) != codes.NotFound {
+		t.Errorf("dry run of a missing repository: got %v, want NotFound", err)
+	}
+}
+
+func TestParsingJobOptions(t *testing.T) {
+	client := startServer(t, fakegithub.New(nil))
+	ctx := context.Background()
+
+	options := &pb.JobOptions{
+		Since:          "2024-02-10T00:00:00Z",
+		State:          "open",
+		MaxItems:       3,
+		TimeoutSeconds: 60,
+		PerPage:        2,
+	}
+	started, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{
+		OwnerName:   "octo",
+		RepoName:    "demo",
+		ParseIssues: true,
+		Options:     options,
+	})
+	if err != nil {
+		t.Fatalf("StartParsingJob: %v", err)
+	}
+
+	job := waitForJob(t, client, started.JobId)
+	if job.Status != "completed" || job.Results.Issues != 3 {
+		t.Fatalf("job finished as %s with %d issues: %s", job.Status, job.Results.Issues, job.ErrorMessage)
+	}
+	if got := job.Params.Options; got.Since != options.Since || got.State != "open" || got.MaxItems != 3 || got.TimeoutSeconds != 60 || got.PerPage != 2 {
+		t.Errorf("job options = %v", got)
+	}
+
+	for _, options := range []*pb.JobOptions{
+		{Since: "yesterday"},
+		{Since: "2024-02-10T00:00:00Z", Until: "2024-02-01T00:00:00Z"},
+		{State: "merged"},
+		{PerPage: 500},
+	} {
+		_, err := client.StartParsingJob(ctx, &pb.StartParsingJobRequest{OwnerName: "octo", RepoName: "demo", Options: options})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("options %v: got %v, want InvalidArgument", options, err)
+		}
+	}
+}
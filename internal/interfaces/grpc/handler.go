@@ -2,8 +2,13 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"strings"
 	"time"
+	"unicode"
 
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
 	"github.com/Dhoini/GitHub_Parser/internal/domain/repository"
 	"github.com/Dhoini/GitHub_Parser/internal/domain/service"
 	pb "github.com/Dhoini/GitHub_Parser/internal/infrastructure/api/proto"
@@ -16,29 +21,38 @@ import (
 type Handler struct {
 	pb.UnimplementedGithubParserServiceServer
 	parserService service.ParserService
+	scheduler     service.SchedulerService
 	repoRepo      repository.RepositoryRepository
 	issueRepo     repository.IssueRepository
 	prRepo        repository.PullRequestRepository
 	userRepo      repository.UserRepository
+	depRepo       repository.DependencyRepository
+	alertRepo     repository.SecurityAlertRepository
 	logger        *logger.Logger
 }
 
 // NewHandler creates a new gRPC handler
 func NewHandler(
 	parserService service.ParserService,
+	scheduler service.SchedulerService,
 	repoRepo repository.RepositoryRepository,
 	issueRepo repository.IssueRepository,
 	prRepo repository.PullRequestRepository,
 	userRepo repository.UserRepository,
+	depRepo repository.DependencyRepository,
+	alertRepo repository.SecurityAlertRepository,
 	logger *logger.Logger,
 ) *Handler {
 	return &Handler{
 		UnimplementedGithubParserServiceServer: pb.UnimplementedGithubParserServiceServer{},
 		parserService:                          parserService,
+		scheduler:                              scheduler,
 		repoRepo:                               repoRepo,
 		issueRepo:                              issueRepo,
 		prRepo:                                 prRepo,
 		userRepo:                               userRepo,
+		depRepo:                                depRepo,
+		alertRepo:                              alertRepo,
 		logger:                                 logger,
 	}
 }
@@ -56,32 +70,49 @@ func (h *Handler) ParseRepository(ctx context.Context, req *pb.ParseRepositoryRe
 	}
 
 	return &pb.ParseRepositoryResponse{
-		Repository: &pb.Repository{
-			Id:              repo.ID,
-			Name:            repo.Name,
-			FullName:        repo.FullName,
-			Description:     repo.Description,
-			IsPrivate:       repo.IsPrivate,
-			OwnerLogin:      repo.OwnerLogin,
-			Language:        repo.Language,
-			StarsCount:      int32(repo.StarsCount),
-			ForksCount:      int32(repo.ForksCount),
-			OpenIssuesCount: int32(repo.OpenIssuesCount),
-			CreatedAt:       repo.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:       repo.UpdatedAt.Format(time.RFC3339),
-		},
+		Repository: toPBRepository(repo),
 	}, nil
 }
 
+// toPBRepository converts a repository to protobuf format
+func toPBRepository(repo *entity.Repository) *pb.Repository {
+	pbRepo := &pb.Repository{
+		Id:              repo.ID,
+		Name:            repo.Name,
+		FullName:        repo.FullName,
+		Description:     repo.Description,
+		IsPrivate:       repo.IsPrivate,
+		OwnerLogin:      repo.OwnerLogin,
+		Language:        repo.Language,
+		StarsCount:      int32(repo.StarsCount),
+		ForksCount:      int32(repo.ForksCount),
+		OpenIssuesCount: int32(repo.OpenIssuesCount),
+		CreatedAt:       repo.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       repo.UpdatedAt.Format(time.RFC3339),
+		DeletionReason:  repo.DeletionReason,
+	}
+
+	for _, rename := range repo.Renames {
+		pbRepo.PreviousFullNames = append(pbRepo.PreviousFullNames, rename.FromFullName)
+	}
+
+	if repo.DeletedAt != nil {
+		pbRepo.DeletedAt = repo.DeletedAt.Format(time.RFC3339)
+	}
+
+	return pbRepo
+}
+
 // ListRepositories returns a list of repositories
 func (h *Handler) ListRepositories(ctx context.Context, req *pb.ListRepositoriesRequest) (*pb.ListRepositoriesResponse, error) {
 	// Create a repository filter based on the request
 	filter := repository.RepositoryFilter{
-		OwnerLogin: req.OwnerLogin,
-		Language:   req.Language,
-		MinStars:   int(req.MinStars),
-		Limit:      int(req.Limit),
-		Offset:     int(req.Offset),
+		OwnerLogin:     req.OwnerLogin,
+		Language:       req.Language,
+		MinStars:       int(req.MinStars),
+		IncludeDeleted: req.IncludeDeleted,
+		Limit:          int(req.Limit),
+		Offset:         int(req.Offset),
 	}
 
 	// Apply defaults if not specified
@@ -99,21 +130,7 @@ func (h *Handler) ListRepositories(ctx context.Context, req *pb.ListRepositories
 	// Convert to protobuf format
 	var pbRepos []*pb.Repository
 	for _, repo := range repos {
-		pbRepo := &pb.Repository{
-			Id:              repo.ID,
-			Name:            repo.Name,
-			FullName:        repo.FullName,
-			Description:     repo.Description,
-			IsPrivate:       repo.IsPrivate,
-			OwnerLogin:      repo.OwnerLogin,
-			Language:        repo.Language,
-			StarsCount:      int32(repo.StarsCount),
-			ForksCount:      int32(repo.ForksCount),
-			OpenIssuesCount: int32(repo.OpenIssuesCount),
-			CreatedAt:       repo.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:       repo.UpdatedAt.Format(time.RFC3339),
-		}
-		pbRepos = append(pbRepos, pbRepo)
+		pbRepos = append(pbRepos, toPBRepository(repo))
 	}
 
 	// We'll just return the count of returned items
@@ -125,51 +142,72 @@ func (h *Handler) ListRepositories(ctx context.Context, req *pb.ListRepositories
 	}, nil
 }
 
-// ParseIssues parses issues of a repository
+// ParseIssues parses issues of a repository and returns what was stored
 func (h *Handler) ParseIssues(ctx context.Context, req *pb.ParseIssuesRequest) (*pb.ParseIssuesResponse, error) {
 	if req.Owner == "" || req.Repo == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "owner and repo are required")
 	}
 
-	issues, err := h.parserService.ParseIssues(ctx, req.Owner, req.Repo)
+	result, err := h.parserService.ParseIssues(ctx, req.Owner, req.Repo, req.SavePolicy, nil)
 	if err != nil {
-		h.logger.Error("Failed to parse issues: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to parse issues: %v", err)
+		return nil, h.parseError("parse issues", err)
 	}
 
-	var pbIssues []*pb.Issue
-	for _, issue := range issues {
-		pbIssue := &pb.Issue{
-			Id:           issue.ID,
-			Number:       int32(issue.Number),
-			Title:        issue.Title,
-			Body:         issue.Body,
-			State:        issue.State,
-			AuthorLogin:  issue.AuthorLogin,
-			RepositoryId: issue.RepositoryID,
-			CreatedAt:    issue.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:    issue.UpdatedAt.Format(time.RFC3339),
-		}
+	return &pb.ParseIssuesResponse{
+		Count:  int32(result.Fetched()),
+		Result: toPBSaveResult(*result),
+	}, nil
+}
 
-		if issue.ClosedAt != nil {
-			pbIssue.ClosedAt = issue.ClosedAt.Format(time.RFC3339)
+// ParseIssuesStream parses issues of a repository and sends every page once it is stored
+func (h *Handler) ParseIssuesStream(req *pb.ParseIssuesRequest, stream pb.GithubParserService_ParseIssuesStreamServer) error {
+	if req.Owner == "" || req.Repo == "" {
+		return status.Errorf(codes.InvalidArgument, "owner and repo are required")
+	}
+
+	count := 0
+	_, err := h.parserService.ParseIssues(stream.Context(), req.Owner, req.Repo, req.SavePolicy, func(issues []*entity.Issue, result entity.SaveResult) error {
+		count += len(issues)
+		page := &pb.IssuePage{Count: int32(count), Result: toPBSaveResult(result)}
+		for _, issue := range issues {
+			page.Issues = append(page.Issues, toPBIssue(issue))
 		}
+		return stream.Send(page)
+	})
+	if err != nil {
+		return h.parseError("parse issues", err)
+	}
+
+	return nil
+}
 
-		pbIssues = append(pbIssues, pbIssue)
+// parseError converts an error of parsing issues or pull requests to a gRPC status;
+// errors of sending a page are statuses already
+func (h *Handler) parseError(action string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, service.ErrInvalidJobOptions) {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	return &pb.ParseIssuesResponse{
-		Issues: pbIssues,
-	}, nil
+	h.logger.Error("Failed to %s: %v", action, err)
+	var saveErr *service.ItemSaveError
+	if errors.As(err, &saveErr) {
+		// The fail-fast policy stopped at an item that could not be stored
+		return status.Errorf(codes.Aborted, "failed to %s: %v", action, err)
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
 }
 
 // ListIssues returns a list of issues
 func (h *Handler) ListIssues(ctx context.Context, req *pb.ListIssuesRequest) (*pb.ListIssuesResponse, error) {
 	filter := repository.IssueFilter{
-		RepositoryID: req.RepositoryId,
-		State:        req.State,
-		Limit:        int(req.Limit),
-		Offset:       int(req.Offset),
+		RepositoryID:   req.RepositoryId,
+		State:          req.State,
+		IncludeDeleted: req.IncludeDeleted,
+		Limit:          int(req.Limit),
+		Offset:         int(req.Offset),
 	}
 
 	// Apply defaults if not specified
@@ -187,23 +225,7 @@ func (h *Handler) ListIssues(ctx context.Context, req *pb.ListIssuesRequest) (*p
 	// Convert to protobuf format
 	var pbIssues []*pb.Issue
 	for _, issue := range issues {
-		pbIssue := &pb.Issue{
-			Id:           issue.ID,
-			Number:       int32(issue.Number),
-			Title:        issue.Title,
-			Body:         issue.Body,
-			State:        issue.State,
-			AuthorLogin:  issue.AuthorLogin,
-			RepositoryId: issue.RepositoryID,
-			CreatedAt:    issue.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:    issue.UpdatedAt.Format(time.RFC3339),
-		}
-
-		if issue.ClosedAt != nil {
-			pbIssue.ClosedAt = issue.ClosedAt.Format(time.RFC3339)
-		}
-
-		pbIssues = append(pbIssues, pbIssue)
+		pbIssues = append(pbIssues, toPBIssue(issue))
 	}
 
 	return &pb.ListIssuesResponse{
@@ -212,55 +234,54 @@ func (h *Handler) ListIssues(ctx context.Context, req *pb.ListIssuesRequest) (*p
 	}, nil
 }
 
-// ParsePullRequests parses pull requests of a repository
+// ParsePullRequests parses pull requests of a repository and returns what was stored
 func (h *Handler) ParsePullRequests(ctx context.Context, req *pb.ParsePullRequestsRequest) (*pb.ParsePullRequestsResponse, error) {
 	if req.Owner == "" || req.Repo == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "owner and repo are required")
 	}
 
-	prs, err := h.parserService.ParsePullRequests(ctx, req.Owner, req.Repo)
+	result, err := h.parserService.ParsePullRequests(ctx, req.Owner, req.Repo, req.SavePolicy, nil)
 	if err != nil {
-		h.logger.Error("Failed to parse pull requests: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to parse pull requests: %v", err)
+		return nil, h.parseError("parse pull requests", err)
 	}
 
-	var pbPRs []*pb.PullRequest
-	for _, pr := range prs {
-		pbPR := &pb.PullRequest{
-			Id:           pr.ID,
-			Number:       int32(pr.Number),
-			Title:        pr.Title,
-			Body:         pr.Body,
-			State:        pr.State,
-			AuthorLogin:  pr.AuthorLogin,
-			RepositoryId: pr.RepositoryID,
-			CreatedAt:    pr.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:    pr.UpdatedAt.Format(time.RFC3339),
-		}
+	return &pb.ParsePullRequestsResponse{
+		Count:  int32(result.Fetched()),
+		Result: toPBSaveResult(*result),
+	}, nil
+}
 
-		if pr.MergedAt != nil {
-			pbPR.MergedAt = pr.MergedAt.Format(time.RFC3339)
-		}
+// ParsePullRequestsStream parses pull requests of a repository and sends every page once it is stored
+func (h *Handler) ParsePullRequestsStream(req *pb.ParsePullRequestsRequest, stream pb.GithubParserService_ParsePullRequestsStreamServer) error {
+	if req.Owner == "" || req.Repo == "" {
+		return status.Errorf(codes.InvalidArgument, "owner and repo are required")
+	}
 
-		if pr.ClosedAt != nil {
-			pbPR.ClosedAt = pr.ClosedAt.Format(time.RFC3339)
+	count := 0
+	_, err := h.parserService.ParsePullRequests(stream.Context(), req.Owner, req.Repo, req.SavePolicy, func(prs []*entity.PullRequest, result entity.SaveResult) error {
+		count += len(prs)
+		page := &pb.PullRequestPage{Count: int32(count), Result: toPBSaveResult(result)}
+		for _, pr := range prs {
+			page.PullRequests = append(page.PullRequests, toPBPullRequest(pr))
 		}
-
-		pbPRs = append(pbPRs, pbPR)
+		return stream.Send(page)
+	})
+	if err != nil {
+		return h.parseError("parse pull requests", err)
 	}
 
-	return &pb.ParsePullRequestsResponse{
-		PullRequests: pbPRs,
-	}, nil
+	return nil
 }
 
 // ListPullRequests returns a list of pull requests
 func (h *Handler) ListPullRequests(ctx context.Context, req *pb.ListPullRequestsRequest) (*pb.ListPullRequestsResponse, error) {
 	filter := repository.PullRequestFilter{
-		RepositoryID: req.RepositoryId,
-		State:        req.State,
-		Limit:        int(req.Limit),
-		Offset:       int(req.Offset),
+		RepositoryID:   req.RepositoryId,
+		State:          req.State,
+		CIState:        req.CiState,
+		IncludeDeleted: req.IncludeDeleted,
+		Limit:          int(req.Limit),
+		Offset:         int(req.Offset),
 	}
 
 	// Apply defaults if not specified
@@ -278,27 +299,7 @@ func (h *Handler) ListPullRequests(ctx context.Context, req *pb.ListPullRequests
 	// Convert to protobuf format
 	var pbPRs []*pb.PullRequest
 	for _, pr := range prs {
-		pbPR := &pb.PullRequest{
-			Id:           pr.ID,
-			Number:       int32(pr.Number),
-			Title:        pr.Title,
-			Body:         pr.Body,
-			State:        pr.State,
-			AuthorLogin:  pr.AuthorLogin,
-			RepositoryId: pr.RepositoryID,
-			CreatedAt:    pr.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:    pr.UpdatedAt.Format(time.RFC3339),
-		}
-
-		if pr.MergedAt != nil {
-			pbPR.MergedAt = pr.MergedAt.Format(time.RFC3339)
-		}
-
-		if pr.ClosedAt != nil {
-			pbPR.ClosedAt = pr.ClosedAt.Format(time.RFC3339)
-		}
-
-		pbPRs = append(pbPRs, pbPR)
+		pbPRs = append(pbPRs, toPBPullRequest(pr))
 	}
 
 	return &pb.ListPullRequestsResponse{
@@ -307,6 +308,73 @@ func (h *Handler) ListPullRequests(ctx context.Context, req *pb.ListPullRequests
 	}, nil
 }
 
+// toPBIssue converts an issue to protobuf format
+func toPBIssue(issue *entity.Issue) *pb.Issue {
+	pbIssue := &pb.Issue{
+		Id:           issue.ID,
+		Number:       int32(issue.Number),
+		Title:        issue.Title,
+		Body:         issue.Body,
+		State:        issue.State,
+		AuthorLogin:  issue.AuthorLogin,
+		RepositoryId: issue.RepositoryID,
+		CreatedAt:    issue.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    issue.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if issue.ClosedAt != nil {
+		pbIssue.ClosedAt = issue.ClosedAt.Format(time.RFC3339)
+	}
+
+	if issue.DeletedAt != nil {
+		pbIssue.DeletedAt = issue.DeletedAt.Format(time.RFC3339)
+		pbIssue.TransferredTo = issue.TransferredTo
+	}
+
+	return pbIssue
+}
+
+// toPBPullRequest converts a pull request to protobuf format
+func toPBPullRequest(pr *entity.PullRequest) *pb.PullRequest {
+	pbPR := &pb.PullRequest{
+		Id:           pr.ID,
+		Number:       int32(pr.Number),
+		Title:        pr.Title,
+		Body:         pr.Body,
+		State:        pr.State,
+		AuthorLogin:  pr.AuthorLogin,
+		RepositoryId: pr.RepositoryID,
+		HeadSha:      pr.HeadSHA,
+		Ci:           toPBCISummary(pr.CI),
+		CreatedAt:    pr.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    pr.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if pr.MergedAt != nil {
+		pbPR.MergedAt = pr.MergedAt.Format(time.RFC3339)
+	}
+
+	if pr.ClosedAt != nil {
+		pbPR.ClosedAt = pr.ClosedAt.Format(time.RFC3339)
+	}
+
+	if pr.DeletedAt != nil {
+		pbPR.DeletedAt = pr.DeletedAt.Format(time.RFC3339)
+	}
+
+	return pbPR
+}
+
+// toPBCISummary converts a CI summary to protobuf format
+func toPBCISummary(ci entity.CISummary) *pb.CISummary {
+	return &pb.CISummary{
+		State:   ci.State,
+		Passed:  int32(ci.Passed),
+		Failed:  int32(ci.Failed),
+		Pending: int32(ci.Pending),
+	}
+}
+
 // ParseUser parses a GitHub user
 func (h *Handler) ParseUser(ctx context.Context, req *pb.ParseUserRequest) (*pb.ParseUserResponse, error) {
 	if req.Username == "" {
@@ -379,24 +447,154 @@ func (h *Handler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.
 	}, nil
 }
 
+// ListDependencies returns tracked repositories depending on a package
+func (h *Handler) ListDependencies(ctx context.Context, req *pb.ListDependenciesRequest) (*pb.ListDependenciesResponse, error) {
+	filter := repository.DependencyFilter{
+		Name:         req.Name,
+		Version:      req.Version,
+		Ecosystem:    req.Ecosystem,
+		RepositoryID: req.RepositoryId,
+		Limit:        int(req.Limit),
+		Offset:       int(req.Offset),
+	}
+
+	// Apply defaults if not specified
+	if filter.Limit <= 0 {
+		filter.Limit = 50 // Default limit
+	}
+
+	// Get dependencies from MongoDB
+	deps, err := h.depRepo.List(ctx, filter)
+	if err != nil {
+		h.logger.Error("Failed to list dependencies: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list dependencies: %v", err)
+	}
+
+	// Convert to protobuf format
+	var pbDeps []*pb.Dependency
+	for _, dep := range deps {
+		pbDeps = append(pbDeps, &pb.Dependency{
+			RepositoryId:       dep.RepositoryID,
+			RepositoryFullName: dep.RepositoryFullName,
+			Manifest:           dep.Manifest,
+			Ecosystem:          dep.Ecosystem,
+			Name:               dep.Name,
+			Version:            dep.Version,
+			Scope:              dep.Scope,
+		})
+	}
+
+	return &pb.ListDependenciesResponse{
+		Dependencies: pbDeps,
+		TotalCount:   int32(len(pbDeps)),
+	}, nil
+}
+
+// ListSecurityAlerts returns stored security alerts along with open alert counts per repository
+func (h *Handler) ListSecurityAlerts(ctx context.Context, req *pb.ListSecurityAlertsRequest) (*pb.ListSecurityAlertsResponse, error) {
+	filter := repository.SecurityAlertFilter{
+		RepositoryID: req.RepositoryId,
+		Source:       req.Source,
+		Severity:     req.Severity,
+		State:        req.State,
+		Limit:        int(req.Limit),
+		Offset:       int(req.Offset),
+	}
+
+	// Apply defaults if not specified
+	if filter.Limit <= 0 {
+		filter.Limit = 50 // Default limit
+	}
+
+	// Get alerts from MongoDB
+	alerts, err := h.alertRepo.List(ctx, filter)
+	if err != nil {
+		h.logger.Error("Failed to list security alerts: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list security alerts: %v", err)
+	}
+
+	openCounts, err := h.alertRepo.CountOpenByRepository(ctx)
+	if err != nil {
+		h.logger.Error("Failed to count open security alerts: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to count open security alerts: %v", err)
+	}
+
+	// Convert to protobuf format
+	var pbAlerts []*pb.SecurityAlert
+	for _, alert := range alerts {
+		pbAlert := &pb.SecurityAlert{
+			RepositoryId:       alert.RepositoryID,
+			RepositoryFullName: alert.RepositoryFullName,
+			Source:             alert.Source,
+			Number:             int32(alert.Number),
+			GhsaId:             alert.GHSAID,
+			CveId:              alert.CVEID,
+			Summary:            alert.Summary,
+			Severity:           alert.Severity,
+			Ecosystem:          alert.Ecosystem,
+			Package:            alert.Package,
+			VulnerableRange:    alert.VulnerableRange,
+			PatchedVersion:     alert.PatchedVersion,
+			State:              alert.State,
+			Url:                alert.URL,
+			CreatedAt:          alert.CreatedAt.Format(time.RFC3339),
+		}
+
+		if alert.FixedAt != nil {
+			pbAlert.FixedAt = alert.FixedAt.Format(time.RFC3339)
+		}
+
+		if alert.DismissedAt != nil {
+			pbAlert.DismissedAt = alert.DismissedAt.Format(time.RFC3339)
+		}
+
+		pbAlerts = append(pbAlerts, pbAlert)
+	}
+
+	var pbCounts []*pb.RepositoryAlertCount
+	for repoID, count := range openCounts {
+		if req.RepositoryId != 0 && repoID != req.RepositoryId {
+			continue
+		}
+		pbCounts = append(pbCounts, &pb.RepositoryAlertCount{
+			RepositoryId: repoID,
+			OpenCount:    int32(count),
+		})
+	}
+
+	// Most exposed repositories first
+	sort.Slice(pbCounts, func(i, j int) bool {
+		if pbCounts[i].OpenCount != pbCounts[j].OpenCount {
+			return pbCounts[i].OpenCount > pbCounts[j].OpenCount
+		}
+		return pbCounts[i].RepositoryId < pbCounts[j].RepositoryId
+	})
+
+	return &pb.ListSecurityAlertsResponse{
+		Alerts:          pbAlerts,
+		TotalCount:      int32(len(pbAlerts)),
+		OpenAlertCounts: pbCounts,
+	}, nil
+}
+
 // StartParsingJob starts an asynchronous parsing job
 func (h *Handler) StartParsingJob(ctx context.Context, req *pb.StartParsingJobRequest) (*pb.StartParsingJobResponse, error) {
-	if req.OwnerName == "" || req.RepoName == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "owner_name and repo_name are required")
+	params, err := toJobParams(req)
+	if err != nil {
+		return nil, err
 	}
 
-	params := service.ParsingJobParams{
-		OwnerName:   req.OwnerName,
-		RepoName:    req.RepoName,
-		ParseIssues: req.ParseIssues,
-		ParsePRs:    req.ParsePullRequests,
-		ParseUsers:  req.ParseUsers,
+	if req.DryRun {
+		estimate, err := h.parserService.EstimateParsingJob(ctx, params)
+		if err != nil {
+			return nil, h.jobError("estimate parsing job", err)
+		}
+		return &pb.StartParsingJobResponse{Estimate: toPBEstimate(estimate)}, nil
 	}
 
 	jobID, err := h.parserService.StartParsingJob(ctx, params)
 	if err != nil {
-		h.logger.Error("Failed to start parsing job: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to start parsing job: %v", err)
+		return nil, h.jobError("start parsing job", err)
 	}
 
 	return &pb.StartParsingJobResponse{
@@ -404,6 +602,138 @@ func (h *Handler) StartParsingJob(ctx context.Context, req *pb.StartParsingJobRe
 	}, nil
 }
 
+// toJobParams validates a job request and converts it to job parameters
+func toJobParams(req *pb.StartParsingJobRequest) (service.ParsingJobParams, error) {
+	if req.OwnerName == "" || req.RepoName == "" {
+		return service.ParsingJobParams{}, status.Errorf(codes.InvalidArgument, "owner_name and repo_name are required")
+	}
+
+	if req.JobType != "" && req.JobType != service.JobTypeParse && req.JobType != service.JobTypeReconcile {
+		return service.ParsingJobParams{}, status.Errorf(codes.InvalidArgument, "unknown job_type: %s", req.JobType)
+	}
+
+	retry, err := toRetryPolicy(req.Retry)
+	if err != nil {
+		return service.ParsingJobParams{}, err
+	}
+
+	params := service.ParsingJobParams{
+		JobType:             req.JobType,
+		OwnerName:           req.OwnerName,
+		RepoName:            req.RepoName,
+		ParseIssues:         req.ParseIssues,
+		ParsePRs:            req.ParsePullRequests,
+		ParseUsers:          req.ParseUsers,
+		ParseContents:       req.ParseContents,
+		ParseSecurityAlerts: req.ParseSecurityAlerts,
+		Priority:            int(req.Priority),
+		Retry:               retry,
+		IdempotencyKey:      req.IdempotencyKey,
+		Dedupe:              req.Dedupe,
+		CallbackURL:         req.CallbackUrl,
+	}
+	if err := applyJobOptions(&params, req.Options); err != nil {
+		return service.ParsingJobParams{}, err
+	}
+
+	return params, nil
+}
+
+// applyJobOptions copies requested job options into params; the service checks their values
+func applyJobOptions(params *service.ParsingJobParams, options *pb.JobOptions) error {
+	if options == nil {
+		return nil
+	}
+
+	var err error
+	if options.Since != "" {
+		if params.Since, err = time.Parse(time.RFC3339, options.Since); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid options.since: %v", err)
+		}
+	}
+	if options.Until != "" {
+		if params.Until, err = time.Parse(time.RFC3339, options.Until); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid options.until: %v", err)
+		}
+	}
+
+	params.State = options.State
+	params.MaxItems = int(options.MaxItems)
+	params.Timeout = time.Duration(options.TimeoutSeconds) * time.Second
+	params.PerPage = int(options.PerPage)
+	params.SavePolicy = options.SavePolicy
+	return nil
+}
+
+// toRetryPolicy validates a requested retry policy; without one the server default applies
+func toRetryPolicy(retry *pb.RetryPolicy) (entity.RetryPolicy, error) {
+	if retry == nil {
+		return entity.RetryPolicy{}, nil
+	}
+
+	if retry.MaxAttempts < 1 || retry.BackoffSeconds < 0 || retry.MaxBackoffSeconds < 0 {
+		return entity.RetryPolicy{}, status.Errorf(codes.InvalidArgument, "retry.max_attempts must be at least 1 and backoffs must not be negative")
+	}
+
+	return entity.RetryPolicy{
+		MaxAttempts: int(retry.MaxAttempts),
+		Backoff:     time.Duration(retry.BackoffSeconds) * time.Second,
+		MaxBackoff:  time.Duration(retry.MaxBackoffSeconds) * time.Second,
+	}, nil
+}
+
+// StartBatchParsingJob starts a batch job with one child job per listed repository
+func (h *Handler) StartBatchParsingJob(ctx context.Context, req *pb.StartBatchParsingJobRequest) (*pb.StartBatchParsingJobResponse, error) {
+	if req.JobType != "" && req.JobType != service.JobTypeParse && req.JobType != service.JobTypeReconcile {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown job_type: %s", req.JobType)
+	}
+
+	retry, err := toRetryPolicy(req.Retry)
+	if err != nil {
+		return nil, err
+	}
+
+	params := service.ParsingJobParams{
+		JobType:             req.JobType,
+		ParseIssues:         req.ParseIssues,
+		ParsePRs:            req.ParsePullRequests,
+		ParseUsers:          req.ParseUsers,
+		ParseContents:       req.ParseContents,
+		ParseSecurityAlerts: req.ParseSecurityAlerts,
+		Priority:            int(req.Priority),
+		Retry:               retry,
+	}
+	if err := applyJobOptions(&params, req.Options); err != nil {
+		return nil, err
+	}
+
+	targets := append(append([]string(nil), req.Targets...), parseTargetsFile(req.TargetsFile)...)
+	jobStatus, err := h.parserService.StartBatchParsingJob(ctx, params, targets)
+	if err != nil {
+		return nil, h.jobError("start batch parsing job", err)
+	}
+
+	return &pb.StartBatchParsingJobResponse{
+		JobId:       jobStatus.ID,
+		ChildJobIds: jobStatus.Batch.JobIDs,
+	}, nil
+}
+
+// parseTargetsFile splits an uploaded list of repositories separated by newlines, spaces or commas.
+// Everything after "#" on a line is a comment.
+func parseTargetsFile(data []byte) []string {
+	var targets []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		targets = append(targets, strings.FieldsFunc(line, func(r rune) bool {
+			return r == ',' || unicode.IsSpace(r)
+		})...)
+	}
+	return targets
+}
+
 // GetParsingJobStatus returns the status of an asynchronous parsing job
 func (h *Handler) GetParsingJobStatus(ctx context.Context, req *pb.GetParsingJobStatusRequest) (*pb.GetParsingJobStatusResponse, error) {
 	if req.JobId == "" {
@@ -412,16 +742,524 @@ func (h *Handler) GetParsingJobStatus(ctx context.Context, req *pb.GetParsingJob
 
 	jobStatus, err := h.parserService.GetParsingJobStatus(ctx, req.JobId)
 	if err != nil {
-		h.logger.Error("Failed to get parsing job status: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to get parsing job status: %v", err)
+		return nil, h.jobError("get parsing job status", err)
 	}
 
-	return &pb.GetParsingJobStatusResponse{
-		Id:           jobStatus.ID,
-		Status:       jobStatus.Status,
-		Progress:     int32(jobStatus.Progress),
-		ErrorMessage: jobStatus.ErrorMessage,
-		CreatedAt:    jobStatus.CreatedAt,
-		UpdatedAt:    jobStatus.UpdatedAt,
+	return toPBJobStatus(jobStatus), nil
+}
+
+// GetParsingJobReport returns what a job did per step: timing, item counts and API usage
+func (h *Handler) GetParsingJobReport(ctx context.Context, req *pb.GetParsingJobReportRequest) (*pb.ParsingJobReport, error) {
+	if req.JobId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "job_id is required")
+	}
+
+	report, err := h.parserService.GetParsingJobReport(ctx, req.JobId)
+	if err != nil {
+		return nil, h.jobError("get parsing job report", err)
+	}
+
+	return &pb.ParsingJobReport{
+		JobId:        report.JobID,
+		JobType:      report.JobType,
+		Status:       report.Status,
+		Progress:     int32(report.Progress),
+		ErrorMessage: report.ErrorMessage,
+		Attempts:     int32(report.Attempts),
+		StartedAt:    report.StartedAt,
+		FinishedAt:   report.FinishedAt,
+		DurationMs:   report.Duration.Milliseconds(),
+		Steps:        toPBJobSteps(report.Steps),
+		Items:        int32(report.Items),
+		Usage:        toPBStepUsage(report.Usage),
+	}, nil
+}
+
+// ListParsingJobs returns the job history matching the request filters, newest first
+func (h *Handler) ListParsingJobs(ctx context.Context, req *pb.ListParsingJobsRequest) (*pb.ListParsingJobsResponse, error) {
+	switch req.JobType {
+	case "", service.JobTypeParse, service.JobTypeReconcile, service.JobTypeBatch:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown job_type: %s", req.JobType)
+	}
+
+	filter := repository.JobFilter{
+		Statuses:  req.Statuses,
+		OwnerName: req.OwnerName,
+		RepoName:  req.RepoName,
+		ParentID:  req.ParentId,
+		JobType:   req.JobType,
+		Limit:     int(req.Limit),
+		Offset:    int(req.Offset),
+	}
+
+	var err error
+	if req.CreatedAfter != "" {
+		if filter.CreatedAfter, err = time.Parse(time.RFC3339, req.CreatedAfter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid created_after: %v", err)
+		}
+	}
+	if req.CreatedBefore != "" {
+		if filter.CreatedBefore, err = time.Parse(time.RFC3339, req.CreatedBefore); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid created_before: %v", err)
+		}
+	}
+
+	// Apply defaults if not specified
+	if filter.Limit <= 0 {
+		filter.Limit = 20 // Default limit
+	}
+
+	jobs, err := h.parserService.ListParsingJobs(ctx, filter)
+	if err != nil {
+		h.logger.Error("Failed to list parsing jobs: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list parsing jobs: %v", err)
+	}
+
+	var pbJobs []*pb.GetParsingJobStatusResponse
+	for _, job := range jobs {
+		pbJobs = append(pbJobs, toPBJobStatus(job))
+	}
+
+	return &pb.ListParsingJobsResponse{
+		Jobs:       pbJobs,
+		TotalCount: int32(len(pbJobs)),
 	}, nil
 }
+
+// WatchParsingJob streams the events of a parsing job until it finishes
+func (h *Handler) WatchParsingJob(req *pb.WatchParsingJobRequest, stream pb.GithubParserService_WatchParsingJobServer) error {
+	if req.JobId == "" {
+		return status.Errorf(codes.InvalidArgument, "job_id is required")
+	}
+
+	events, err := h.parserService.WatchParsingJob(stream.Context(), req.JobId)
+	if err != nil {
+		return h.jobError("watch parsing job", err)
+	}
+
+	for event := range events {
+		if err := stream.Send(toPBJobEvent(event)); err != nil {
+			return err
+		}
+	}
+
+	return stream.Context().Err()
+}
+
+// CancelParsingJob stops a queued, running or paused parsing job
+func (h *Handler) CancelParsingJob(ctx context.Context, req *pb.CancelParsingJobRequest) (*pb.GetParsingJobStatusResponse, error) {
+	if req.JobId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "job_id is required")
+	}
+
+	jobStatus, err := h.parserService.CancelParsingJob(ctx, req.JobId)
+	if err != nil {
+		return nil, h.jobError("cancel parsing job", err)
+	}
+
+	return toPBJobStatus(jobStatus), nil
+}
+
+// PauseParsingJob pauses a queued or running parsing job
+func (h *Handler) PauseParsingJob(ctx context.Context, req *pb.PauseParsingJobRequest) (*pb.GetParsingJobStatusResponse, error) {
+	if req.JobId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "job_id is required")
+	}
+
+	jobStatus, err := h.parserService.PauseParsingJob(ctx, req.JobId)
+	if err != nil {
+		return nil, h.jobError("pause parsing job", err)
+	}
+
+	return toPBJobStatus(jobStatus), nil
+}
+
+// ResumeParsingJob puts a paused parsing job back into the queue
+func (h *Handler) ResumeParsingJob(ctx context.Context, req *pb.ResumeParsingJobRequest) (*pb.GetParsingJobStatusResponse, error) {
+	if req.JobId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "job_id is required")
+	}
+
+	jobStatus, err := h.parserService.ResumeParsingJob(ctx, req.JobId)
+	if err != nil {
+		return nil, h.jobError("resume parsing job", err)
+	}
+
+	return toPBJobStatus(jobStatus), nil
+}
+
+// RetryParsingJob queues a failed, dead-lettered or interrupted job again
+func (h *Handler) RetryParsingJob(ctx context.Context, req *pb.RetryParsingJobRequest) (*pb.GetParsingJobStatusResponse, error) {
+	if req.JobId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "job_id is required")
+	}
+
+	jobStatus, err := h.parserService.RetryParsingJob(ctx, req.JobId)
+	if err != nil {
+		return nil, h.jobError("retry parsing job", err)
+	}
+
+	return toPBJobStatus(jobStatus), nil
+}
+
+// jobError maps parsing job errors to gRPC status codes
+func (h *Handler) jobError(action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrJobNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, service.ErrJobState):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, service.ErrJobQueueFull):
+		return status.Errorf(codes.ResourceExhausted, "%v", err)
+	case errors.Is(err, service.ErrInvalidBatch), errors.Is(err, service.ErrIdempotencyConflict),
+		errors.Is(err, service.ErrInvalidCallback), errors.Is(err, service.ErrInvalidJobOptions):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var duplicate *service.DuplicateJobError
+	if errors.As(err, &duplicate) {
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	}
+
+	var gone *service.RepositoryGoneError
+	if errors.As(err, &gone) {
+		return status.Errorf(codes.NotFound, "%v", err)
+	}
+
+	h.logger.Error("Failed to %s: %v", action, err)
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+// toPBJobOptions converts the options of a job to protobuf format; nil when none is set
+func toPBJobOptions(params service.ParsingJobParams) *pb.JobOptions {
+	if params.Since.IsZero() && params.Until.IsZero() && params.State == "" &&
+		params.MaxItems == 0 && params.Timeout == 0 && params.PerPage == 0 && params.SavePolicy == "" {
+		return nil
+	}
+
+	options := &pb.JobOptions{
+		State:          params.State,
+		MaxItems:       int32(params.MaxItems),
+		TimeoutSeconds: int32(params.Timeout / time.Second),
+		PerPage:        int32(params.PerPage),
+		SavePolicy:     params.SavePolicy,
+	}
+	if !params.Since.IsZero() {
+		options.Since = params.Since.Format(time.RFC3339)
+	}
+	if !params.Until.IsZero() {
+		options.Until = params.Until.Format(time.RFC3339)
+	}
+
+	return options
+}
+
+// toPBEstimate converts the estimate of a dry run to protobuf format
+func toPBEstimate(estimate *service.ParsingEstimate) *pb.ParsingEstimate {
+	pbEstimate := &pb.ParsingEstimate{
+		OwnerName:          estimate.OwnerName,
+		RepoName:           estimate.RepoName,
+		JobType:            estimate.JobType,
+		Requests:           int32(estimate.Requests),
+		RateLimit:          int32(estimate.RateLimit.Limit),
+		RateLimitRemaining: int32(estimate.RateLimit.Remaining),
+		RateLimitReset:     estimate.RateLimit.Reset.Format(time.RFC3339),
+		DurationMs:         estimate.Duration.Milliseconds(),
+		RateLimitWaitMs:    estimate.RateLimitWait.Milliseconds(),
+		EstimateRequests:   int32(estimate.EstimateRequests),
+	}
+
+	for _, step := range estimate.Steps {
+		pbEstimate.Steps = append(pbEstimate.Steps, &pb.StepEstimate{
+			Step:     step.Step,
+			Items:    int32(step.Items),
+			Pages:    int32(step.Pages),
+			Requests: int32(step.Requests),
+		})
+	}
+
+	return pbEstimate
+}
+
+// toPBJobStatus converts a parsing job status to protobuf format
+func toPBJobStatus(jobStatus *service.ParsingJobStatus) *pb.GetParsingJobStatusResponse {
+	return &pb.GetParsingJobStatusResponse{
+		Id:             jobStatus.ID,
+		Status:         jobStatus.Status,
+		Progress:       int32(jobStatus.Progress),
+		ErrorMessage:   jobStatus.ErrorMessage,
+		CreatedAt:      jobStatus.CreatedAt,
+		UpdatedAt:      jobStatus.UpdatedAt,
+		StartedAt:      jobStatus.StartedAt,
+		FinishedAt:     jobStatus.FinishedAt,
+		Attempts:       int32(jobStatus.Attempts),
+		Results:        toPBJobResults(jobStatus.Results),
+		Reconciliation: toPBReconciliation(jobStatus.Reconciliation),
+		CompletedSteps: jobStatus.CompletedSteps,
+		Params:         toPBJobParams(jobStatus.Params),
+		Steps:          toPBJobSteps(jobStatus.Steps),
+		Checkpoints:    toPBJobCheckpoints(jobStatus.Checkpoints),
+		NextAttemptAt:  jobStatus.NextAttemptAt,
+		AttemptHistory: toPBJobAttempts(jobStatus.AttemptHistory),
+		FailedAttempts: int32(jobStatus.FailedAttempts),
+		Batch:          toPBBatchSummary(jobStatus.Batch),
+		Children:       toPBBatchChildren(jobStatus.Children),
+		LeaseOwner:     jobStatus.LeaseOwner,
+		LeaseExpiresAt: jobStatus.LeaseExpiresAt,
+		Callbacks:      toPBCallbacks(jobStatus.Callbacks),
+	}
+}
+
+// toPBBatchSummary converts the child counts of a batch job to protobuf format
+func toPBBatchSummary(batch *entity.BatchSummary) *pb.BatchSummary {
+	if batch == nil {
+		return nil
+	}
+
+	return &pb.BatchSummary{
+		JobType:   batch.JobType,
+		Total:     int32(batch.Total),
+		Completed: int32(batch.Completed),
+		Failed:    int32(batch.Failed),
+		Cancelled: int32(batch.Cancelled),
+		Running:   int32(batch.Running),
+	}
+}
+
+// toPBBatchChildren converts the child job results of a batch job to protobuf format
+func toPBBatchChildren(children []service.BatchChild) []*pb.BatchChild {
+	var pbChildren []*pb.BatchChild
+	for _, child := range children {
+		pbChildren = append(pbChildren, &pb.BatchChild{
+			JobId:        child.JobID,
+			OwnerName:    child.OwnerName,
+			RepoName:     child.RepoName,
+			Status:       child.Status,
+			Progress:     int32(child.Progress),
+			ErrorMessage: child.ErrorMessage,
+			Results:      toPBJobResults(child.Results),
+		})
+	}
+
+	return pbChildren
+}
+
+// toPBJobEvent converts a job event to protobuf format
+func toPBJobEvent(event entity.JobEvent) *pb.JobEvent {
+	pbEvent := &pb.JobEvent{
+		Type:     event.Type,
+		JobId:    event.JobID,
+		Status:   event.Status,
+		Progress: int32(event.Progress),
+		Message:  event.Message,
+		At:       event.At.Format(time.RFC3339Nano),
+	}
+
+	if event.Step != nil {
+		pbEvent.Step = toPBJobSteps([]entity.JobStepResult{*event.Step})[0]
+	}
+
+	if event.Job != nil {
+		pbEvent.Job = toPBJobStatus(service.NewParsingJobStatus(event.Job))
+	}
+
+	return pbEvent
+}
+
+// toPBJobParams converts job parameters to protobuf format
+func toPBJobParams(params service.ParsingJobParams) *pb.ParsingJobParams {
+	return &pb.ParsingJobParams{
+		JobType:             params.JobType,
+		OwnerName:           params.OwnerName,
+		RepoName:            params.RepoName,
+		ParseIssues:         params.ParseIssues,
+		ParsePullRequests:   params.ParsePRs,
+		ParseUsers:          params.ParseUsers,
+		ParseContents:       params.ParseContents,
+		ParseSecurityAlerts: params.ParseSecurityAlerts,
+		Priority:            int32(params.Priority),
+		ScheduleId:          params.ScheduleID,
+		ParentId:            params.ParentID,
+		IdempotencyKey:      params.IdempotencyKey,
+		Dedupe:              params.Dedupe,
+		CallbackUrl:         params.CallbackURL,
+		Options:             toPBJobOptions(params),
+		Retry: &pb.RetryPolicy{
+			MaxAttempts:       int32(params.Retry.MaxAttempts),
+			BackoffSeconds:    int32(params.Retry.Backoff / time.Second),
+			MaxBackoffSeconds: int32(params.Retry.MaxBackoff / time.Second),
+		},
+	}
+}
+
+// toPBJobCheckpoints converts the page cursors of a job to protobuf format
+func toPBJobCheckpoints(checkpoints []entity.JobCheckpoint) []*pb.JobCheckpoint {
+	var pbCheckpoints []*pb.JobCheckpoint
+	for _, checkpoint := range checkpoints {
+		pbCheckpoints = append(pbCheckpoints, &pb.JobCheckpoint{
+			Step:      checkpoint.Step,
+			NextPage:  int32(checkpoint.NextPage),
+			Items:     int32(checkpoint.Items),
+			UpdatedAt: checkpoint.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return pbCheckpoints
+}
+
+// toPBJobAttempts converts the attempt history of a job to protobuf format
+func toPBJobAttempts(attempts []entity.JobAttempt) []*pb.JobAttempt {
+	var pbAttempts []*pb.JobAttempt
+	for _, attempt := range attempts {
+		pbAttempt := &pb.JobAttempt{
+			Number:    int32(attempt.Number),
+			StartedAt: attempt.StartedAt.Format(time.RFC3339),
+			Status:    attempt.Status,
+			Step:      attempt.Step,
+			Error:     attempt.Error,
+		}
+
+		if attempt.FinishedAt != nil {
+			pbAttempt.FinishedAt = attempt.FinishedAt.Format(time.RFC3339)
+		}
+
+		pbAttempts = append(pbAttempts, pbAttempt)
+	}
+
+	return pbAttempts
+}
+
+// toPBCallbacks converts callback deliveries to protobuf format
+func toPBCallbacks(deliveries []entity.CallbackDelivery) []*pb.CallbackDelivery {
+	var pbDeliveries []*pb.CallbackDelivery
+	for _, delivery := range deliveries {
+		pbDelivery := &pb.CallbackDelivery{
+			Id:        delivery.ID,
+			Event:     delivery.Event,
+			Url:       delivery.URL,
+			Status:    delivery.Status,
+			Error:     delivery.Error,
+			CreatedAt: delivery.CreatedAt.Format(time.RFC3339),
+		}
+
+		for _, attempt := range delivery.Attempts {
+			pbDelivery.Attempts = append(pbDelivery.Attempts, &pb.CallbackAttempt{
+				Number:     int32(attempt.Number),
+				At:         attempt.At.Format(time.RFC3339),
+				StatusCode: int32(attempt.StatusCode),
+				Error:      attempt.Error,
+				DurationMs: attempt.Duration.Milliseconds(),
+			})
+		}
+
+		pbDeliveries = append(pbDeliveries, pbDelivery)
+	}
+
+	return pbDeliveries
+}
+
+// toPBJobSteps converts per-step job results to protobuf format
+func toPBJobSteps(steps []entity.JobStepResult) []*pb.JobStepResult {
+	var pbSteps []*pb.JobStepResult
+	for _, step := range steps {
+		pbStep := &pb.JobStepResult{
+			Step:      step.Step,
+			Status:    step.Status,
+			Items:     int32(step.Items),
+			Error:     step.Error,
+			StartedAt: step.StartedAt.Format(time.RFC3339),
+			Total:     int32(step.Total),
+			Usage:     toPBStepUsage(step.StepUsage),
+		}
+
+		if step.FinishedAt != nil {
+			pbStep.FinishedAt = step.FinishedAt.Format(time.RFC3339)
+		}
+
+		pbSteps = append(pbSteps, pbStep)
+	}
+
+	return pbSteps
+}
+
+// toPBJobResults converts job result counts to protobuf format
+func toPBJobResults(results entity.JobResults) *pb.JobResults {
+	return &pb.JobResults{
+		Issues:         int32(results.Issues),
+		PullRequests:   int32(results.PullRequests),
+		Users:          int32(results.Users),
+		Files:          int32(results.Files),
+		SecurityAlerts: int32(results.SecurityAlerts),
+	}
+}
+
+// toPBReconciliation converts a reconciliation diff to protobuf format
+func toPBReconciliation(result *entity.ReconciliationResult) *pb.ReconciliationResult {
+	if result == nil {
+		return nil
+	}
+
+	pbResult := &pb.ReconciliationResult{
+		IssuesChecked:       int32(result.IssuesChecked),
+		PullRequestsChecked: int32(result.PullRequestsChecked),
+	}
+
+	for _, item := range result.Deleted {
+		pbResult.Deleted = append(pbResult.Deleted, toPBReconciledItem(item))
+	}
+
+	for _, item := range result.Transferred {
+		pbResult.Transferred = append(pbResult.Transferred, toPBReconciledItem(item))
+	}
+
+	return pbResult
+}
+
+func toPBReconciledItem(item entity.ReconciledItem) *pb.ReconciledItem {
+	return &pb.ReconciledItem{
+		Kind:          item.Kind,
+		Id:            item.ID,
+		Number:        int32(item.Number),
+		TransferredTo: item.TransferredTo,
+	}
+}
+
+// toPBStepUsage converts the counts of a step to protobuf format
+func toPBStepUsage(usage entity.StepUsage) *pb.StepUsage {
+	return &pb.StepUsage{
+		Saved:           int32(usage.Saved),
+		Failed:          int32(usage.Failed),
+		SampleErrors:    usage.SampleErrors,
+		ApiCalls:        int32(usage.APICalls),
+		RateLimitHits:   int32(usage.RateLimitHits),
+		RateLimitWaitMs: usage.RateLimitWait.Milliseconds(),
+		Created:         int32(usage.Created),
+		Updated:         int32(usage.Updated),
+		Unchanged:       int32(usage.Unchanged),
+		FailedItems:     toPBItemFailures(usage.FailedItems),
+	}
+}
+
+// toPBSaveResult converts what a parse call stored to protobuf format
+func toPBSaveResult(result entity.SaveResult) *pb.SaveResult {
+	return &pb.SaveResult{
+		Created:   int32(result.Created),
+		Updated:   int32(result.Updated),
+		Unchanged: int32(result.Unchanged),
+		Failed:    int32(result.Failed),
+		Failures:  toPBItemFailures(result.Failures),
+	}
+}
+
+func toPBItemFailures(failures []entity.ItemFailure) []*pb.ItemFailure {
+	var pbFailures []*pb.ItemFailure
+	for _, failure := range failures {
+		pbFailures = append(pbFailures, &pb.ItemFailure{
+			Id:     failure.ID,
+			Number: int32(failure.Number),
+			Reason: failure.Reason,
+		})
+	}
+	return pbFailures
+}
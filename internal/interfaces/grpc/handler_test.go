@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseTargetsFile(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		data string
+		want []string
+	}{
+		{
+			name: "lines, commas and comments",
+			data: "# portfolio\nocto/demo, octo/tools\n\n  octo/other # archived soon\r\nocto/cli\tocto/web,,\n",
+			want: []string{"octo/demo", "octo/tools", "octo/other", "octo/cli", "octo/web"},
+		},
+		{
+			// Malformed entries are kept, so the batch rejects them instead of silently skipping
+			name: "malformed entries",
+			data: "octo/demo\nnot-a-repo\nocto/demo/issues\n",
+			want: []string{"octo/demo", "not-a-repo", "octo/demo/issues"},
+		},
+		{
+			name: "only comments",
+			data: "# nothing yet\n\n",
+		},
+	} {
+		if got := parseTargetsFile([]byte(tc.data)); !slices.Equal(got, tc.want) {
+			t.Errorf("%s: parseTargetsFile = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/internal/domain/entity"
+	"github.com/Dhoini/GitHub_Parser/internal/domain/service"
+	pb "github.com/Dhoini/GitHub_Parser/internal/infrastructure/api/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateSchedule stores a recurring parsing job
+func (h *Handler) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (*pb.Schedule, error) {
+	if req.Job == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "job is required")
+	}
+
+	params, err := toJobParams(req.Job)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := h.scheduler.CreateSchedule(ctx, params, req.Cron, time.Duration(req.IntervalSeconds)*time.Second)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidSchedule) || errors.Is(err, service.ErrInvalidCallback) ||
+			errors.Is(err, service.ErrInvalidJobOptions) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		h.logger.Error("Failed to create schedule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create schedule: %v", err)
+	}
+
+	return toPBSchedule(schedule), nil
+}
+
+// ListSchedules returns all schedules, oldest first
+func (h *Handler) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (*pb.ListSchedulesResponse, error) {
+	schedules, err := h.scheduler.ListSchedules(ctx)
+	if err != nil {
+		h.logger.Error("Failed to list schedules: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list schedules: %v", err)
+	}
+
+	var pbSchedules []*pb.Schedule
+	for _, schedule := range schedules {
+		pbSchedules = append(pbSchedules, toPBSchedule(schedule))
+	}
+
+	return &pb.ListSchedulesResponse{
+		Schedules: pbSchedules,
+	}, nil
+}
+
+// DeleteSchedule removes a schedule; jobs it already started keep running
+func (h *Handler) DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) (*pb.DeleteScheduleResponse, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id is required")
+	}
+
+	if err := h.scheduler.DeleteSchedule(ctx, req.Id); err != nil {
+		if errors.Is(err, service.ErrScheduleNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+		h.logger.Error("Failed to delete schedule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete schedule: %v", err)
+	}
+
+	return &pb.DeleteScheduleResponse{}, nil
+}
+
+// toPBSchedule converts a schedule to protobuf format
+func toPBSchedule(schedule *entity.Schedule) *pb.Schedule {
+	pbSchedule := &pb.Schedule{
+		Id:              schedule.ID,
+		Params:          toPBJobParams(schedule.Params),
+		Cron:            schedule.Cron,
+		IntervalSeconds: int64(schedule.Interval / time.Second),
+		LastJobId:       schedule.LastJobID,
+		LastRunResult:   schedule.LastRunResult,
+		LastRunMessage:  schedule.LastRunMessage,
+		CreatedAt:       schedule.CreatedAt.Format(time.RFC3339),
+	}
+
+	if schedule.LastRunAt != nil {
+		pbSchedule.LastRunAt = schedule.LastRunAt.Format(time.RFC3339)
+	}
+
+	if !schedule.NextRunAt.IsZero() {
+		pbSchedule.NextRunAt = schedule.NextRunAt.Format(time.RFC3339)
+	}
+
+	return pbSchedule
+}
@@ -2,6 +2,8 @@ package RateLimiter
 
 import (
 	"context"
+	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -30,44 +32,43 @@ func NewRateLimit(logger *logger.Logger) *RateLimit {
 	}
 }
 
-// Wait waits if necessary to comply with API rate limits
+// Wait waits if necessary to comply with API rate limits.
+// The limit is shared by concurrent callers, so the lock is not held while waiting.
 func (r *RateLimit) Wait(ctx context.Context) error {
 	r.mu.Lock()
-	defer r.mu.Unlock()
+	for {
+		// Check if the reset period has passed
+		elapsed := time.Since(r.lastReset)
+		if elapsed >= r.resetPeriod {
+			r.logger.Debug("Rate limit period reset")
+			r.requestCount = 0
+			r.lastReset = time.Now()
+		}
 
-	// Check if the reset period has passed
-	elapsed := time.Since(r.lastReset)
-	if elapsed >= r.resetPeriod {
-		r.logger.Debug("Rate limit period reset")
-		r.requestCount = 0
-		r.lastReset = time.Now()
-		return nil
-	}
+		if r.requestCount < r.maxRequests {
+			break
+		}
 
-	// If we've reached the request limit
-	if r.requestCount >= r.maxRequests {
 		// Calculate time until reset
 		waitTime := r.resetPeriod - elapsed
 		r.logger.Warn("Rate limit reached, waiting for %v", waitTime)
-
-		// Create a timer for waiting
-		timer := time.NewTimer(waitTime)
-		defer timer.Stop()
+		r.mu.Unlock()
 
 		// Wait either for the rate limit to reset or the context to be canceled
+		timer := time.NewTimer(waitTime)
 		select {
 		case <-timer.C:
-			// Reset counter after waiting
-			r.requestCount = 0
-			r.lastReset = time.Now()
 		case <-ctx.Done():
+			timer.Stop()
 			return ctx.Err()
 		}
+		r.mu.Lock()
 	}
 
 	// Increment request counter
 	r.requestCount++
 	r.logger.Debug("API request count: %d/%d", r.requestCount, r.maxRequests)
+	r.mu.Unlock()
 
 	return nil
 }
@@ -110,3 +111,37 @@ func (r *RateLimit) UpdateLimits(remaining int, resetTime time.Time) {
 	r.logger.Debug("Updated rate limits: remaining=%d, reset=%v",
 		remaining, resetTime.Format(time.RFC3339))
 }
+
+// Transport wraps base, http.DefaultTransport when nil, so every request waits for the limit
+// and every response updates it from the X-RateLimit-Remaining and X-RateLimit-Reset headers.
+// All clients sharing r share one budget.
+func (r *RateLimit) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{limit: r, base: base}
+}
+
+type transport struct {
+	limit *RateLimit
+	base  http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limit.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, errRemaining := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	reset, errReset := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if errRemaining == nil && errReset == nil {
+		t.limit.UpdateLimits(remaining, time.Unix(reset, 0))
+	}
+
+	return resp, nil
+}
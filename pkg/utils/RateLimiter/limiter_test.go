@@ -0,0 +1,66 @@
+package RateLimiter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Dhoini/GitHub_Parser/pkg/utils/logger"
+)
+
+func TestTransportWaitsForReset(t *testing.T) {
+	// The server runs out of requests until reset
+	reset := time.Now().Add(time.Second).Truncate(time.Second).Add(time.Second)
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	}))
+	defer server.Close()
+
+	limit := NewRateLimit(logger.New(logger.FATAL))
+	client := &http.Client{Transport: limit.Transport(nil)}
+	get := func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	}
+
+	if err := get(context.Background()); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	if remaining := limit.GetRemainingRequests(); remaining != 0 {
+		t.Errorf("remaining = %d after the headers said 0", remaining)
+	}
+
+	// A caller that cannot wait for the reset gives up without a request
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := get(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("request before the reset: %v, want deadline exceeded", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("server got %d requests, want 1", calls.Load())
+	}
+
+	// Others wait for it
+	if err := get(context.Background()); err != nil {
+		t.Fatalf("request after the reset: %v", err)
+	}
+	if now := time.Now(); now.Before(reset) {
+		t.Errorf("request went out %s before the reset", reset.Sub(now))
+	}
+}
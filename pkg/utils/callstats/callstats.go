@@ -0,0 +1,73 @@
+package callstats
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+)
+
+// Stats counts the HTTP calls made on behalf of a context and the calls refused by a rate limit
+type Stats struct {
+	calls       atomic.Int64
+	rateLimited atomic.Int64
+}
+
+// Calls returns the number of calls that got a response or failed in transit
+func (s *Stats) Calls() int {
+	return int(s.calls.Load())
+}
+
+// RateLimited returns the number of calls answered with a rate limit error
+func (s *Stats) RateLimited() int {
+	return int(s.rateLimited.Load())
+}
+
+type statsKey struct{}
+
+// WithStats returns a context whose calls through Transport are counted in stats
+func WithStats(ctx context.Context, stats *Stats) context.Context {
+	return context.WithValue(ctx, statsKey{}, stats)
+}
+
+// FromContext returns the stats set by WithStats, nil when there are none
+func FromContext(ctx context.Context) *Stats {
+	stats, _ := ctx.Value(statsKey{}).(*Stats)
+	return stats
+}
+
+// Transport wraps base, http.DefaultTransport when nil, so it counts calls made with a context carrying stats
+func Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+
+	if stats := FromContext(req.Context()); stats != nil {
+		stats.calls.Add(1)
+		if err == nil && RateLimited(resp) {
+			stats.rateLimited.Add(1)
+		}
+	}
+
+	return resp, err
+}
+
+// RateLimited reports whether a response refuses the call because of a primary or secondary rate limit:
+// 429, or 403 with no requests remaining or a Retry-After header
+func RateLimited(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusForbidden:
+		return resp.Header.Get("X-RateLimit-Remaining") == "0" || resp.Header.Get("Retry-After") != ""
+	}
+	return false
+}
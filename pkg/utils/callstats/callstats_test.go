@@ -0,0 +1,50 @@
+package callstats
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/limited":
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusForbidden)
+		case "/forbidden":
+			w.Header().Set("X-RateLimit-Remaining", "42")
+			w.WriteHeader(http.StatusForbidden)
+		case "/slow-down":
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: Transport(nil)}
+	get := func(ctx context.Context, path string) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		resp.Body.Close()
+	}
+
+	var stats Stats
+	ctx := WithStats(context.Background(), &stats)
+	for _, path := range []string{"/ok", "/limited", "/forbidden", "/slow-down"} {
+		get(ctx, path)
+	}
+
+	// Calls without stats in the context are not counted anywhere
+	get(context.Background(), "/limited")
+
+	if stats.Calls() != 4 || stats.RateLimited() != 2 {
+		t.Errorf("counted %d calls, %d rate limited; want 4 and 2", stats.Calls(), stats.RateLimited())
+	}
+}
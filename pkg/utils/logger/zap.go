@@ -27,6 +27,6 @@ func CustomLogger() *Logger {
 
 // Write реализует io.Writer для интеграции с zap
 func (l *ZapToCustomAdapter) Write(p []byte) (n int, err error) {
-	l.customLogger.Info(string(p))
+	l.customLogger.Info("%s", string(p))
 	return len(p), nil
 }
@@ -0,0 +1,29 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// New returns a random version 7 UUID (RFC 9562): a millisecond timestamp followed by 74 random bits,
+// so IDs created later sort after earlier ones and never collide in practice
+func New() string {
+	return newAt(time.Now())
+}
+
+func newAt(now time.Time) string {
+	var b [16]byte
+	// crypto/rand never fails on supported platforms
+	rand.Read(b[6:])
+
+	var ms [8]byte
+	binary.BigEndian.PutUint64(ms[:], uint64(now.UnixMilli()))
+	copy(b[:6], ms[2:])
+
+	b[6] = b[6]&0x0f | 0x70 // version 7
+	b[8] = b[8]&0x3f | 0x80 // RFC 9562 variant
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
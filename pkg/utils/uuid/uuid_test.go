@@ -0,0 +1,36 @@
+package uuid
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+var format = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestNew(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 10000; i++ {
+		id := New()
+		if !format.MatchString(id) {
+			t.Fatalf("New() = %q, want a version 7 UUID", id)
+		}
+		if seen[id] {
+			t.Fatalf("New() returned %q twice", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestNewSortsByTime(t *testing.T) {
+	at := time.Date(2026, 3, 11, 10, 7, 30, 0, time.UTC)
+
+	earlier := newAt(at)
+	later := newAt(at.Add(time.Millisecond))
+	if earlier >= later {
+		t.Errorf("%s sorts after %s", earlier, later)
+	}
+	if got := earlier[:13]; got != "019cdc5d-a2d0" {
+		t.Errorf("timestamp part = %s, want 019cdc5d-a2d0", got)
+	}
+}